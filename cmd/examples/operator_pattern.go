@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -57,7 +58,7 @@ func (r *OperatorReconciler) ReconcileTopic(ctx context.Context, topicName strin
 
 	// Check if topic exists
 	topic, err := topicMgr.GetTopic(ctx, r.config.DefaultCluster, topicName)
-	if err != nil {
+	if errors.Is(err, resources.ErrTopicNotFound) {
 		// Topic doesn't exist, create it
 		log.Printf("Topic %s not found, creating...\n", topicName)
 		newTopic := createTopicFromSpec(topicName, partitions, replicationFactor)
@@ -66,6 +67,10 @@ func (r *OperatorReconciler) ReconcileTopic(ctx context.Context, topicName strin
 		}
 		return nil
 	}
+	if err != nil {
+		// Auth, permission, and network failures must not be mistaken for a missing topic
+		return fmt.Errorf("failed to get topic: %w", err)
+	}
 
 	// Topic exists, check if it needs updates
 	if topic.PartitionCount != partitions {
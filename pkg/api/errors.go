@@ -19,6 +19,13 @@ type Error struct {
 	Message string
 	// Details contains additional error details from the API
 	Details map[string]interface{}
+	// Errors holds the entries of the "errors" array returned by Confluent Cloud APIs, if any
+	Errors []ErrorDetail
+	// Headers are the response headers (if the error came from an HTTP response)
+	Headers http.Header
+	// Method and Path identify the failed request (if the error came from an HTTP response)
+	Method string
+	Path   string
 	// Err is the underlying error (if any)
 	Err error
 }
@@ -45,6 +52,17 @@ func (e *Error) Unwrap() error {
 	return e.Err
 }
 
+// RequestID returns the Confluent request ID of the failed request, or "" if unknown.
+// Include it in support tickets with Confluent.
+func (e *Error) RequestID() string {
+	return e.Headers.Get(RequestIDHeader)
+}
+
+// RateLimit returns the rate-limit state reported with the error, if any.
+func (e *Error) RateLimit() (RateLimitInfo, bool) {
+	return ParseRateLimitHeaders(e.Headers)
+}
+
 // IsBadRequest returns true if this is a 400 Bad Request error.
 func (e *Error) IsBadRequest() bool {
 	return e.Code == http.StatusBadRequest
@@ -95,6 +113,63 @@ func (e *Error) RetryAfter() int {
 	return 60
 }
 
+// ErrorDetail is one entry of the "errors" array in a Confluent Cloud error response.
+type ErrorDetail struct {
+	ID     string       `json:"id,omitempty"`
+	Status string       `json:"status,omitempty"`
+	Code   string       `json:"code,omitempty"`
+	Title  string       `json:"title,omitempty"`
+	Detail string       `json:"detail,omitempty"`
+	Source *ErrorSource `json:"source,omitempty"`
+}
+
+// ErrorSource identifies the part of the request an ErrorDetail refers to.
+type ErrorSource struct {
+	// Pointer is a JSON pointer into the request body (e.g., "/spec/display_name")
+	Pointer string `json:"pointer,omitempty"`
+	// Parameter is the query parameter that caused the error
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// FirstDetail returns the first entry of the errors array, or nil if there is none.
+func (e *Error) FirstDetail() *ErrorDetail {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return &e.Errors[0]
+}
+
+// HasCode returns true if the error's ErrorCode or any entry of its errors array has the given code.
+func (e *Error) HasCode(code string) bool {
+	if e.ErrorCode == code {
+		return true
+	}
+	for _, d := range e.Errors {
+		if d.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// message returns the most descriptive text of the detail.
+func (d ErrorDetail) message() string {
+	if d.Detail != "" {
+		return d.Detail
+	}
+	return d.Title
+}
+
+func parseErrorDetails(body []byte) []ErrorDetail {
+	var list struct {
+		Errors []ErrorDetail `json:"errors"`
+	}
+	if json.Unmarshal(body, &list) != nil {
+		return nil
+	}
+	return list.Errors
+}
+
 // apiErrorResponse represents the standard Confluent API error response format.
 type apiErrorResponse struct {
 	ErrorCode string `json:"error_code"`
@@ -106,6 +181,7 @@ func NewError(statusCode int, responseBody []byte, headers http.Header) *Error {
 	err := &Error{
 		Code:    statusCode,
 		Details: make(map[string]interface{}),
+		Headers: headers,
 	}
 
 	// Try to parse Confluent-specific error response
@@ -116,6 +192,14 @@ func NewError(statusCode int, responseBody []byte, headers http.Header) *Error {
 			err.Message = apiErr.Message
 		}
 
+		// Confluent Cloud APIs report failures as an errors array instead
+		if details := parseErrorDetails(responseBody); len(details) > 0 {
+			err.Errors = details
+			if err.Message == "" {
+				err.Message = details[0].message()
+			}
+		}
+
 		// Also try to parse as generic JSON
 		var jsonBody map[string]interface{}
 		if json.Unmarshal(responseBody, &jsonBody) == nil {
@@ -204,6 +288,10 @@ func ParseErrorFromResponse(statusCode int, body []byte) (message string, errorC
 		return apiErr.Message, apiErr.ErrorCode
 	}
 
+	if details := parseErrorDetails(body); len(details) > 0 && details[0].message() != "" {
+		return details[0].message(), StatusCodeToErrorCode(statusCode)
+	}
+
 	// Try to extract error from generic JSON response
 	var jsonBody map[string]interface{}
 	if err := json.Unmarshal(body, &jsonBody); err == nil {
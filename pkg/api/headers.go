@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RequestIDHeader is the response header carrying the Confluent request ID.
+// Include its value in support tickets with Confluent.
+const RequestIDHeader = "X-Request-Id"
+
+// RateLimitInfo is the rate-limit state reported in response headers.
+type RateLimitInfo struct {
+	// Limit is the number of requests allowed in the current window
+	Limit int
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// Reset is the time until the current window resets
+	Reset time.Duration
+}
+
+// ParseRateLimitHeaders extracts rate-limit state from response headers, accepting both
+// the RateLimit-* and X-RateLimit-* forms. A reset given as a Unix timestamp rather than a
+// number of seconds is converted to the time remaining until it.
+// ok is false when no rate-limit headers are present.
+func ParseRateLimitHeaders(h http.Header) (info RateLimitInfo, ok bool) {
+	limit, hasLimit := rateLimitHeader(h, "Limit")
+	remaining, hasRemaining := rateLimitHeader(h, "Remaining")
+	reset, hasReset := rateLimitHeader(h, "Reset")
+	if !hasLimit && !hasRemaining && !hasReset {
+		return RateLimitInfo{}, false
+	}
+	resetIn := time.Duration(reset) * time.Second
+	if reset > unixTimestampThreshold {
+		resetIn = time.Until(time.Unix(int64(reset), 0))
+		if resetIn < 0 {
+			resetIn = 0
+		}
+	}
+	return RateLimitInfo{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     resetIn,
+	}, true
+}
+
+// unixTimestampThreshold separates reset values given in seconds (a window is at most
+// hours long) from Unix timestamps (after 2001).
+const unixTimestampThreshold = 1_000_000_000
+
+func rateLimitHeader(h http.Header, name string) (int, bool) {
+	for _, key := range []string{"RateLimit-" + name, "X-RateLimit-" + name} {
+		if v := h.Get(key); v != "" {
+			if n, err := strconv.Atoi(v); err == nil {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// NetworkError is a transport-level failure: the request received no HTTP response,
+// e.g. because of a connection reset, a DNS failure, or a timeout.
+// Unlike *Error it carries no status code.
+type NetworkError struct {
+	Method string
+	Path   string
+	// IdempotencyKey is the Idempotency-Key the request was sent with, if any
+	IdempotencyKey string
+	Err            error
+}
+
+// Error implements the error interface.
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("failed to execute HTTP request %s %s: %v", e.Method, e.Path, e.Err)
+}
+
+// Unwrap returns the underlying transport error.
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// Timeout returns true if the request timed out.
+func (e *NetworkError) Timeout() bool {
+	var netErr net.Error
+	return errors.Is(e.Err, context.DeadlineExceeded) || (errors.As(e.Err, &netErr) && netErr.Timeout())
+}
+
+// IsRetryable returns true unless the request was cancelled by the caller.
+// Connection failures and timeouts are usually transient.
+func (e *NetworkError) IsRetryable() bool {
+	return !errors.Is(e.Err, context.Canceled)
+}
+
+// Replayable returns true if resending the request cannot apply it twice: its method
+// is idempotent (GET, HEAD, OPTIONS, PUT, DELETE) or it carries an idempotency key.
+// A POST or PATCH that received no response may already have been applied.
+func (e *NetworkError) Replayable() bool {
+	switch e.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return e.IdempotencyKey != ""
+}
+
+// IsNetworkError returns true if err is or wraps a *NetworkError.
+func IsNetworkError(err error) bool {
+	var netErr *NetworkError
+	return errors.As(err, &netErr)
+}
@@ -2,7 +2,7 @@
 package api
 
 // Cluster represents a Confluent Kafka cluster with its configuration and status.
-// Clusters can be BASIC, STANDARD, or DEDICATED types.
+// Clusters can be BASIC, STANDARD, ENTERPRISE, DEDICATED, or FREIGHT types.
 type Cluster struct {
 	ID               string `json:"id"`
 	Name             string `json:"name"`
@@ -11,7 +11,8 @@ type Cluster struct {
 	ProviderCloud    string `json:"provider_cloud"`
 	Status           string `json:"status"`
 	BootstrapServers string `json:"bootstrap_servers"`
-	Type             string `json:"type"` // BASIC, STANDARD, DEDICATED
+	Type             string `json:"type"`         // BASIC, STANDARD, ENTERPRISE, DEDICATED, FREIGHT
+	Availability     string `json:"availability"` // SINGLE_ZONE, MULTI_ZONE, LOW, HIGH
 }
 
 // Topic represents a Kafka topic with its partition and replication configuration.
@@ -20,13 +21,37 @@ type Topic struct {
 	PartitionCount    int32             `json:"partition_count"`
 	ReplicationFactor int16             `json:"replication_factor"`
 	Config            map[string]string `json:"config"`
+	// Metrics is only populated by TopicMetricsManager.AttachTopicMetrics
+	Metrics *TopicMetrics `json:"metrics,omitempty"`
+}
+
+// TopicMetrics holds throughput and storage metrics of a topic from the Confluent Metrics API.
+type TopicMetrics struct {
+	BytesIn       int64 `json:"bytes_in"`       // bytes produced during the queried window
+	BytesOut      int64 `json:"bytes_out"`      // bytes consumed during the queried window
+	RetainedBytes int64 `json:"retained_bytes"` // bytes currently stored, including replicas
 }
 
 // TopicConfig represents a single topic-level configuration key-value pair.
 // Examples include retention.ms, cleanup.policy, compression.type, etc.
 type TopicConfig struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	IsDefault   bool   `json:"is_default"`
+	IsReadOnly  bool   `json:"is_read_only"`
+	IsSensitive bool   `json:"is_sensitive"` // Value is empty for sensitive configs
+	// Source is where the value comes from, e.g. DYNAMIC_TOPIC_CONFIG or DEFAULT_CONFIG
+	Source   string          `json:"source"`
+	Synonyms []ConfigSynonym `json:"synonyms"`
+}
+
+// ConfigSynonym is one of the values a config would take at a given source, in order of
+// precedence. A topic's retention.ms, for example, may be set on the topic, inherited
+// from the broker's log.retention.ms, or fall back to the default.
+type ConfigSynonym struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
 }
 
 // ServiceAccount represents a Confluent service account used for programmatic access.
@@ -45,6 +70,7 @@ type APIKey struct {
 	Secret      string  `json:"secret"`
 	Description string  `json:"description"`
 	OwnerID     string  `json:"owner_id"`
+	ResourceID  string  `json:"resource_id,omitempty"` // scoped resource, e.g. lkc-... or lsrc-...; empty for cloud keys
 	CreatedAt   string  `json:"created_at"`
 	ExpiresAt   *string `json:"expires_at"`
 }
@@ -68,6 +94,26 @@ type Environment struct {
 	DisplayName string `json:"display_name"`
 }
 
+// Organization represents a Confluent Cloud organization, the top-level container for environments.
+type Organization struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	JITEnabled  bool   `json:"jit_enabled"`
+}
+
+// Entitlement represents a partner or marketplace entitlement attached to an organization.
+// Entitlements determine which products and features (e.g., Flink, Freight clusters, Tableflow)
+// are enabled. Features is only populated where the API exposes feature flags.
+type Entitlement struct {
+	ID             string   `json:"id"`
+	OrganizationID string   `json:"organization_id"`
+	CustomerID     string   `json:"customer_id"`
+	ProductID      string   `json:"product_id"`
+	PlanID         string   `json:"plan_id"`
+	Status         string   `json:"status"` // ACTIVE, SUSPENDED, CANCELLED
+	Features       []string `json:"features"`
+}
+
 // RoleBinding represents a role assignment to a principal (user or service account).
 // Role bindings grant permissions at the organization, environment, or cluster level.
 type RoleBinding struct {
@@ -94,9 +140,15 @@ type Resource struct {
 // BrokerConfig represents a broker-level configuration setting.
 // Broker configs apply to individual Kafka brokers within a cluster.
 type BrokerConfig struct {
-	BrokerID string `json:"broker_id"`
+	BrokerID string `json:"broker_id"` // empty for cluster-wide defaults
 	Name     string `json:"name"`
 	Value    string `json:"value"`
+	// IsDefault, IsReadOnly, IsSensitive, Source, and Synonyms have the same meaning as in TopicConfig
+	IsDefault   bool            `json:"is_default"`
+	IsReadOnly  bool            `json:"is_read_only"`
+	IsSensitive bool            `json:"is_sensitive"`
+	Source      string          `json:"source"`
+	Synonyms    []ConfigSynonym `json:"synonyms"`
 }
 
 // PartitionInfo represents metadata about a Kafka topic partition.
@@ -109,6 +161,74 @@ type PartitionInfo struct {
 	ISR       []int32 `json:"isr"` // In-Sync Replicas
 }
 
+// ConsumerGroup represents a Kafka consumer group.
+type ConsumerGroup struct {
+	ConsumerGroupID   string `json:"consumer_group_id"`
+	IsSimple          bool   `json:"is_simple"`
+	PartitionAssignor string `json:"partition_assignor"`
+	State             string `json:"state"` // STABLE, PREPARING_REBALANCE, COMPLETING_REBALANCE, EMPTY, DEAD, UNKNOWN
+}
+
+// Consumer represents a member of a Kafka consumer group.
+type Consumer struct {
+	ConsumerID string `json:"consumer_id"`
+	InstanceID string `json:"instance_id"` // static membership ID, empty for dynamic members
+	ClientID   string `json:"client_id"`
+}
+
+// ConsumerLag is the lag of a consumer group on one topic partition.
+type ConsumerLag struct {
+	ConsumerGroupID string `json:"consumer_group_id"`
+	TopicName       string `json:"topic_name"`
+	PartitionID     int32  `json:"partition_id"`
+	CurrentOffset   int64  `json:"current_offset"`
+	LogEndOffset    int64  `json:"log_end_offset"`
+	Lag             int64  `json:"lag"`
+	ConsumerID      string `json:"consumer_id"`
+	InstanceID      string `json:"instance_id"`
+	ClientID        string `json:"client_id"`
+}
+
+// ConsumerGroupLagSummary summarizes the lag of a consumer group across all its partitions.
+type ConsumerGroupLagSummary struct {
+	ConsumerGroupID   string `json:"consumer_group_id"`
+	MaxLag            int64  `json:"max_lag"`
+	TotalLag          int64  `json:"total_lag"`
+	MaxLagConsumerID  string `json:"max_lag_consumer_id"`
+	MaxLagInstanceID  string `json:"max_lag_instance_id"`
+	MaxLagClientID    string `json:"max_lag_client_id"`
+	MaxLagTopicName   string `json:"max_lag_topic_name"`
+	MaxLagPartitionID int32  `json:"max_lag_partition_id"`
+}
+
+// ClusterLink represents a cluster link replicating data between Kafka clusters.
+type ClusterLink struct {
+	LinkName             string   `json:"link_name"`
+	LinkID               string   `json:"link_id"`
+	SourceClusterID      string   `json:"source_cluster_id"`
+	DestinationClusterID string   `json:"destination_cluster_id"`
+	LinkState            string   `json:"link_state"` // ACTIVE, PAUSED, FAILED, UNAVAILABLE
+	TopicNames           []string `json:"topic_names"`
+}
+
+// MirrorTopic represents a read-only topic mirrored over a cluster link.
+type MirrorTopic struct {
+	LinkName        string      `json:"link_name"`
+	MirrorTopicName string      `json:"mirror_topic_name"`
+	SourceTopicName string      `json:"source_topic_name"`
+	NumPartitions   int32       `json:"num_partitions"`
+	MirrorStatus    string      `json:"mirror_status"` // ACTIVE, PAUSED, STOPPED, FAILED, PENDING_STOPPED, ...
+	StateTimeMs     int64       `json:"state_time_ms"`
+	MirrorLags      []MirrorLag `json:"mirror_lags"`
+}
+
+// MirrorLag is the replication lag of one partition of a mirror topic.
+type MirrorLag struct {
+	Partition             int32 `json:"partition"`
+	Lag                   int64 `json:"lag"`
+	LastSourceFetchOffset int64 `json:"last_source_fetch_offset"`
+}
+
 // SchemaSubject represents a subject in Confluent Schema Registry.
 // A subject typically corresponds to a topic and contains multiple schema versions.
 type SchemaSubject struct {
@@ -136,6 +256,43 @@ type SchemaReference struct {
 	Version int32  `json:"version"`
 }
 
+// SchemaRegistryCluster represents the Schema Registry (Stream Governance) cluster of an environment.
+// Each environment has at most one Schema Registry cluster.
+type SchemaRegistryCluster struct {
+	ID                  string `json:"id"`
+	DisplayName         string `json:"display_name"`
+	EnvironmentID       string `json:"environment_id"`
+	Package             string `json:"package"` // ESSENTIALS, ADVANCED
+	Cloud               string `json:"cloud"`
+	Region              string `json:"region"`
+	HTTPEndpoint        string `json:"http_endpoint"`
+	PrivateHTTPEndpoint string `json:"private_http_endpoint,omitempty"`
+	CatalogHTTPEndpoint string `json:"catalog_http_endpoint,omitempty"`
+	Phase               string `json:"phase"` // PROVISIONING, PROVISIONED, FAILED, DEPROVISIONING
+}
+
+// SchemaRegistryRegion is a cloud region where a Schema Registry cluster can be provisioned.
+type SchemaRegistryRegion struct {
+	ID          string   `json:"id"`
+	DisplayName string   `json:"display_name"`
+	Cloud       string   `json:"cloud"`
+	RegionName  string   `json:"region_name"`
+	Packages    []string `json:"packages"`
+}
+
+// ClientQuota is a throughput quota applied to a set of principals on a dedicated cluster.
+// Byte rates are in bytes per second; an empty rate is unlimited.
+type ClientQuota struct {
+	ID              string   `json:"id"`
+	DisplayName     string   `json:"display_name"`
+	Description     string   `json:"description"`
+	EnvironmentID   string   `json:"environment_id"`
+	ClusterID       string   `json:"cluster_id"`
+	Principals      []string `json:"principals"` // service account IDs, or "<default>"
+	IngressByteRate string   `json:"ingress_byte_rate"`
+	EgressByteRate  string   `json:"egress_byte_rate"`
+}
+
 // ConnectorConfig represents a Kafka Connect connector configuration.
 // Connectors can be SOURCE (producing to Kafka) or SINK (consuming from Kafka).
 type ConnectorConfig struct {
@@ -162,6 +319,34 @@ type TaskStatus struct {
 	State  string `json:"state"`
 	Worker string `json:"worker"`
 	Error  string `json:"error"`
+	// Trace is Error parsed as a Java stack trace, or nil if Error is not one
+	Trace *TaskTrace `json:"-"`
+}
+
+// TaskTrace is a task's Java stack trace split into its exception chain.
+type TaskTrace struct {
+	Exception string // fully qualified class, e.g. org.apache.kafka.connect.errors.ConnectException
+	Message   string
+	// Frames are the "at ..." lines of the top-level exception, without the "at " prefix
+	Frames []string
+	// Causes are the "Caused by:" exceptions, outermost first
+	Causes []TaskTraceCause
+}
+
+// TaskTraceCause is a "Caused by:" exception of a TaskTrace.
+type TaskTraceCause struct {
+	Exception string
+	Message   string
+	Frames    []string
+}
+
+// RootCause returns the innermost exception of the trace and its message.
+func (t *TaskTrace) RootCause() (exception string, message string) {
+	if len(t.Causes) == 0 {
+		return t.Exception, t.Message
+	}
+	c := t.Causes[len(t.Causes)-1]
+	return c.Exception, c.Message
 }
 
 // ConnectorError represents an error that occurred in a connector or task.
@@ -184,6 +369,43 @@ type ConnectorValidation struct {
 	Configs    []ConnectorConfigValidation `json:"configs"`
 }
 
+// IsValid returns true when no config property has validation errors.
+func (v *ConnectorValidation) IsValid() bool {
+	if v.ErrorCount > 0 {
+		return false
+	}
+	for _, c := range v.Configs {
+		if len(c.Value.Errors) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ErrorsByConfig returns the validation errors keyed by config property name, omitting
+// properties without errors.
+func (v *ConnectorValidation) ErrorsByConfig() map[string][]string {
+	errs := make(map[string][]string)
+	for _, c := range v.Configs {
+		if len(c.Value.Errors) > 0 {
+			errs[c.Value.Name] = append(errs[c.Value.Name], c.Value.Errors...)
+		}
+	}
+	return errs
+}
+
+// MissingRequired returns the names of required config properties without a value or
+// default, in the order they were reported.
+func (v *ConnectorValidation) MissingRequired() []string {
+	var missing []string
+	for _, c := range v.Configs {
+		if c.Definition.Required && c.Value.Value == "" && c.Definition.DefaultValue == "" {
+			missing = append(missing, c.Definition.Name)
+		}
+	}
+	return missing
+}
+
 // ConnectorConfigValidation represents validation information for a single config property.
 type ConnectorConfigValidation struct {
 	Definition ConfigDefinition `json:"definition"`
@@ -214,9 +436,59 @@ type ConfigValue struct {
 	Visible           bool     `json:"visible"`
 }
 
+// CustomConnectorPlugin is a connector plugin uploaded to Confluent Cloud to run as a
+// custom connector.
+type CustomConnectorPlugin struct {
+	ID                string `json:"id"`
+	DisplayName       string `json:"display_name"`
+	Description       string `json:"description"`
+	DocumentationLink string `json:"documentation_link"`
+	ConnectorClass    string `json:"connector_class"`
+	ConnectorType     string `json:"connector_type"` // SOURCE or SINK
+	// SensitiveConfigProperties are config keys whose values are masked, e.g. passwords
+	SensitiveConfigProperties []string `json:"sensitive_config_properties"`
+	Cloud                     string   `json:"cloud"` // AWS, AZURE, GCP
+}
+
 // ConnectorTask represents a task instance for a connector.
 type ConnectorTask struct {
 	ID        int32             `json:"id"`
 	Config    map[string]string `json:"config"`
 	Connector string            `json:"connector"`
 }
+
+// ConnectorOffsets represents the committed offsets of a connector.
+// For sink connectors partitions are Kafka topic-partitions; for source connectors
+// they are connector-defined source partitions.
+type ConnectorOffsets struct {
+	Name    string            `json:"name"`
+	ID      string            `json:"id"`
+	Offsets []ConnectorOffset `json:"offsets"`
+}
+
+// ConnectorOffset is a single partition/offset pair of a connector.
+type ConnectorOffset struct {
+	Partition map[string]interface{} `json:"partition"`
+	Offset    map[string]interface{} `json:"offset"`
+}
+
+// ConnectorOffsetRequest is a pending request to alter or reset the offsets of a connector.
+type ConnectorOffsetRequest struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Type        string            `json:"type"` // PATCH or DELETE
+	Offsets     []ConnectorOffset `json:"offsets"`
+	RequestedAt string            `json:"requested_at"`
+}
+
+// ConnectorOffsetRequestStatus is the progress of the latest ConnectorOffsetRequest.
+type ConnectorOffsetRequestStatus struct {
+	Request ConnectorOffsetRequest `json:"request"`
+	Status  struct {
+		Phase   string `json:"phase"` // PENDING, APPLIED, FAILED
+		Message string `json:"message"`
+	} `json:"status"`
+	// PreviousOffsets are the offsets before the request was applied, for rollback
+	PreviousOffsets []ConnectorOffset `json:"previous_offsets"`
+	AppliedAt       string            `json:"applied_at"`
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// AuditEvent describes a mutating request for audit logging.
+type AuditEvent struct {
+	// Method is the HTTP verb (POST, PUT, PATCH, or DELETE)
+	Method string
+	// Path is the request path, e.g. /iam/v2/service-accounts/sa-123
+	Path string
+	// Principal identifies the caller: the API key ID or basic-auth username,
+	// or empty for bearer tokens and unauthenticated requests
+	Principal string
+	// StatusCode is the response status, or 0 if no response was received
+	StatusCode int
+	// Err is the error returned to the caller, if any
+	Err error
+	// RequestID is the Confluent request ID of the response, if any
+	RequestID string
+	// DryRun is true when the request was short-circuited by dry-run mode
+	DryRun bool
+	// Duration is the time spent in Client.Do
+	Duration time.Duration
+}
+
+// Succeeded returns true if the request was sent and succeeded.
+func (e AuditEvent) Succeeded() bool {
+	return e.Err == nil && !e.DryRun
+}
+
+func (c *Client) audit(ctx context.Context, req Request, resp *Response, err error, duration time.Duration) {
+	event := AuditEvent{
+		Method:   req.Method,
+		Path:     req.Path,
+		Err:      err,
+		Duration: duration,
+	}
+	switch c.authMode {
+	case AuthModeAPIKey:
+		event.Principal = c.config.APIKey
+	case AuthModeBasic:
+		event.Principal = c.config.Username
+	}
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+		event.RequestID = resp.RequestID()
+		event.DryRun = resp.DryRun
+	}
+	c.config.AuditHook(ctx, event)
+}
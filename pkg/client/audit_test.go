@@ -0,0 +1,55 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_AuditHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	var events []client.AuditEvent
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		AuditHook: func(ctx context.Context, e client.AuditEvent) { events = append(events, e) },
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	ctx := context.Background()
+
+	_, _ = c.Do(ctx, client.Request{Method: "GET", Path: "/iam/v2/service-accounts"})
+	_, _ = c.Do(ctx, client.Request{Method: "POST", Path: "/iam/v2/service-accounts", Body: map[string]string{}})
+	_, _ = c.Do(ctx, client.Request{Method: "DELETE", Path: "/iam/v2/service-accounts/sa-1"})
+	_, _ = c.Do(ctx, client.Request{Method: "PATCH", Path: "/iam/v2/service-accounts/sa-1", DryRun: true})
+
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 audit events, got %d", len(events))
+	}
+	created := events[0]
+	if created.Method != "POST" || created.Principal != "test-key" || created.StatusCode != http.StatusCreated ||
+		created.RequestID != "req-1" || !created.Succeeded() {
+		t.Errorf("Unexpected create event %+v", created)
+	}
+	deleted := events[1]
+	if deleted.Path != "/iam/v2/service-accounts/sa-1" || deleted.StatusCode != http.StatusNotFound || deleted.Err == nil || deleted.Succeeded() {
+		t.Errorf("Unexpected delete event %+v", deleted)
+	}
+	if !events[2].DryRun || events[2].Succeeded() {
+		t.Errorf("Unexpected dry-run event %+v", events[2])
+	}
+}
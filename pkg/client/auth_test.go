@@ -0,0 +1,110 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_AuthModes(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	basic := func(user, pass string) string {
+		r, _ := http.NewRequest("GET", "/", nil)
+		r.SetBasicAuth(user, pass)
+		return r.Header.Get("Authorization")
+	}
+
+	tests := map[string]struct {
+		config client.Config
+		want   string
+	}{
+		"api key": {client.Config{APIKey: "key", APISecret: "secret"}, basic("key", "secret")},
+		"basic":   {client.Config{AuthMode: client.AuthModeBasic, Username: "alice", Password: "pw"}, basic("alice", "pw")},
+		"none":    {client.Config{AuthMode: client.AuthModeNone}, ""},
+		"bearer":  {client.Config{AuthMode: client.AuthModeBearer, TokenSource: staticTokenSource("tok")}, "Bearer tok"},
+	}
+	for name, tc := range tests {
+		tc.config.BaseURL = server.URL
+		c, err := client.NewClient(tc.config)
+		if err != nil {
+			t.Fatalf("%s: NewClient failed: %v", name, err)
+		}
+		if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/v3/clusters"}); err != nil {
+			t.Fatalf("%s: Do failed: %v", name, err)
+		}
+		if gotAuth != tc.want {
+			t.Errorf("%s: Authorization = %q, want %q", name, gotAuth, tc.want)
+		}
+	}
+}
+
+func TestNewClient_AuthModeValidation(t *testing.T) {
+	for name, config := range map[string]client.Config{
+		"basic without password": {AuthMode: client.AuthModeBasic, Username: "alice"},
+		"bearer without source":  {AuthMode: client.AuthModeBearer},
+		"unknown mode":           {AuthMode: "kerberos"},
+	} {
+		config.BaseURL = "https://localhost"
+		if _, err := client.NewClient(config); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestMDSTokenSource(t *testing.T) {
+	var logins int
+	mds := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if r.URL.Path != client.DefaultMDSAuthenticatePath || user != "alice" || pass != "pw" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		logins++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"auth_token":"mds-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer mds.Close()
+
+	var gotAuth string
+	rest := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer rest.Close()
+
+	mdsClient, err := client.NewClient(client.Config{BaseURL: mds.URL, AuthMode: client.AuthModeBasic, Username: "alice", Password: "pw"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	c, err := client.NewClient(client.Config{
+		BaseURL:     rest.URL,
+		AuthMode:    client.AuthModeBearer,
+		TokenSource: client.NewMDSTokenSource(mdsClient, 0),
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/v3/clusters"}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+	if gotAuth != "Bearer mds-token" || logins != 1 {
+		t.Errorf("Authorization = %q after %d logins", gotAuth, logins)
+	}
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (*client.Token, error) {
+	return &client.Token{AccessToken: string(s)}, nil
+}
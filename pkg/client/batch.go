@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Retrier retries an operation; *retry.Strategy implements it.
+type Retrier interface {
+	Do(ctx context.Context, operation func() error) error
+}
+
+// BatchOptions configures Batch and BatchFuncs.
+type BatchOptions struct {
+	// Concurrency bounds the number of items in flight (optional, defaults to 4).
+	// Combine with Config.RateLimiter to also bound the request rate.
+	Concurrency int
+	// Retry retries each failed item independently (optional, no retries when nil).
+	// Requests with streamed (io.Reader) bodies cannot be retried.
+	Retry Retrier
+	// StopOnError cancels the items not yet started once any item fails;
+	// they fail with the context error (optional)
+	StopOnError bool
+}
+
+// BatchError reports the items of a batch that failed.
+type BatchError struct {
+	// Errors holds the error of each item by index; nil for items that succeeded
+	Errors []error
+}
+
+// Error implements the error interface.
+func (e *BatchError) Error() string {
+	failed := e.Failed()
+	msgs := make([]string, 0, len(failed))
+	for _, i := range failed {
+		msgs = append(msgs, fmt.Sprintf("item %d: %v", i, e.Errors[i]))
+		if len(msgs) == 3 && len(failed) > 3 {
+			msgs = append(msgs, fmt.Sprintf("and %d more", len(failed)-3))
+			break
+		}
+	}
+	return fmt.Sprintf("%d of %d batch items failed: %s", len(failed), len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the item errors, so errors.Is and errors.As match any of them.
+func (e *BatchError) Unwrap() []error {
+	var errs []error
+	for _, err := range e.Errors {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Failed returns the indexes of the items that failed, in ascending order.
+func (e *BatchError) Failed() []int {
+	var failed []int
+	for i, err := range e.Errors {
+		if err != nil {
+			failed = append(failed, i)
+		}
+	}
+	sort.Ints(failed)
+	return failed
+}
+
+// Batch executes requests with bounded parallelism and returns their responses by index.
+// Every request is attempted; if any fail, the error is a *BatchError and the responses
+// of the failed requests are nil.
+//
+// Example usage:
+//
+//	reqs := make([]client.Request, len(topics))
+//	for i, topic := range topics {
+//		reqs[i] = client.Request{Method: "POST", Path: topicsPath, Body: topic}
+//	}
+//	_, err := client.Batch(ctx, c, reqs, client.BatchOptions{Concurrency: 8, Retry: retry.DefaultStrategy()})
+func Batch(ctx context.Context, d Doer, reqs []Request, opts BatchOptions) ([]*Response, error) {
+	responses := make([]*Response, len(reqs))
+	fns := make([]func(ctx context.Context) error, len(reqs))
+	for i := range reqs {
+		i := i
+		fns[i] = func(ctx context.Context) error {
+			resp, err := d.Do(ctx, reqs[i])
+			if err != nil {
+				return err
+			}
+			responses[i] = resp
+			return nil
+		}
+	}
+	return responses, BatchFuncs(ctx, fns, opts)
+}
+
+// BatchFuncs runs fns with bounded parallelism, e.g. to batch manager calls.
+// Every function is run; if any fail, the error is a *BatchError.
+func BatchFuncs(ctx context.Context, fns []func(ctx context.Context) error, opts BatchOptions) error {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make([]error, len(fns))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, fn := range fns {
+		wg.Add(1)
+		go func(i int, fn func(ctx context.Context) error) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return
+			}
+			if opts.Retry != nil {
+				errs[i] = opts.Retry.Do(ctx, func() error { return fn(ctx) })
+			} else {
+				errs[i] = fn(ctx)
+			}
+			if errs[i] != nil && opts.StopOnError {
+				cancel()
+			}
+		}(i, fn)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return &BatchError{Errors: errs}
+		}
+	}
+	return nil
+}
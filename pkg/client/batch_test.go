@@ -0,0 +1,97 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/retry"
+)
+
+func TestBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	var attempts atomic.Int32
+	fake := client.DoerFunc(func(ctx context.Context, req client.Request) (*client.Response, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		switch {
+		case strings.HasSuffix(req.Path, "/flaky") && attempts.Add(1) == 1:
+			return nil, api.NewError(http.StatusServiceUnavailable, nil, nil)
+		case strings.HasSuffix(req.Path, "/bad"):
+			return nil, api.NewError(http.StatusBadRequest, []byte(`{"message":"invalid"}`), nil)
+		}
+		return &client.Response{StatusCode: http.StatusOK, Body: []byte(req.Path)}, nil
+	})
+
+	paths := []string{"/a", "/b", "/flaky", "/c", "/bad", "/d", "/e", "/f"}
+	reqs := make([]client.Request, len(paths))
+	for i, p := range paths {
+		reqs[i] = client.Request{Method: "POST", Path: "/kafka/v3/clusters/lkc-1/topics" + p}
+	}
+
+	strategy := retry.DefaultStrategy().WithInitialBackoff(time.Millisecond).WithJitter(false)
+	responses, err := client.Batch(context.Background(), fake, reqs, client.BatchOptions{Concurrency: 3, Retry: strategy})
+
+	var batchErr *client.BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("Expected *BatchError, got %v", err)
+	}
+	if failed := batchErr.Failed(); len(failed) != 1 || failed[0] != 4 {
+		t.Errorf("Expected only item 4 to fail, got %v", failed)
+	}
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Errorf("Expected wrapped bad request error, got %v", err)
+	}
+	for i, resp := range responses {
+		if (resp == nil) != (i == 4) {
+			t.Errorf("Unexpected response %d: %v", i, resp)
+		}
+	}
+	if m := maxInFlight.Load(); m > 3 {
+		t.Errorf("Expected at most 3 requests in flight, got %d", m)
+	}
+}
+
+func TestBatchFuncs_StopOnError(t *testing.T) {
+	boom := errors.New("boom")
+	fns := make([]func(ctx context.Context) error, 5)
+	fns[0] = func(ctx context.Context) error { return boom }
+	for i := 1; i < len(fns); i++ {
+		fns[i] = func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(5 * time.Second):
+				return nil
+			}
+		}
+	}
+
+	start := time.Now()
+	err := client.BatchFuncs(context.Background(), fns, client.BatchOptions{Concurrency: len(fns), StopOnError: true})
+	if time.Since(start) > 2*time.Second {
+		t.Error("Expected remaining items to be cancelled")
+	}
+	var batchErr *client.BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Failed()) != len(fns) {
+		t.Fatalf("Expected every item to fail, got %v", err)
+	}
+	if !errors.Is(err, boom) || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected boom and cancellation errors, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// ErrCircuitOpen is returned by Client.Do without contacting the API while the
+// circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerConfig configures a circuit breaker that stops sending requests
+// while the API is returning sustained server errors.
+//
+// The breaker opens after FailureThreshold consecutive failures (transport errors
+// or 5xx responses). While open, requests fail fast with ErrCircuitOpen. After
+// OpenDuration it becomes half-open and admits up to HalfOpenProbes requests;
+// if they all succeed the breaker closes, and any failure reopens it.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker (defaults to 5)
+	FailureThreshold int
+	// OpenDuration is how long the breaker stays open before probing (defaults to 30s)
+	OpenDuration time.Duration
+	// HalfOpenProbes is the number of trial requests admitted while half-open (defaults to 1)
+	HalfOpenProbes int
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker is a goroutine-safe consecutive-failure circuit breaker.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	openFor   time.Duration
+	probes    int
+	clock     clock.Clock
+
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	inFlight  int
+	successes int
+}
+
+// newCircuitBreaker builds a circuit breaker from config, returning nil when disabled.
+func newCircuitBreaker(cfg *CircuitBreakerConfig, clk clock.Clock) *circuitBreaker {
+	if cfg == nil {
+		return nil
+	}
+	cb := &circuitBreaker{
+		threshold: cfg.FailureThreshold,
+		openFor:   cfg.OpenDuration,
+		probes:    cfg.HalfOpenProbes,
+		clock:     clk,
+	}
+	if cb.threshold <= 0 {
+		cb.threshold = 5
+	}
+	if cb.openFor <= 0 {
+		cb.openFor = 30 * time.Second
+	}
+	if cb.probes <= 0 {
+		cb.probes = 1
+	}
+	return cb
+}
+
+// allow reports whether a request may proceed. Every admitted request must be
+// followed by exactly one call to record.
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case breakerOpen:
+		if cb.clock.Now().Sub(cb.openedAt) < cb.openFor {
+			return false
+		}
+		cb.state = breakerHalfOpen
+		cb.inFlight = 0
+		cb.successes = 0
+		fallthrough
+	case breakerHalfOpen:
+		if cb.inFlight+cb.successes >= cb.probes {
+			return false
+		}
+		cb.inFlight++
+	}
+	return true
+}
+
+// record reports the outcome of an admitted request.
+func (cb *circuitBreaker) record(failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case breakerClosed:
+		if !failed {
+			cb.failures = 0
+			return
+		}
+		cb.failures++
+		if cb.failures >= cb.threshold {
+			cb.trip()
+		}
+	case breakerHalfOpen:
+		cb.inFlight--
+		if failed {
+			cb.trip()
+			return
+		}
+		cb.successes++
+		if cb.successes >= cb.probes {
+			cb.state = breakerClosed
+			cb.failures = 0
+		}
+	}
+}
+
+// release gives back the slot of an admitted request that never reached the API,
+// such as one that failed to sign, without counting it as a success or failure.
+// It takes the place of record for that request.
+func (cb *circuitBreaker) release() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == breakerHalfOpen {
+		cb.inFlight--
+	}
+}
+
+func (cb *circuitBreaker) trip() {
+	cb.state = breakerOpen
+	cb.openedAt = cb.clock.Now()
+	cb.failures = 0
+}
@@ -0,0 +1,127 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+func TestClientDo_CircuitBreaker(t *testing.T) {
+	var healthy atomic.Bool
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		CircuitBreaker: &client.CircuitBreakerConfig{
+			FailureThreshold: 2,
+			OpenDuration:     time.Minute,
+		},
+		Clock: fake,
+	})
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	ctx := context.Background()
+	req := client.Request{Method: "GET", Path: "/cmk/v2/clusters"}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Do(ctx, req); err == nil || errors.Is(err, client.ErrCircuitOpen) {
+			t.Fatalf("request %d: expected API error, got %v", i, err)
+		}
+	}
+	if _, err := c.Do(ctx, req); !errors.Is(err, client.ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 calls to reach the server, got %d", got)
+	}
+
+	// Failed probe reopens the breaker
+	fake.Advance(time.Minute)
+	if _, err := c.Do(ctx, req); err == nil || errors.Is(err, client.ErrCircuitOpen) {
+		t.Fatalf("expected probe to reach the server, got %v", err)
+	}
+	if _, err := c.Do(ctx, req); !errors.Is(err, client.ErrCircuitOpen) {
+		t.Fatalf("expected breaker to reopen after failed probe, got %v", err)
+	}
+
+	// Successful probe closes the breaker
+	healthy.Store(true)
+	fake.Advance(time.Minute)
+	for i := 0; i < 3; i++ {
+		if _, err := c.Do(ctx, req); err != nil {
+			t.Fatalf("request %d after recovery: %v", i, err)
+		}
+	}
+}
+
+func TestClientDo_CircuitBreakerSignFailureWhileHalfOpen(t *testing.T) {
+	var healthy, signFails atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		CircuitBreaker: &client.CircuitBreakerConfig{
+			FailureThreshold: 1,
+			OpenDuration:     time.Minute,
+		},
+		Signer: client.SignerFunc(func(req *http.Request, bodySHA256 string) error {
+			if signFails.Load() {
+				return errors.New("no signing key")
+			}
+			return nil
+		}),
+		Clock: fake,
+	})
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	ctx := context.Background()
+	req := client.Request{Method: "GET", Path: "/cmk/v2/clusters"}
+
+	if _, err := c.Do(ctx, req); err == nil || errors.Is(err, client.ErrCircuitOpen) {
+		t.Fatalf("expected API error, got %v", err)
+	}
+
+	// The half-open probe fails to sign and must not hold the probe slot
+	fake.Advance(time.Minute)
+	signFails.Store(true)
+	if _, err := c.Do(ctx, req); err == nil || errors.Is(err, client.ErrCircuitOpen) {
+		t.Fatalf("expected signing error, got %v", err)
+	}
+
+	signFails.Store(false)
+	healthy.Store(true)
+	if _, err := c.Do(ctx, req); err != nil {
+		t.Fatalf("expected probe after signing failure to reach the server, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+)
+
+// CachedResponse is a response stored by a ResponseCache together with its ETag.
+type CachedResponse struct {
+	ETag       string
+	StatusCode int
+	Body       []byte
+	Headers    http.Header
+}
+
+// ResponseCache stores GET responses that carried an ETag so the client can revalidate
+// them with If-None-Match. Keys are request URLs: the resolved base URL followed by the
+// path and query string, so clients sharing a cache across endpoints do not collide.
+// Implementations must be safe for concurrent use.
+type ResponseCache interface {
+	// Get returns the cached response for key, if any
+	Get(key string) (*CachedResponse, bool)
+	// Set stores a response for key
+	Set(key string, resp *CachedResponse)
+	// Delete removes any cached response for key
+	Delete(key string)
+}
+
+// MemoryResponseCache is an in-memory ResponseCache that evicts the least recently
+// used entry once it holds MaxEntries responses.
+type MemoryResponseCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	resp *CachedResponse
+}
+
+// NewMemoryResponseCache creates an LRU response cache. maxEntries of zero or less means unbounded.
+func NewMemoryResponseCache(maxEntries int) *MemoryResponseCache {
+	return &MemoryResponseCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements ResponseCache.
+func (c *MemoryResponseCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*memoryCacheEntry).resp, true
+}
+
+// Set implements ResponseCache.
+func (c *MemoryResponseCache) Set(key string, resp *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*memoryCacheEntry).resp = resp
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&memoryCacheEntry{key: key, resp: resp})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Delete implements ResponseCache.
+func (c *MemoryResponseCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.Remove(el)
+		delete(c.entries, key)
+	}
+}
+
+// Len returns the number of cached responses.
+func (c *MemoryResponseCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,111 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_ResponseCacheRevalidatesWithETag(t *testing.T) {
+	var conditional int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"id":"env-1"}`))
+	}))
+	defer server.Close()
+
+	cache := client.NewMemoryResponseCache(10)
+	c, err := client.NewClient(client.Config{
+		BaseURL:       server.URL,
+		APIKey:        "test-key",
+		APISecret:     "test-secret",
+		ResponseCache: cache,
+	})
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	ctx := context.Background()
+	get := client.Request{Method: "GET", Path: "/org/v2/environments/env-1"}
+
+	for i := 0; i < 2; i++ {
+		resp, err := c.Do(ctx, get)
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		if resp.StatusCode != http.StatusOK || string(resp.Body) != `{"id":"env-1"}` {
+			t.Fatalf("request %d: unexpected response %d %s", i, resp.StatusCode, resp.Body)
+		}
+	}
+	if conditional != 1 {
+		t.Errorf("expected 1 conditional request, got %d", conditional)
+	}
+
+	if _, err := c.Do(ctx, client.Request{Method: "PATCH", Path: get.Path, Body: map[string]string{}}); err != nil {
+		t.Fatalf("PATCH error: %v", err)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("expected PATCH to invalidate cached entry, cache has %d entries", cache.Len())
+	}
+}
+
+func TestMemoryResponseCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := client.NewMemoryResponseCache(2)
+	cache.Set("a", &client.CachedResponse{ETag: "1"})
+	cache.Set("b", &client.CachedResponse{ETag: "2"})
+	cache.Get("a")
+	cache.Set("c", &client.CachedResponse{ETag: "3"})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("expected a to be retained")
+	}
+}
+
+func TestClientDo_ResponseCacheKeyedByBaseURL(t *testing.T) {
+	newServer := func(body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("If-None-Match") != "" {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(body))
+		}))
+	}
+	cloud, cluster := newServer(`{"from":"cloud"}`), newServer(`{"from":"cluster"}`)
+	defer cloud.Close()
+	defer cluster.Close()
+
+	cache := client.NewMemoryResponseCache(10)
+	get := client.Request{Method: "GET", Path: "/kafka/v3/clusters/lkc-1/topics"}
+	for _, tc := range []struct{ url, want string }{{cloud.URL, `{"from":"cloud"}`}, {cluster.URL, `{"from":"cluster"}`}} {
+		c, err := client.NewClient(client.Config{BaseURL: tc.url, APIKey: "test-key", APISecret: "test-secret", ResponseCache: cache})
+		if err != nil {
+			t.Fatalf("NewClient error: %v", err)
+		}
+		resp, err := c.Do(context.Background(), get)
+		if err != nil {
+			t.Fatalf("Do error: %v", err)
+		}
+		if string(resp.Body) != tc.want {
+			t.Errorf("GET %s: got %s, want %s", tc.url, resp.Body, tc.want)
+		}
+	}
+	if cache.Len() != 2 {
+		t.Errorf("expected one cache entry per base URL, got %d", cache.Len())
+	}
+}
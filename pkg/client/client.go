@@ -5,30 +5,138 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
 )
 
 // Config holds the configuration for the Confluent REST client.
 type Config struct {
 	// BaseURL is the base URL for the Confluent API (e.g., https://api.confluent.cloud)
 	BaseURL string
+	// Endpoints overrides BaseURL per API family, keyed by EndpointClass (e.g., "kafka" for a
+	// cluster's REST endpoint, "schema-registry" for a Schema Registry cluster) (optional)
+	Endpoints map[string]string
 	// APIKey is the Confluent Cloud API key
 	APIKey string
 	// APISecret is the Confluent Cloud API secret
 	APISecret string
-	// HTTPClient is the HTTP client to use (optional, defaults to http.DefaultClient)
+	// AuthMode selects the authentication scheme (optional, defaults to AuthModeAuto)
+	AuthMode AuthMode
+	// Username and Password are the credentials for AuthModeBasic, e.g. LDAP users of
+	// self-managed Confluent Platform
+	Username string
+	Password string
+	// TokenSource authenticates requests with bearer tokens instead of APIKey/APISecret,
+	// e.g. a TokenExchanger for Flink and Tableflow data-plane APIs (optional)
+	TokenSource TokenSource
+	// HTTPClient is the HTTP client to use (optional, defaults to a client with connection,
+	// TLS handshake, and response header timeouts)
 	HTTPClient *http.Client
+	// Transport tunes connection pooling, keep-alives, and HTTP/2 of the default transport
+	// (optional, cannot be combined with HTTPClient)
+	Transport *TransportConfig
+	// TLS configures custom CAs, client certificates, and verification (optional, cannot be combined with HTTPClient)
+	TLS *TLSConfig
+	// ProxyURL routes requests through an HTTP(S) proxy, e.g. "http://proxy.corp:3128"
+	// (optional, defaults to the environment's HTTPS_PROXY settings; cannot be combined with HTTPClient)
+	ProxyURL string
+	// RequestTimeout bounds the duration of every request, including rate limiter waits
+	// (optional, zero relies on the caller's context). Request.Timeout overrides it.
+	RequestTimeout time.Duration
+	// UserAgent is appended to the default User-Agent (confluent-go/<version>) to identify the
+	// calling service in audit logs, e.g. "orders-operator/2.3.1" (optional)
+	UserAgent string
+	// RateLimiter enables client-side rate limiting (optional, disabled when nil)
+	RateLimiter *RateLimiterConfig
+	// AdaptiveThrottle delays requests as the rate-limit quota reported in response headers
+	// runs low (optional, disabled when nil)
+	AdaptiveThrottle *AdaptiveThrottleConfig
+	// CircuitBreaker fails requests fast while the API returns sustained server errors (optional, disabled when nil)
+	CircuitBreaker *CircuitBreakerConfig
+	// ResponseCache enables conditional GETs: responses with an ETag are cached and
+	// revalidated with If-None-Match, and a 304 returns the cached body (optional, disabled when nil)
+	ResponseCache ResponseCache
+	// IdempotencyKeys generates an Idempotency-Key header for every POST request without
+	// one from Request.IdempotencyKey or WithIdempotencyKey, kept across the attempts of
+	// a request retried with WithRetry (optional)
+	IdempotencyKeys bool
+	// GzipRequestThreshold gzips request bodies of at least this many bytes, e.g. for bulk
+	// schema imports (optional, zero disables). Responses are always accepted gzip-encoded
+	// and decompressed transparently.
+	GzipRequestThreshold int
+	// Signer signs every request as the last step before it is sent, after all headers
+	// are set, e.g. for gateways requiring HMAC signatures (optional)
+	Signer Signer
+	// OnAPIDrift receives warnings when responses carry Deprecation, Sunset, or Warning
+	// headers, or contain fields the decoded types do not model (optional)
+	OnAPIDrift func(APIDrift)
+	// DriftSampleRate is the fraction (0-1) of decoded responses checked for unknown
+	// fields and reported to OnAPIDrift (optional, zero checks none)
+	DriftSampleRate float64
+	// OnUnknownFields is called with the unknown fields whenever a decoded response has
+	// fields the target type does not model; path is a PathTemplate (optional)
+	OnUnknownFields func(method, path string, fields []string)
+	// DryRun short-circuits mutating requests (POST, PUT, PATCH, DELETE): they are reported to
+	// OnDryRun and logged instead of sent, and return a synthetic success response (optional)
+	DryRun bool
+	// OnDryRun receives every request short-circuited by dry-run mode (optional)
+	OnDryRun func(DryRunRequest)
+	// Clock is used by the rate limiter, circuit breaker, and token exchange
+	// (optional, defaults to clock.Real)
+	Clock clock.Clock
+	// Middlewares wrap every HTTP round trip, the first being the outermost (optional)
+	Middlewares []Middleware
+	// Debug writes full wire-level dumps of every request and response to DebugOutput,
+	// with credentials and secret fields redacted (optional)
+	Debug bool
+	// DebugOutput receives the dumps written when Debug is set (optional, defaults to os.Stderr)
+	DebugOutput io.Writer
+	// AuditHook is called after every mutating request (POST, PUT, PATCH, DELETE),
+	// including failed and dry-run ones, to record who changed what (optional)
+	AuditHook func(ctx context.Context, event AuditEvent)
+	// Logger receives debug-level logs of every request with credentials redacted (optional)
+	Logger *slog.Logger
 }
 
+// Doer executes API requests. *Client implements it; managers accept a Doer so
+// they can be tested with lightweight fakes instead of HTTP servers.
+type Doer interface {
+	Do(ctx context.Context, req Request) (*Response, error)
+}
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc func(ctx context.Context, req Request) (*Response, error)
+
+// Do implements Doer.
+func (f DoerFunc) Do(ctx context.Context, req Request) (*Response, error) {
+	return f(ctx, req)
+}
+
+var _ Doer = (*Client)(nil)
+
 // Client is a REST-based HTTP client for Confluent Cloud and Platform APIs.
 type Client struct {
 	config     Config
 	httpClient *http.Client
+	limiter    *rateLimiter
+	throttle   *adaptiveThrottle
+	breaker    *circuitBreaker
+	clock      clock.Clock
+	authMode   AuthMode
+	roundTrip  RoundTripFunc
+
+	// Set by With on derived clients
+	basePath string
+	headers  map[string]string
+	retrier  Retrier
 }
 
 // NewClient creates a new Confluent REST client with the given configuration.
@@ -36,30 +144,51 @@ func NewClient(config Config) (*Client, error) {
 	if config.BaseURL == "" {
 		return nil, fmt.Errorf("BaseURL is required in config")
 	}
-	if config.APIKey == "" {
-		return nil, fmt.Errorf("APIKey is required in config")
-	}
-	if config.APISecret == "" {
-		return nil, fmt.Errorf("APISecret is required in config")
+	authMode, err := resolveAuthMode(config)
+	if err != nil {
+		return nil, err
 	}
 
-	httpClient := config.HTTPClient
-	if httpClient == nil {
-		httpClient = http.DefaultClient
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
 	}
+	clk := clock.OrReal(config.Clock)
 
 	return &Client{
 		config:     config,
 		httpClient: httpClient,
+		limiter:    newRateLimiter(config.RateLimiter, clk),
+		throttle:   newAdaptiveThrottle(config.AdaptiveThrottle, clk),
+		breaker:    newCircuitBreaker(config.CircuitBreaker, clk),
+		clock:      clk,
+		authMode:   authMode,
+		roundTrip:  chain(decompressing(signing(config.Signer, httpClient.Do)), config.Middlewares),
 	}, nil
 }
 
 // Request represents an HTTP request to the Confluent API.
 type Request struct {
-	Method  string
-	Path    string
-	Body    interface{}
-	Headers map[string]string
+	Method string
+	Path   string
+	// Body is sent as-is when it is an io.Reader (streamed) or []byte,
+	// and JSON-encoded otherwise
+	Body interface{}
+	// ContentType overrides the Content-Type header (optional, defaults to application/json)
+	ContentType string
+	// Multipart sends a multipart/form-data body instead of Body (optional)
+	Multipart *Multipart
+	Headers   map[string]string
+	// Timeout overrides Config.RequestTimeout for this request (optional)
+	Timeout time.Duration
+	// IdempotencyKey is sent in the Idempotency-Key header of a POST request
+	// (optional, see WithIdempotencyKey and Config.IdempotencyKeys)
+	IdempotencyKey string
+	// DryRun short-circuits this request if it is mutating, as Config.DryRun does (optional)
+	DryRun bool
+	// NoRetry sends this request once even if the client retries with WithRetry, for
+	// requests that are unsafe to replay after a partial failure (optional)
+	NoRetry bool
 }
 
 // Response represents an HTTP response from the Confluent API.
@@ -67,49 +196,227 @@ type Response struct {
 	StatusCode int
 	Body       []byte
 	Headers    http.Header
+	// DryRun is true for synthetic responses to requests short-circuited by dry-run mode
+	DryRun bool
+
+	drift           *driftDetector
+	onUnknownFields func(fields []string)
 }
 
 // Do executes an HTTP request to the Confluent API.
 func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
-	url := strings.TrimSuffix(c.config.BaseURL, "/") + "/" + strings.TrimPrefix(req.Path, "/")
+	req = c.derive(req)
+	// Resolve the idempotency key once so every retried attempt sends the same key
+	req.IdempotencyKey = c.idempotencyKey(ctx, req)
+	if c.config.AuditHook == nil || !isMutating(req.Method) {
+		return c.doWithRetry(ctx, req)
+	}
+	start := c.clock.Now()
+	resp, err := c.doWithRetry(ctx, req)
+	c.audit(ctx, req, resp, err, clock.Since(c.clock, start))
+	return resp, err
+}
+
+// doWithRetry executes req, retrying it with the strategy set by WithRetry.
+func (c *Client) doWithRetry(ctx context.Context, req Request) (*Response, error) {
+	if c.retrier == nil || req.NoRetry {
+		return c.do(ctx, req)
+	}
+	// Streamed and multipart bodies are consumed by the first attempt, so buffer them
+	// once to send the same body on every attempt
+	if err := bufferBody(&req); err != nil {
+		return nil, err
+	}
+	var resp *Response
+	err := c.retrier.Do(ctx, func() error {
+		var err error
+		resp, err = c.do(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+// bufferBody replaces a multipart or io.Reader body of req with its encoded bytes.
+func bufferBody(req *Request) error {
+	if req.Multipart != nil {
+		if req.Body != nil {
+			return fmt.Errorf("request cannot have both Body and Multipart")
+		}
+		data, contentType, err := req.Multipart.encode()
+		if err != nil {
+			return fmt.Errorf("failed to encode multipart body: %w", err)
+		}
+		req.Body, req.Multipart = data, nil
+		if req.ContentType == "" {
+			req.ContentType = contentType
+		}
+		return nil
+	}
+	if r, ok := req.Body.(io.Reader); ok {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = data
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, req Request) (*Response, error) {
+	timeout := c.config.RequestTimeout
+	if req.Timeout > 0 {
+		timeout = req.Timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	url := strings.TrimSuffix(c.baseURL(req), "/") + "/" + strings.TrimPrefix(req.Path, "/")
+
+	if req.Multipart != nil {
+		if err := bufferBody(&req); err != nil {
+			return nil, err
+		}
+	}
 
 	var body io.Reader
-	if req.Body != nil {
+	var reqBody []byte
+	switch b := req.Body.(type) {
+	case nil:
+	case []byte:
+		reqBody = b
+		body = bytes.NewReader(b)
+	case io.Reader:
+		body = b
+		if c.config.Signer != nil {
+			// The signer needs the body hash, so the stream must be buffered
+			data, err := io.ReadAll(b)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read request body: %w", err)
+			}
+			reqBody = data
+			body = bytes.NewReader(data)
+		}
+	default:
 		jsonBody, err := json.Marshal(req.Body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
+		reqBody = jsonBody
 		body = bytes.NewReader(jsonBody)
 	}
 
+	// sentBody is the body as sent on the wire, which the signer must hash
+	sentBody := reqBody
+	compressed := false
+	if threshold := c.config.GzipRequestThreshold; threshold > 0 && reqBody != nil && len(reqBody) >= threshold {
+		gz, err := gzipBody(reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compress request body: %w", err)
+		}
+		sentBody, compressed = gz, true
+		body = bytes.NewReader(gz)
+	}
+
 	httpReq, err := http.NewRequestWithContext(ctx, req.Method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 
-	// Set authentication headers
-	httpReq.SetBasicAuth(c.config.APIKey, c.config.APISecret)
-
 	// Set default headers
-	httpReq.Header.Set("Content-Type", "application/json")
+	contentType := "application/json"
+	if req.ContentType != "" {
+		contentType = req.ContentType
+	}
+	httpReq.Header.Set("Content-Type", contentType)
 	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent(c.config.UserAgent))
+	if compressed {
+		httpReq.Header.Set("Content-Encoding", "gzip")
+	}
+
+	if key := c.idempotencyKey(ctx, req); key != "" {
+		httpReq.Header.Set(IdempotencyKeyHeader, key)
+	}
 
 	// Set custom headers
 	for key, value := range req.Headers {
 		httpReq.Header.Set(key, value)
 	}
 
-	httpResp, err := c.httpClient.Do(httpReq)
+	if c.dryRun(req) {
+		resp := c.dryRunResponse(httpReq, req, reqBody)
+		c.logExchange(ctx, httpReq, reqBody, resp, 0, nil)
+		return resp, nil
+	}
+
+	// Set authentication headers. Dry runs return above so they never fetch tokens.
+	if err := c.authenticate(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx, req.Method, req.Path); err != nil {
+			return nil, fmt.Errorf("rate limiter wait cancelled: %w", err)
+		}
+	}
+
+	if c.throttle != nil {
+		if err := c.throttle.wait(ctx, EndpointClass(req.Method, req.Path)); err != nil {
+			return nil, fmt.Errorf("adaptive throttle wait cancelled: %w", err)
+		}
+	}
+
+	var cached *CachedResponse
+	if c.config.ResponseCache != nil && req.Method == http.MethodGet {
+		if entry, ok := c.config.ResponseCache.Get(url); ok && httpReq.Header.Get("If-None-Match") == "" {
+			cached = entry
+			httpReq.Header.Set("If-None-Match", entry.ETag)
+		}
+	}
+
+	if c.breaker != nil {
+		if !c.breaker.allow() {
+			return nil, ErrCircuitOpen
+		}
+	}
+
+	if c.config.Signer != nil {
+		// Signed as the last step of the round trip, after all headers are set
+		httpReq = httpReq.WithContext(context.WithValue(httpReq.Context(), bodyHashContextKey{}, bodyHash(sentBody)))
+	}
+
+	start := time.Now()
+	httpResp, err := c.roundTrip(httpReq)
+	var signErr *signError
+	if errors.As(err, &signErr) {
+		if c.breaker != nil {
+			c.breaker.release()
+		}
+		return nil, fmt.Errorf("failed to sign request: %w", signErr.err)
+	}
+	if c.breaker != nil {
+		c.breaker.record(err != nil || httpResp.StatusCode >= 500)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+		err = &api.NetworkError{Method: req.Method, Path: req.Path, IdempotencyKey: req.IdempotencyKey, Err: err}
+		c.logExchange(ctx, httpReq, reqBody, nil, time.Since(start), err)
+		return nil, err
 	}
 	defer func() {
 		_ = httpResp.Body.Close()
 	}()
+	if c.throttle != nil {
+		c.throttle.observe(EndpointClass(req.Method, req.Path), httpResp.Header)
+	}
 
 	respBody, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		err = fmt.Errorf("failed to read response body: %w", err)
+		c.logExchange(ctx, httpReq, reqBody, nil, time.Since(start), err)
+		return nil, err
 	}
 
 	resp := &Response{
@@ -117,19 +424,92 @@ func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
 		Body:       respBody,
 		Headers:    httpResp.Header,
 	}
+	if c.config.ResponseCache != nil {
+		resp = c.updateCache(url, req, resp, cached)
+	}
+	resp.drift = c.newDriftDetector(req.Method, req.Path, httpResp.Header)
+	if c.config.OnUnknownFields != nil {
+		method, path := req.Method, PathTemplate(req.Path)
+		resp.onUnknownFields = func(fields []string) { c.config.OnUnknownFields(method, path, fields) }
+	}
 
 	// Check for API errors
 	if httpResp.StatusCode >= 400 {
-		return resp, api.NewError(httpResp.StatusCode, respBody, httpResp.Header)
+		apiErr := api.NewError(httpResp.StatusCode, respBody, httpResp.Header)
+		apiErr.Method, apiErr.Path = req.Method, req.Path
+		c.logExchange(ctx, httpReq, reqBody, resp, time.Since(start), apiErr)
+		return resp, apiErr
 	}
 
+	c.logExchange(ctx, httpReq, reqBody, resp, time.Since(start), nil)
 	return resp, nil
 }
 
+// baseURL returns the endpoint override for the request's API family, or Config.BaseURL.
+func (c *Client) baseURL(req Request) string {
+	if endpoint, ok := c.config.Endpoints[EndpointClass(req.Method, req.Path)]; ok && endpoint != "" {
+		return endpoint
+	}
+	return c.config.BaseURL
+}
+
+// updateCache stores cacheable GET responses under key, the request URL, serves cached
+// bodies on 304 Not Modified, and drops cached entries for URLs that were modified.
+func (c *Client) updateCache(key string, req Request, resp *Response, cached *CachedResponse) *Response {
+	cache := c.config.ResponseCache
+	if req.Method != http.MethodGet {
+		if resp.StatusCode < 400 {
+			cache.Delete(key)
+		}
+		return resp
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return &Response{
+			StatusCode: cached.StatusCode,
+			Body:       cached.Body,
+			Headers:    cached.Headers,
+		}
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if etag := resp.Headers.Get("ETag"); etag != "" {
+			cache.Set(key, &CachedResponse{
+				ETag:       etag,
+				StatusCode: resp.StatusCode,
+				Body:       resp.Body,
+				Headers:    resp.Headers,
+			})
+		} else if cached != nil {
+			cache.Delete(key)
+		}
+	}
+	return resp
+}
+
+// RequestID returns the Confluent request ID of the response, or "" if not reported.
+func (r *Response) RequestID() string {
+	return r.Headers.Get(api.RequestIDHeader)
+}
+
+// RateLimit returns the rate-limit state reported in the response headers, if any.
+func (r *Response) RateLimit() (api.RateLimitInfo, bool) {
+	return api.ParseRateLimitHeaders(r.Headers)
+}
+
 // DecodeJSON decodes the response body as JSON into the provided value.
+// Use DecodeJSONStrict to fail on fields v does not model.
 func (r *Response) DecodeJSON(v interface{}) error {
 	if len(r.Body) == 0 {
 		return nil
 	}
-	return json.Unmarshal(r.Body, v)
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		return err
+	}
+	if r.onUnknownFields != nil {
+		if fields := unknownFields(r.Body, v); len(fields) > 0 {
+			r.reportUnknownFields(fields)
+		}
+	}
+	r.drift.checkBody(r.Body, v)
+	return nil
 }
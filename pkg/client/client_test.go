@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -382,6 +385,10 @@ func TestClientDo_Error_IsNotFound(t *testing.T) {
 		t.Errorf("Expected code 404, got %d", apiErr.Code)
 	}
 
+	if apiErr.Method != "GET" || apiErr.Path != "/clusters/nonexistent" {
+		t.Errorf("Expected failed request GET /clusters/nonexistent, got %s %s", apiErr.Method, apiErr.Path)
+	}
+
 	if apiErr.ErrorCode != "NOT_FOUND" {
 		t.Errorf("Expected error code 'NOT_FOUND', got %s", apiErr.ErrorCode)
 	}
@@ -565,3 +572,146 @@ func TestClientDo_Error_IsServerError(t *testing.T) {
 		t.Errorf("Expected IsRetryable() to return true for server errors")
 	}
 }
+
+func TestClientDo_ExposesRequestIDAndRateLimit(t *testing.T) {
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "30")
+		if fail {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	resp, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if resp.RequestID() != "req-123" {
+		t.Errorf("Expected request ID req-123, got %q", resp.RequestID())
+	}
+	limit, ok := resp.RateLimit()
+	if !ok || limit.Limit != 100 || limit.Remaining != 42 || limit.Reset != 30*time.Second {
+		t.Errorf("Unexpected rate limit %+v (ok=%v)", limit, ok)
+	}
+
+	fail = true
+	_, err = c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"})
+	apiErr, ok := err.(*api.Error)
+	if !ok {
+		t.Fatalf("Expected *api.Error, got %T", err)
+	}
+	if apiErr.RequestID() != "req-123" {
+		t.Errorf("Expected error request ID req-123, got %q", apiErr.RequestID())
+	}
+}
+
+func TestClientDo_ErrorDetailsArray(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"id":"e-1","status":"400","code":"invalid_input","detail":"display_name is too long","source":{"pointer":"/spec/display_name"}},{"status":"400","code":"missing_field","title":"Missing field"}]}`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	_, err = c.Do(context.Background(), client.Request{Method: "POST", Path: "/org/v2/environments"})
+	apiErr, ok := err.(*api.Error)
+	if !ok {
+		t.Fatalf("Expected *api.Error, got %T", err)
+	}
+	if apiErr.Message != "display_name is too long" {
+		t.Errorf("Expected primary message from first detail, got %q", apiErr.Message)
+	}
+	if apiErr.ErrorCode != api.ErrorCodeInvalidRequest {
+		t.Errorf("Expected error code %s, got %s", api.ErrorCodeInvalidRequest, apiErr.ErrorCode)
+	}
+	first := apiErr.FirstDetail()
+	if first == nil || first.Source == nil || first.Source.Pointer != "/spec/display_name" {
+		t.Errorf("Unexpected first detail %+v", first)
+	}
+	if !apiErr.HasCode("missing_field") || apiErr.HasCode("conflict") {
+		t.Errorf("HasCode did not match the errors array: %+v", apiErr.Errors)
+	}
+}
+
+func TestClientDo_RawAndStreamedBodies(t *testing.T) {
+	var gotBody, gotType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotBody, gotType = string(b), r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		body        interface{}
+		contentType string
+		wantBody    string
+		wantType    string
+	}{
+		{"reader", strings.NewReader("PK\x03\x04plugin"), "application/zip", "PK\x03\x04plugin", "application/zip"},
+		{"bytes", []byte("key=value"), "text/plain", "key=value", "text/plain"},
+		{"json", map[string]string{"a": "b"}, "", `{"a":"b"}`, "application/json"},
+	}
+	for _, tt := range tests {
+		_, err := c.Do(context.Background(), client.Request{Method: "PUT", Path: "/upload", Body: tt.body, ContentType: tt.contentType})
+		if err != nil {
+			t.Fatalf("%s: Do failed: %v", tt.name, err)
+		}
+		if gotBody != tt.wantBody || gotType != tt.wantType {
+			t.Errorf("%s: got body %q (%s), want %q (%s)", tt.name, gotBody, gotType, tt.wantBody, tt.wantType)
+		}
+	}
+}
+
+func TestClientDo_NetworkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	c, err := client.NewClient(client.Config{BaseURL: url, APIKey: "test-key", APISecret: "test-secret"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	_, err = c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"})
+	var netErr *api.NetworkError
+	if !errors.As(err, &netErr) {
+		t.Fatalf("Expected *api.NetworkError, got %v", err)
+	}
+	if !netErr.IsRetryable() || netErr.Path != "/org/v2/environments" {
+		t.Errorf("Unexpected network error %+v", netErr)
+	}
+}
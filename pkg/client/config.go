@@ -0,0 +1,159 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DefaultBaseURL is the Confluent Cloud API base URL.
+const DefaultBaseURL = "https://api.confluent.cloud"
+
+// Environment variables read by ConfigFromEnv.
+const (
+	EnvAPIKey     = "CONFLUENT_CLOUD_API_KEY"
+	EnvAPISecret  = "CONFLUENT_CLOUD_API_SECRET"
+	EnvBaseURL    = "CONFLUENT_CLOUD_BASE_URL"
+	EnvUserAgent  = "CONFLUENT_USER_AGENT"
+	EnvKafkaREST  = "KAFKA_REST_ENDPOINT"
+	EnvSchemaREST = "SCHEMA_REGISTRY_REST_ENDPOINT"
+	// EnvEndpointPrefix overrides the endpoint of an API family, e.g.
+	// CONFLUENT_ENDPOINT_SCHEMA_REGISTRY sets Endpoints["schema-registry"]
+	EnvEndpointPrefix = "CONFLUENT_ENDPOINT_"
+)
+
+// ConfigFromEnv builds a Config from environment variables:
+//   - CONFLUENT_CLOUD_API_KEY and CONFLUENT_CLOUD_API_SECRET (required)
+//   - CONFLUENT_CLOUD_BASE_URL (optional, defaults to DefaultBaseURL)
+//   - CONFLUENT_USER_AGENT (optional)
+//   - KAFKA_REST_ENDPOINT and SCHEMA_REGISTRY_REST_ENDPOINT (optional endpoint overrides)
+//   - CONFLUENT_ENDPOINT_<FAMILY> (optional endpoint overrides, e.g. CONFLUENT_ENDPOINT_CONNECT)
+func ConfigFromEnv() (Config, error) {
+	config := Config{
+		BaseURL:   os.Getenv(EnvBaseURL),
+		APIKey:    os.Getenv(EnvAPIKey),
+		APISecret: os.Getenv(EnvAPISecret),
+		UserAgent: os.Getenv(EnvUserAgent),
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+
+	setEndpoint := func(class, url string) {
+		if url == "" {
+			return
+		}
+		if config.Endpoints == nil {
+			config.Endpoints = make(map[string]string)
+		}
+		config.Endpoints[class] = url
+	}
+	setEndpoint("kafka", os.Getenv(EnvKafkaREST))
+	setEndpoint("schema-registry", os.Getenv(EnvSchemaREST))
+	for _, kv := range os.Environ() {
+		name, value, _ := strings.Cut(kv, "=")
+		if family, ok := strings.CutPrefix(name, EnvEndpointPrefix); ok && family != "" {
+			setEndpoint(strings.ReplaceAll(strings.ToLower(family), "_", "-"), value)
+		}
+	}
+
+	if config.APIKey == "" || config.APISecret == "" {
+		return config, fmt.Errorf("%s and %s must be set", EnvAPIKey, EnvAPISecret)
+	}
+	return config, nil
+}
+
+// fileConfig is the JSON format read by ConfigFromFile.
+type fileConfig struct {
+	BaseURL   string            `json:"base_url"`
+	APIKey    string            `json:"api_key"`
+	APISecret string            `json:"api_secret"`
+	UserAgent string            `json:"user_agent"`
+	Endpoints map[string]string `json:"endpoints"`
+}
+
+// ConfigFromFile builds a Config from a JSON file of the form:
+//
+//	{
+//	  "base_url": "https://api.confluent.cloud",
+//	  "api_key": "...",
+//	  "api_secret": "...",
+//	  "user_agent": "orders-operator/1.0",
+//	  "endpoints": {"kafka": "https://pkc-123.us-east-1.aws.confluent.cloud:443"}
+//	}
+//
+// base_url is optional and defaults to DefaultBaseURL.
+func ConfigFromFile(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var fc fileConfig
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return Config{}, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	config := Config{
+		BaseURL:   fc.BaseURL,
+		APIKey:    fc.APIKey,
+		APISecret: fc.APISecret,
+		UserAgent: fc.UserAgent,
+		Endpoints: fc.Endpoints,
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+	if config.APIKey == "" || config.APISecret == "" {
+		return config, fmt.Errorf("config file %s must set api_key and api_secret", path)
+	}
+	return config, nil
+}
+
+// cliConfig is the subset of the confluent CLI's config.json read by ConfigFromCLI.
+type cliConfig struct {
+	CurrentContext string `json:"current_context"`
+	Contexts       map[string]struct {
+		Platform   string `json:"platform"`
+		Credential string `json:"credential"`
+	} `json:"contexts"`
+	Platforms map[string]struct {
+		Server string `json:"server"`
+	} `json:"platforms"`
+	Credentials map[string]struct {
+		APIKeyPair *struct {
+			Key    string `json:"api_key"`
+			Secret string `json:"api_secret"`
+		} `json:"api_key_pair"`
+	} `json:"credentials"`
+}
+
+// ConfigFromCLI builds a Config from the current context of the confluent CLI's
+// config.json (typically ~/.confluent/config.json). Only contexts authenticated with
+// an API key are supported; login sessions carry no reusable credentials.
+func ConfigFromCLI(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read confluent CLI config: %w", err)
+	}
+	var cc cliConfig
+	if err := json.Unmarshal(data, &cc); err != nil {
+		return Config{}, fmt.Errorf("failed to parse confluent CLI config %s: %w", path, err)
+	}
+
+	ctx, ok := cc.Contexts[cc.CurrentContext]
+	if !ok {
+		return Config{}, fmt.Errorf("confluent CLI config %s has no current context", path)
+	}
+	config := Config{BaseURL: DefaultBaseURL}
+	if platform, ok := cc.Platforms[ctx.Platform]; ok && platform.Server != "" {
+		config.BaseURL = platform.Server
+	}
+	cred, ok := cc.Credentials[ctx.Credential]
+	if !ok || cred.APIKeyPair == nil || cred.APIKeyPair.Key == "" {
+		return config, fmt.Errorf("confluent CLI context %q is not authenticated with an API key", cc.CurrentContext)
+	}
+	config.APIKey = cred.APIKeyPair.Key
+	config.APISecret = cred.APIKeyPair.Secret
+	return config, nil
+}
@@ -0,0 +1,130 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv(client.EnvAPIKey, "env-key")
+	t.Setenv(client.EnvAPISecret, "env-secret")
+	t.Setenv(client.EnvBaseURL, "")
+	t.Setenv(client.EnvKafkaREST, "https://pkc-123.confluent.cloud:443")
+	t.Setenv("CONFLUENT_ENDPOINT_SCHEMA_REGISTRY", "https://psrc-123.confluent.cloud")
+
+	config, err := client.ConfigFromEnv()
+	if err != nil {
+		t.Fatalf("ConfigFromEnv failed: %v", err)
+	}
+	if config.APIKey != "env-key" || config.APISecret != "env-secret" {
+		t.Errorf("unexpected credentials %q/%q", config.APIKey, config.APISecret)
+	}
+	if config.BaseURL != client.DefaultBaseURL {
+		t.Errorf("BaseURL = %q, want %q", config.BaseURL, client.DefaultBaseURL)
+	}
+	if got := config.Endpoints["kafka"]; got != "https://pkc-123.confluent.cloud:443" {
+		t.Errorf("kafka endpoint = %q", got)
+	}
+	if got := config.Endpoints["schema-registry"]; got != "https://psrc-123.confluent.cloud" {
+		t.Errorf("schema-registry endpoint = %q", got)
+	}
+}
+
+func TestConfigFromEnv_MissingCredentials(t *testing.T) {
+	t.Setenv(client.EnvAPIKey, "")
+	t.Setenv(client.EnvAPISecret, "")
+
+	if _, err := client.ConfigFromEnv(); err == nil {
+		t.Fatal("expected error for missing credentials")
+	}
+}
+
+func TestConfigFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "confluent.json")
+	data := `{"api_key": "file-key", "api_secret": "file-secret", "endpoints": {"kafka": "https://pkc-123.confluent.cloud:443"}}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := client.ConfigFromFile(path)
+	if err != nil {
+		t.Fatalf("ConfigFromFile failed: %v", err)
+	}
+	if config.APIKey != "file-key" || config.APISecret != "file-secret" {
+		t.Errorf("unexpected credentials %q/%q", config.APIKey, config.APISecret)
+	}
+	if config.BaseURL != client.DefaultBaseURL {
+		t.Errorf("BaseURL = %q, want %q", config.BaseURL, client.DefaultBaseURL)
+	}
+	if got := config.Endpoints["kafka"]; got != "https://pkc-123.confluent.cloud:443" {
+		t.Errorf("kafka endpoint = %q", got)
+	}
+
+	if _, err := client.ConfigFromFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestConfigFromCLI(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{
+		"current_context": "api-key-context",
+		"contexts": {"api-key-context": {"platform": "cloud", "credential": "api-key-ABC"}},
+		"platforms": {"cloud": {"server": "https://confluent.cloud"}},
+		"credentials": {"api-key-ABC": {"api_key_pair": {"api_key": "cli-key", "api_secret": "cli-secret"}}}
+	}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := client.ConfigFromCLI(path)
+	if err != nil {
+		t.Fatalf("ConfigFromCLI failed: %v", err)
+	}
+	if config.APIKey != "cli-key" || config.APISecret != "cli-secret" {
+		t.Errorf("unexpected credentials %q/%q", config.APIKey, config.APISecret)
+	}
+	if config.BaseURL != "https://confluent.cloud" {
+		t.Errorf("BaseURL = %q", config.BaseURL)
+	}
+}
+
+func TestClientDo_EndpointOverride(t *testing.T) {
+	var cloudHits, kafkaHits int
+	cloud := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cloudHits++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer cloud.Close()
+	kafka := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kafkaHits++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer kafka.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   cloud.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		Endpoints: map[string]string{"kafka": kafka.URL},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := c.Do(ctx, client.Request{Method: "GET", Path: "/kafka/v3/clusters/lkc-1/topics"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if _, err := c.Do(ctx, client.Request{Method: "GET", Path: "/org/v2/environments"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if cloudHits != 1 || kafkaHits != 1 {
+		t.Errorf("cloud hits = %d, kafka hits = %d, want 1 each", cloudHits, kafkaHits)
+	}
+}
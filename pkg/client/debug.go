@@ -0,0 +1,56 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// debugMu serializes dumps so concurrent requests do not interleave their output.
+var debugMu sync.Mutex
+
+// dumpExchange writes a wire-level dump of a request and its response to the debug
+// output when Config.Debug is set. Credentials in headers and sensitive JSON fields
+// (secrets, passwords, tokens, ...) are redacted. resp is nil when no response was received.
+func (c *Client) dumpExchange(httpReq *http.Request, reqBody []byte, resp *Response, err error) {
+	if !c.config.Debug {
+		return
+	}
+	out := c.config.DebugOutput
+	if out == nil {
+		out = os.Stderr
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "---> %s %s %s\r\n", httpReq.Method, httpReq.URL.RequestURI(), httpReq.Proto)
+	fmt.Fprintf(&b, "Host: %s\r\n", httpReq.URL.Host)
+	_ = RedactHeaders(httpReq.Header).Write(&b)
+	b.WriteString("\r\n")
+	switch {
+	case len(reqBody) > 0:
+		b.Write(RedactBody(reqBody))
+		b.WriteString("\n")
+	case httpReq.Body != nil && httpReq.Body != http.NoBody:
+		b.WriteString("[streamed body not shown]\n")
+	}
+
+	if resp != nil {
+		fmt.Fprintf(&b, "<--- %d %s\r\n", resp.StatusCode, http.StatusText(resp.StatusCode))
+		_ = RedactHeaders(resp.Headers).Write(&b)
+		b.WriteString("\r\n")
+		if len(resp.Body) > 0 {
+			b.Write(RedactBody(resp.Body))
+			b.WriteString("\n")
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(&b, "<--- error: %v\n", err)
+	}
+
+	debugMu.Lock()
+	defer debugMu.Unlock()
+	_, _ = io.Copy(out, &b)
+}
@@ -0,0 +1,51 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_DebugDumpRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"id":"key-1","spec":{"secret":"s3cr3t-from-server"}}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		APIKey:      "test-key",
+		APISecret:   "very-secret",
+		Debug:       true,
+		DebugOutput: &out,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	_, _ = c.Do(context.Background(), client.Request{
+		Method: "POST",
+		Path:   "/iam/v2/api-keys",
+		Body:   map[string]string{"display_name": "ci", "password": "hunter2"},
+	})
+
+	dump := out.String()
+	for _, want := range []string{"---> POST /iam/v2/api-keys", "<--- 400 Bad Request", `"display_name":"ci"`, "[REDACTED]"} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("dump missing %q:\n%s", want, dump)
+		}
+	}
+	for _, secret := range []string{"hunter2", "s3cr3t-from-server", "Basic "} {
+		if strings.Contains(dump, secret) {
+			t.Errorf("dump leaked %q:\n%s", secret, dump)
+		}
+	}
+}
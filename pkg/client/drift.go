@@ -0,0 +1,196 @@
+package client
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/creiche/confluent-go/pkg/api"
+)
+
+// Kinds of API drift reported to Config.OnAPIDrift.
+const (
+	// DriftDeprecated means the response carried a Deprecation header
+	DriftDeprecated = "deprecated"
+	// DriftSunset means the response carried a Sunset header announcing removal
+	DriftSunset = "sunset"
+	// DriftWarning means the response carried a Warning header
+	DriftWarning = "warning"
+	// DriftUnknownField means the response body had fields the target type does not model
+	DriftUnknownField = "unknown_field"
+)
+
+// APIDrift describes a sign that the API has changed in ways this library may not
+// handle yet, such as deprecation headers or unmodeled response fields.
+type APIDrift struct {
+	// Kind is one of the Drift* constants
+	Kind string
+	// Method and Path identify the endpoint; Path is a PathTemplate
+	Method string
+	Path   string
+	// Detail is the header value, or the dotted path of an unknown field
+	Detail string
+	// RequestID is the Confluent request ID of the response
+	RequestID string
+}
+
+// driftHeaders maps response headers to the drift kind they signal.
+var driftHeaders = []struct {
+	header string
+	kind   string
+}{
+	{"Deprecation", DriftDeprecated},
+	{"Sunset", DriftSunset},
+	{"Warning", DriftWarning},
+}
+
+// driftDetector reports API drift for one response.
+type driftDetector struct {
+	report    func(APIDrift)
+	method    string
+	path      string
+	requestID string
+	sampled   bool
+}
+
+// newDriftDetector inspects the response headers for drift and decides whether the
+// body is sampled for unknown-field detection. It returns nil when drift detection is disabled.
+func (c *Client) newDriftDetector(method, path string, headers http.Header) *driftDetector {
+	if c.config.OnAPIDrift == nil {
+		return nil
+	}
+	d := &driftDetector{
+		report:    c.config.OnAPIDrift,
+		method:    method,
+		path:      PathTemplate(path),
+		requestID: headers.Get(api.RequestIDHeader),
+		sampled:   c.config.DriftSampleRate >= 1 || (c.config.DriftSampleRate > 0 && rand.Float64() < c.config.DriftSampleRate),
+	}
+	for _, h := range driftHeaders {
+		for _, value := range headers.Values(h.header) {
+			d.emit(h.kind, value)
+		}
+	}
+	return d
+}
+
+func (d *driftDetector) emit(kind, detail string) {
+	d.report(APIDrift{Kind: kind, Method: d.method, Path: d.path, Detail: detail, RequestID: d.requestID})
+}
+
+// checkBody reports fields of body that v does not model, if this response was sampled.
+func (d *driftDetector) checkBody(body []byte, v interface{}) {
+	if d == nil || !d.sampled {
+		return
+	}
+	for _, field := range unknownFields(body, v) {
+		d.emit(DriftUnknownField, field)
+	}
+}
+
+// unknownFields returns the dotted paths of JSON object keys in data that would be
+// ignored when decoding into v (e.g., "data[].spec.new_field"), sorted.
+func unknownFields(data []byte, v interface{}) []string {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+	var fields []string
+	collectUnknownFields(raw, reflect.TypeOf(v), "", &fields)
+	sort.Strings(fields)
+	return fields
+}
+
+func collectUnknownFields(raw interface{}, t reflect.Type, path string, fields *[]string) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return
+	}
+	switch value := raw.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for key, elem := range value {
+				collectUnknownFields(elem, t.Elem(), joinFieldPath(path, key), fields)
+			}
+		case reflect.Struct:
+			known := structFields(t)
+			for key, elem := range value {
+				ft, ok := lookupField(known, key)
+				if !ok {
+					*fields = append(*fields, joinFieldPath(path, key))
+					continue
+				}
+				collectUnknownFields(elem, ft, joinFieldPath(path, key), fields)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, elem := range value {
+				collectUnknownFields(elem, t.Elem(), path+"[]", fields)
+			}
+		}
+	}
+}
+
+// structFields returns the JSON field names of a struct type, including fields
+// promoted from embedded structs, mapped to their types.
+func structFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range structFields(ft) {
+					if _, exists := fields[k]; !exists {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// lookupField matches a JSON key to a struct field the way encoding/json does:
+// exact match first, then case-insensitive.
+func lookupField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,64 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_ReportsAPIDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Sunset", "Wed, 01 Jan 2025 00:00:00 GMT")
+		w.Header().Set("X-Request-Id", "req-1")
+		_, _ = w.Write([]byte(`{"data":[{"id":"env-1","display_name":"prod","stream_governance":{"package":"ESSENTIALS"}}],"metadata":{"next":""}}`))
+	}))
+	defer server.Close()
+
+	var drifts []client.APIDrift
+	c, err := client.NewClient(client.Config{
+		BaseURL:         server.URL,
+		APIKey:          "test-key",
+		APISecret:       "test-secret",
+		OnAPIDrift:      func(d client.APIDrift) { drifts = append(drifts, d) },
+		DriftSampleRate: 1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	resp, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	var result struct {
+		Data []api.Environment `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		t.Fatalf("DecodeJSON failed: %v", err)
+	}
+
+	var got []string
+	for _, d := range drifts {
+		if d.Path != "/org/v2/environments" || d.RequestID != "req-1" {
+			t.Errorf("unexpected drift context %+v", d)
+		}
+		got = append(got, d.Kind+":"+d.Detail)
+	}
+	sort.Strings(got)
+	want := []string{
+		"deprecated:true",
+		"sunset:Wed, 01 Jan 2025 00:00:00 GMT",
+		"unknown_field:data[].stream_governance",
+		"unknown_field:metadata",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("drifts = %v, want %v", got, want)
+	}
+}
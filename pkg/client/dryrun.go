@@ -0,0 +1,58 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// DryRunRequest describes a mutating request that was not sent because of dry-run mode.
+type DryRunRequest struct {
+	Method string
+	Path   string
+	// Headers are the request headers with credentials redacted
+	Headers http.Header
+	// Body is the request body with secret fields redacted
+	Body []byte
+}
+
+// isMutating reports whether an HTTP method changes server-side state.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// dryRun reports whether req must be short-circuited instead of sent.
+func (c *Client) dryRun(req Request) bool {
+	return (c.config.DryRun || req.DryRun) && isMutating(req.Method)
+}
+
+// dryRunResponse records a short-circuited request and returns the synthetic success
+// response for it: the request body echoed back when it is a JSON object, so that
+// create and update calls decode the desired resource, and 204 No Content otherwise.
+func (c *Client) dryRunResponse(httpReq *http.Request, req Request, reqBody []byte) *Response {
+	if c.config.OnDryRun != nil {
+		headers := RedactHeaders(httpReq.Header)
+		if c.authMode != AuthModeNone && headers.Get("Authorization") == "" {
+			// Credentials are not resolved for dry runs; mark where they would be sent
+			headers.Set("Authorization", redactedValue)
+		}
+		c.config.OnDryRun(DryRunRequest{
+			Method:  req.Method,
+			Path:    req.Path,
+			Headers: headers,
+			Body:    RedactBody(reqBody),
+		})
+	}
+
+	resp := &Response{StatusCode: http.StatusNoContent, Headers: http.Header{}, DryRun: true}
+	var obj map[string]interface{}
+	if req.Method != http.MethodDelete && json.Unmarshal(reqBody, &obj) == nil {
+		resp.StatusCode = http.StatusOK
+		resp.Body = reqBody
+		resp.Headers.Set("Content-Type", "application/json")
+	}
+	return resp
+}
@@ -0,0 +1,132 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_DryRun(t *testing.T) {
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	var recorded []client.DryRunRequest
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		DryRun:    true,
+		OnDryRun:  func(r client.DryRunRequest) { recorded = append(recorded, r) },
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	ctx := context.Background()
+
+	resp, err := c.Do(ctx, client.Request{
+		Method: "POST",
+		Path:   "/iam/v2/api-keys",
+		Body:   map[string]string{"display_name": "orders", "secret": "s3cr3t"},
+	})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	var created struct {
+		DisplayName string `json:"display_name"`
+	}
+	if err := resp.DecodeJSON(&created); err != nil {
+		t.Fatalf("DecodeJSON failed: %v", err)
+	}
+	if !resp.DryRun || created.DisplayName != "orders" {
+		t.Errorf("Expected echoed dry-run response, got %+v %s", resp, resp.Body)
+	}
+
+	resp, err = c.Do(ctx, client.Request{Method: "DELETE", Path: "/iam/v2/api-keys/KEY1"})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if !resp.DryRun || resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected 204 dry-run response, got %d", resp.StatusCode)
+	}
+
+	if _, err := c.Do(ctx, client.Request{Method: "GET", Path: "/iam/v2/api-keys"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	if len(sent) != 1 || sent[0] != "GET" {
+		t.Errorf("Expected only the GET to be sent, got %v", sent)
+	}
+	if len(recorded) != 2 {
+		t.Fatalf("Expected 2 recorded requests, got %d", len(recorded))
+	}
+	if strings.Contains(string(recorded[0].Body), "s3cr3t") || recorded[0].Headers.Get("Authorization") == "" ||
+		strings.Contains(recorded[0].Headers.Get("Authorization"), "Basic") {
+		t.Errorf("Expected redacted recording, got %s %v", recorded[0].Body, recorded[0].Headers)
+	}
+}
+
+func TestClientDo_RequestDryRun(t *testing.T) {
+	var sent int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{BaseURL: server.URL, APIKey: "test-key", APISecret: "test-secret"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	resp, err := c.Do(context.Background(), client.Request{Method: "PUT", Path: "/org/v2/environments/env-1", DryRun: true})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if sent != 0 || !resp.DryRun {
+		t.Errorf("Expected request to be short-circuited, sent=%d", sent)
+	}
+}
+
+type countingTokenSource struct{ calls int }
+
+func (s *countingTokenSource) Token(ctx context.Context) (*client.Token, error) {
+	s.calls++
+	return &client.Token{AccessToken: "t"}, nil
+}
+
+func TestClientDo_DryRunSkipsTokenFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s during dry run", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	tokens := &countingTokenSource{}
+	var recorded []client.DryRunRequest
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		TokenSource: tokens,
+		DryRun:      true,
+		OnDryRun:    func(r client.DryRunRequest) { recorded = append(recorded, r) },
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if _, err := c.Do(context.Background(), client.Request{Method: "DELETE", Path: "/kafka/v3/clusters/lkc-1/topics/orders"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if tokens.calls != 0 {
+		t.Errorf("Expected no token fetch during dry run, got %d", tokens.calls)
+	}
+	if len(recorded) != 1 || recorded[0].Headers.Get("Authorization") != "[REDACTED]" {
+		t.Errorf("Expected redacted Authorization placeholder, got %+v", recorded)
+	}
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// gzipBody compresses a request body.
+func gzipBody(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompressing wraps the innermost round trip to request gzip-encoded responses and
+// decompress them, so middlewares and callers always see plain bodies regardless of
+// the HTTP client's transport.
+func decompressing(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Accept-Encoding") == "" {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+		resp, err := next(req)
+		if err != nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+			return resp, err
+		}
+		zr, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			if err == io.EOF {
+				// Empty body, e.g. 204 No Content with a Content-Encoding header
+				resp.Header.Del("Content-Encoding")
+				return resp, nil
+			}
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("failed to decompress response: %w", err)
+		}
+		resp.Body = &gzipReadCloser{Reader: zr, body: resp.Body}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+		return resp, nil
+	}
+}
+
+// gzipReadCloser closes the underlying response body along with the gzip reader.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (r *gzipReadCloser) Close() error {
+	_ = r.Reader.Close()
+	return r.body.Close()
+}
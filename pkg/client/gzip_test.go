@@ -0,0 +1,74 @@
+package client_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_Gzip(t *testing.T) {
+	var gotEncoding, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotEncoding = r.Header.Get("Content-Encoding")
+		body := io.Reader(r.Body)
+		if gotEncoding == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Errorf("invalid gzip request body: %v", err)
+				return
+			}
+			body = zr
+		}
+		data, _ := io.ReadAll(body)
+		gotBody = string(data)
+
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Expected Accept-Encoding gzip, got %q", r.Header.Get("Accept-Encoding"))
+		}
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, _ = zw.Write([]byte(`{"data":[]}`))
+		_ = zw.Close()
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(buf.Bytes())
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:              server.URL,
+		APIKey:               "test-key",
+		APISecret:            "test-secret",
+		GzipRequestThreshold: 100,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	ctx := context.Background()
+
+	large := map[string]string{"schema": strings.Repeat("x", 200)}
+	resp, err := c.Do(ctx, client.Request{Method: "POST", Path: "/subjects/orders/versions", Body: large})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if gotEncoding != "gzip" || !strings.Contains(gotBody, strings.Repeat("x", 200)) {
+		t.Errorf("Expected gzipped request body, got encoding %q", gotEncoding)
+	}
+	if string(resp.Body) != `{"data":[]}` || resp.Headers.Get("Content-Encoding") != "" {
+		t.Errorf("Expected decompressed response, got %q", resp.Body)
+	}
+
+	if _, err := c.Do(ctx, client.Request{Method: "POST", Path: "/subjects/orders/versions", Body: map[string]string{"schema": "x"}}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if gotEncoding != "" {
+		t.Errorf("Expected small body to be sent uncompressed, got %q", gotEncoding)
+	}
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// IdempotencyKeyHeader is the header carrying the idempotency key of a mutating request.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context whose POST requests carry key as their
+// idempotency key. An empty key is replaced by NewIdempotencyKey().
+//
+// Retrying an operation re-issues its requests, so set the key on the context
+// outside the retry loop to keep it stable across attempts:
+//
+//	ctx = client.WithIdempotencyKey(ctx, "")
+//	err := retry.DefaultStrategy().Do(ctx, func() error {
+//		_, err := serviceAccounts.CreateServiceAccount(ctx, "orders", "Orders service")
+//		return err
+//	})
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		key = NewIdempotencyKey()
+	}
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key set by WithIdempotencyKey.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, ok
+}
+
+// NewIdempotencyKey returns a random UUIDv4 suitable as an idempotency key.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("client: failed to generate idempotency key: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// idempotencyKey returns the idempotency key for req, in order of precedence:
+// Request.IdempotencyKey, the context key, and a generated key when
+// Config.IdempotencyKeys is set. Only POST requests get a key.
+func (c *Client) idempotencyKey(ctx context.Context, req Request) string {
+	if req.Method != http.MethodPost {
+		return ""
+	}
+	if req.IdempotencyKey != "" {
+		return req.IdempotencyKey
+	}
+	if key, ok := IdempotencyKeyFromContext(ctx); ok {
+		return key
+	}
+	if c.config.IdempotencyKeys {
+		return NewIdempotencyKey()
+	}
+	return ""
+}
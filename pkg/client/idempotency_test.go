@@ -0,0 +1,100 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/retry"
+)
+
+func TestClientDo_IdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(client.IdempotencyKeyHeader))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:         server.URL,
+		APIKey:          "test-key",
+		APISecret:       "test-secret",
+		IdempotencyKeys: true,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	ctx := context.Background()
+	post := client.Request{Method: "POST", Path: "/iam/v2/service-accounts", Body: map[string]string{}}
+	keyed := client.WithIdempotencyKey(ctx, "")
+	explicit := post
+	explicit.IdempotencyKey = "explicit-key"
+
+	for _, call := range []struct {
+		ctx context.Context
+		req client.Request
+	}{
+		{ctx, post},
+		{ctx, post},
+		{keyed, post},
+		{keyed, post},
+		{keyed, explicit},
+		{ctx, client.Request{Method: "GET", Path: "/iam/v2/service-accounts"}},
+	} {
+		if _, err := c.Do(call.ctx, call.req); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !uuid.MatchString(keys[0]) || keys[0] == keys[1] {
+		t.Errorf("Expected distinct generated keys, got %q and %q", keys[0], keys[1])
+	}
+	contextKey, _ := client.IdempotencyKeyFromContext(keyed)
+	if keys[2] != contextKey || keys[3] != contextKey {
+		t.Errorf("Expected context key %q to be reused, got %q and %q", contextKey, keys[2], keys[3])
+	}
+	if keys[4] != "explicit-key" {
+		t.Errorf("Expected explicit key, got %q", keys[4])
+	}
+	if keys[5] != "" {
+		t.Errorf("Expected no key on GET, got %q", keys[5])
+	}
+}
+
+func TestClientDo_IdempotencyKeyStableAcrossRetries(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(client.IdempotencyKeyHeader))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:         server.URL,
+		APIKey:          "test-key",
+		APISecret:       "test-secret",
+		IdempotencyKeys: true,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	c = c.With(client.WithRetry(retry.DefaultStrategy().WithInitialBackoff(time.Millisecond)))
+
+	if _, err := c.Do(context.Background(), client.Request{Method: "POST", Path: "/iam/v2/service-accounts", Body: map[string]string{}}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if len(keys) != 3 || keys[0] == "" || keys[1] != keys[0] || keys[2] != keys[0] {
+		t.Errorf("Expected the same key on all 3 attempts, got %q", keys)
+	}
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxLoggedBodyBytes caps the size of request/response bodies included in debug logs.
+const maxLoggedBodyBytes = 4096
+
+// redactedValue replaces sensitive values in logs.
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeyFragments identify JSON keys whose values must never be logged.
+var sensitiveKeyFragments = []string{"secret", "password", "passwd", "token", "private_key", "credential", "keyfile"}
+
+// sensitiveHeaders are HTTP headers whose values must never be logged.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// logExchange logs a completed request at debug level if a logger is configured,
+// and dumps it when Config.Debug is set.
+func (c *Client) logExchange(ctx context.Context, httpReq *http.Request, reqBody []byte, resp *Response, duration time.Duration, err error) {
+	c.dumpExchange(httpReq, reqBody, resp, err)
+
+	logger := c.config.Logger
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", httpReq.Method),
+		slog.String("path", httpReq.URL.Path),
+		slog.Duration("duration", duration),
+		slog.Any("request_headers", RedactHeaders(httpReq.Header)),
+	}
+	if len(reqBody) > 0 {
+		attrs = append(attrs, slog.String("request_body", string(truncate(RedactBody(reqBody)))))
+	}
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if resp.DryRun {
+			attrs = append(attrs, slog.Bool("dry_run", true))
+		}
+		if len(resp.Body) > 0 {
+			attrs = append(attrs, slog.String("response_body", string(truncate(RedactBody(resp.Body)))))
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "confluent API request", attrs...)
+}
+
+// RedactHeaders returns a copy of h with credential header values (Authorization, Cookie, ...) replaced.
+func RedactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range sensitiveHeaders {
+		if out.Get(name) != "" {
+			out.Set(name, redactedValue)
+		}
+	}
+	return out
+}
+
+// RedactBody replaces the values of sensitive keys (secret, password, token, ...) in a JSON body.
+// Bodies that are not JSON objects or arrays are returned unchanged.
+func RedactBody(body []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactValue walks decoded JSON, replacing values stored under sensitive keys.
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if IsSensitiveKey(k) {
+				t[k] = redactedValue
+			} else {
+				t[k] = redactValue(val)
+			}
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = redactValue(val)
+		}
+	}
+	return v
+}
+
+// IsSensitiveKey reports whether a JSON or config key holds a secret value. Besides
+// keys containing secret, password, token, ..., dotted config keys ending in ".key"
+// (e.g. "kafka.api.key", "ssl.key") are sensitive, while a bare "key" is not.
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return strings.HasSuffix(key, ".key")
+}
+
+func truncate(b []byte) []byte {
+	if len(b) <= maxLoggedBodyBytes {
+		return b
+	}
+	return append(b[:maxLoggedBodyBytes:maxLoggedBodyBytes], "...(truncated)"...)
+}
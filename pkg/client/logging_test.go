@@ -0,0 +1,86 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_LoggerRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "KEY123",
+			"secret": "returned-secret",
+		})
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "super-secret-value",
+		Logger:    logger,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	_, err = c.Do(context.Background(), client.Request{
+		Method: "POST",
+		Path:   "/iam/v2/api-keys",
+		Body:   map[string]interface{}{"spec": map[string]string{"api_secret": "body-secret", "display_name": "ci"}},
+	})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{`"method":"POST"`, `"path":"/iam/v2/api-keys"`, `"status":201`, `"duration"`, "display_name"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected log to contain %s, got %s", want, out)
+		}
+	}
+	for _, secret := range []string{"body-secret", "returned-secret", "super-secret-value", "Basic "} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Log leaked %q: %s", secret, out)
+		}
+	}
+}
+
+func TestClientDo_LoggerDisabledAboveDebug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		Logger:    logger,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/test"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected no logs at info level, got %s", buf.String())
+	}
+}
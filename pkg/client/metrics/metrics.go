@@ -0,0 +1,213 @@
+// Package metrics instruments client.Client requests with request counts, latency
+// histograms, and error counters labeled by API family (iam, cmk, kafka, connect,
+// schema-registry, ...).
+//
+// Metrics are reported to a Recorder, so any metrics system can be plugged in.
+// PrometheusRecorder is a dependency-free Recorder that serves the Prometheus
+// text exposition format:
+//
+//	rec := metrics.NewPrometheusRecorder()
+//	c, err := client.NewClient(client.Config{
+//		// ...
+//		Middlewares: []client.Middleware{metrics.Middleware(rec)},
+//	})
+//	http.Handle("/metrics", rec)
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Recorder receives one observation per HTTP round trip.
+// Implementations must be safe for concurrent use.
+type Recorder interface {
+	// ObserveRequest records a completed request. status is 0 when the request
+	// failed before a response was received (e.g., connection errors).
+	ObserveRequest(family string, method string, status int, duration time.Duration)
+}
+
+// Middleware returns a client middleware that reports every round trip to rec.
+func Middleware(rec Recorder) client.Middleware {
+	return func(next client.RoundTripFunc) client.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			rec.ObserveRequest(client.EndpointClass(req.Method, req.URL.Path), req.Method, status, time.Since(start))
+			return resp, err
+		}
+	}
+}
+
+// DefaultBuckets are the latency histogram buckets, in seconds, used by NewPrometheusRecorder.
+var DefaultBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// PrometheusRecorder is an in-memory Recorder that exposes its metrics in the
+// Prometheus text exposition format via ServeHTTP.
+//
+// Exposed metrics:
+//   - confluent_client_requests_total{family,method,code}
+//   - confluent_client_request_errors_total{family,code}
+//   - confluent_client_request_duration_seconds{family} (histogram)
+type PrometheusRecorder struct {
+	mu        sync.Mutex
+	buckets   []float64
+	requests  map[[3]string]uint64
+	errors    map[[2]string]uint64
+	latencies map[string]*histogram
+}
+
+type histogram struct {
+	counts []uint64 // cumulative count per bucket
+	sum    float64
+	count  uint64
+}
+
+// NewPrometheusRecorder creates a recorder using DefaultBuckets.
+func NewPrometheusRecorder() *PrometheusRecorder {
+	return NewPrometheusRecorderWithBuckets(DefaultBuckets)
+}
+
+// NewPrometheusRecorderWithBuckets creates a recorder with custom latency buckets in seconds.
+func NewPrometheusRecorderWithBuckets(buckets []float64) *PrometheusRecorder {
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	return &PrometheusRecorder{
+		buckets:   b,
+		requests:  make(map[[3]string]uint64),
+		errors:    make(map[[2]string]uint64),
+		latencies: make(map[string]*histogram),
+	}
+}
+
+// ObserveRequest implements Recorder.
+func (p *PrometheusRecorder) ObserveRequest(family string, method string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	seconds := duration.Seconds()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[[3]string{family, method, code}]++
+	if status == 0 || status >= 400 {
+		p.errors[[2]string{family, code}]++
+	}
+
+	h, ok := p.latencies[family]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.latencies[family] = h
+	}
+	for i, upper := range p.buckets {
+		if seconds <= upper {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// RequestCount returns the number of requests observed for a family, method, and status code.
+func (p *PrometheusRecorder) RequestCount(family string, method string, status int) uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.requests[[3]string{family, method, strconv.Itoa(status)}]
+}
+
+// ErrorCount returns the number of failed requests observed for a family and status code.
+func (p *PrometheusRecorder) ErrorCount(family string, status int) uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.errors[[2]string{family, strconv.Itoa(status)}]
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format.
+func (p *PrometheusRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_ = p.WriteText(w)
+}
+
+// WriteText writes all metrics in the Prometheus text exposition format to w.
+func (p *PrometheusRecorder) WriteText(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var b strings.Builder
+
+	b.WriteString("# HELP confluent_client_requests_total Total Confluent API requests.\n")
+	b.WriteString("# TYPE confluent_client_requests_total counter\n")
+	requestKeys := make([][3]string, 0, len(p.requests))
+	for k := range p.requests {
+		requestKeys = append(requestKeys, k)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		return strings.Join(requestKeys[i][:], "\x00") < strings.Join(requestKeys[j][:], "\x00")
+	})
+	for _, k := range requestKeys {
+		fmt.Fprintf(&b, "confluent_client_requests_total{family=\"%s\",method=\"%s\",code=\"%s\"} %d\n", EscapeLabelValue(k[0]), EscapeLabelValue(k[1]), EscapeLabelValue(k[2]), p.requests[k])
+	}
+
+	b.WriteString("# HELP confluent_client_request_errors_total Total failed Confluent API requests by status code.\n")
+	b.WriteString("# TYPE confluent_client_request_errors_total counter\n")
+	errorKeys := make([][2]string, 0, len(p.errors))
+	for k := range p.errors {
+		errorKeys = append(errorKeys, k)
+	}
+	sort.Slice(errorKeys, func(i, j int) bool {
+		return errorKeys[i][0]+"\x00"+errorKeys[i][1] < errorKeys[j][0]+"\x00"+errorKeys[j][1]
+	})
+	for _, k := range errorKeys {
+		fmt.Fprintf(&b, "confluent_client_request_errors_total{family=\"%s\",code=\"%s\"} %d\n", EscapeLabelValue(k[0]), EscapeLabelValue(k[1]), p.errors[k])
+	}
+
+	b.WriteString("# HELP confluent_client_request_duration_seconds Confluent API request latency.\n")
+	b.WriteString("# TYPE confluent_client_request_duration_seconds histogram\n")
+	families := make([]string, 0, len(p.latencies))
+	for f := range p.latencies {
+		families = append(families, f)
+	}
+	sort.Strings(families)
+	for _, family := range families {
+		h := p.latencies[family]
+		f := EscapeLabelValue(family)
+		for i, upper := range p.buckets {
+			fmt.Fprintf(&b, "confluent_client_request_duration_seconds_bucket{family=\"%s\",le=\"%s\"} %d\n", f, formatFloat(upper), h.counts[i])
+		}
+		fmt.Fprintf(&b, "confluent_client_request_duration_seconds_bucket{family=\"%s\",le=\"+Inf\"} %d\n", f, h.count)
+		fmt.Fprintf(&b, "confluent_client_request_duration_seconds_sum{family=\"%s\"} %s\n", f, formatFloat(h.sum))
+		fmt.Fprintf(&b, "confluent_client_request_duration_seconds_count{family=\"%s\"} %d\n", f, h.count)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// labelEscaper escapes a Prometheus label value. Unlike Go quoting, the exposition
+// format only escapes backslash, double quote, and newline.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// EscapeLabelValue escapes v for use as a label value in the Prometheus text
+// exposition format, e.g. in WriteText.
+func EscapeLabelValue(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
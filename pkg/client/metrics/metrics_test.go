@@ -0,0 +1,75 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/client/metrics"
+)
+
+func TestMiddleware_RecordsRequestsByFamily(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/connect/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rec := metrics.NewPrometheusRecorder()
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		Middlewares: []client.Middleware{metrics.Middleware(rec)},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	ctx := context.Background()
+	_, _ = c.Do(ctx, client.Request{Method: "GET", Path: "/kafka/v3/clusters/lkc-1/topics"})
+	_, _ = c.Do(ctx, client.Request{Method: "GET", Path: "/kafka/v3/clusters/lkc-1/topics"})
+	_, _ = c.Do(ctx, client.Request{Method: "GET", Path: "/connect/v1/environments/env-1/clusters/lcc-1/connectors"})
+
+	if got := rec.RequestCount("kafka", "GET", 200); got != 2 {
+		t.Errorf("Expected 2 kafka requests, got %d", got)
+	}
+	if got := rec.ErrorCount("connect", 404); got != 1 {
+		t.Errorf("Expected 1 connect 404 error, got %d", got)
+	}
+
+	out := httptest.NewRecorder()
+	rec.ServeHTTP(out, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := out.Body.String()
+	for _, want := range []string{
+		`confluent_client_requests_total{family="kafka",method="GET",code="200"} 2`,
+		`confluent_client_request_errors_total{family="connect",code="404"} 1`,
+		`confluent_client_request_duration_seconds_count{family="kafka"} 2`,
+		`confluent_client_request_duration_seconds_bucket{family="kafka",le="+Inf"} 2`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected exposition to contain %q, got:\n%s", want, body)
+		}
+	}
+}
+
+func TestPrometheusRecorder_EscapesLabels(t *testing.T) {
+	rec := metrics.NewPrometheusRecorder()
+	rec.ObserveRequest("a\"b\\c\nd é", "GET", 200, time.Millisecond)
+
+	var b strings.Builder
+	if err := rec.WriteText(&b); err != nil {
+		t.Fatalf("WriteText failed: %v", err)
+	}
+	want := `confluent_client_requests_total{family="a\"b\\c\nd é",method="GET",code="200"} 1`
+	if !strings.Contains(b.String(), want) {
+		t.Errorf("Expected output to contain %s, got:\n%s", want, b.String())
+	}
+}
@@ -0,0 +1,22 @@
+package client
+
+import "net/http"
+
+// RoundTripFunc sends a prepared HTTP request and returns the raw HTTP response.
+// The innermost RoundTripFunc of a client sends the request with its HTTP client.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to observe or modify requests and responses,
+// e.g. for auth refresh, logging, metrics, or header mutation.
+// A middleware must call next to continue the chain, or return its own response to short-circuit it.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// chain wraps base with middlewares so that the first middleware is the outermost.
+func chain(base RoundTripFunc, middlewares []Middleware) RoundTripFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if middlewares[i] != nil {
+			base = middlewares[i](base)
+		}
+	}
+	return base
+}
@@ -0,0 +1,84 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_MiddlewareOrderAndHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Trace"); got != "outer,inner" {
+			t.Errorf("Expected X-Trace header outer,inner, got %q", got)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var order []string
+	tag := func(name string) client.Middleware {
+		return func(next client.RoundTripFunc) client.RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				if existing := req.Header.Get("X-Trace"); existing != "" {
+					name = existing + "," + name
+				}
+				req.Header.Set("X-Trace", name)
+				return next(req)
+			}
+		}
+	}
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		Middlewares: []client.Middleware{tag("outer"), tag("inner")},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/test"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if len(order) != 2 || order[0] != "outer" || order[1] != "inner" {
+		t.Errorf("Expected middlewares to run outer then inner, got %v", order)
+	}
+}
+
+func TestClientDo_MiddlewareShortCircuit(t *testing.T) {
+	stub := func(next client.RoundTripFunc) client.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"stubbed":true}`)),
+			}, nil
+		}
+	}
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:     "http://127.0.0.1:0",
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		Middlewares: []client.Middleware{stub},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	resp, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/test"})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	var out map[string]bool
+	if err := resp.DecodeJSON(&out); err != nil || !out["stubbed"] {
+		t.Errorf("Expected stubbed response, got %s (err %v)", resp.Body, err)
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"strings"
+)
+
+// Multipart is a multipart/form-data request body, e.g. for custom connector plugin uploads.
+type Multipart struct {
+	// Fields are plain form values, written in key order before the files
+	Fields map[string]string
+	// Files are the file parts, written in order
+	Files []MultipartFile
+}
+
+// MultipartFile is a file part of a multipart request.
+type MultipartFile struct {
+	// FieldName is the form field name (e.g., "file")
+	FieldName string
+	// FileName is the file name reported to the server
+	FileName string
+	// ContentType of the part (optional, defaults to application/octet-stream)
+	ContentType string
+	// Content is read once when the request is sent. Use a fresh reader per request,
+	// or a *bytes.Reader rewound before retries.
+	Content io.Reader
+}
+
+// encode writes the multipart body and returns it with its Content-Type, including the boundary.
+func (m *Multipart) encode() ([]byte, string, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	keys := make([]string, 0, len(m.Fields))
+	for k := range m.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := w.WriteField(k, m.Fields[k]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	for _, f := range m.Files {
+		if f.Content == nil {
+			return nil, "", fmt.Errorf("multipart file %q has no content", f.FieldName)
+		}
+		contentType := f.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, escapeQuotes(f.FieldName), escapeQuotes(f.FileName)))
+		h.Set("Content-Type", contentType)
+		part, err := w.CreatePart(h)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := io.Copy(part, f.Content); err != nil {
+			return nil, "", fmt.Errorf("failed to read multipart file %q: %w", f.FileName, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), w.FormDataContentType(), nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
@@ -0,0 +1,64 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_Multipart(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("ParseMultipartForm failed: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if got := r.FormValue("display_name"); got != "my-plugin" {
+			t.Errorf("display_name = %q", got)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile failed: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		data, _ := io.ReadAll(file)
+		if header.Filename != "plugin.zip" || header.Header.Get("Content-Type") != "application/zip" || string(data) != "PK-zip-content" {
+			t.Errorf("Unexpected file %s %s %q", header.Filename, header.Header.Get("Content-Type"), data)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{BaseURL: server.URL, APIKey: "test-key", APISecret: "test-secret"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	_, err = c.Do(context.Background(), client.Request{
+		Method: "POST",
+		Path:   "/connect/v1/custom-connector-plugins/upload",
+		Multipart: &client.Multipart{
+			Fields: map[string]string{"display_name": "my-plugin"},
+			Files: []client.MultipartFile{{
+				FieldName:   "file",
+				FileName:    "plugin.zip",
+				ContentType: "application/zip",
+				Content:     strings.NewReader("PK-zip-content"),
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	_, err = c.Do(context.Background(), client.Request{Method: "POST", Path: "/x", Body: "x", Multipart: &client.Multipart{}})
+	if err == nil {
+		t.Error("Expected error for Body with Multipart")
+	}
+}
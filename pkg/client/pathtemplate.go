@@ -0,0 +1,45 @@
+package client
+
+import (
+	"regexp"
+	"strings"
+)
+
+// staticPathSegments are the fixed words that appear in Confluent API paths.
+// Any other segment is treated as a resource identifier by PathTemplate.
+var staticPathSegments = map[string]bool{
+	// API families
+	"cmk": true, "kafka": true, "iam": true, "org": true, "connect": true, "schema-registry": true,
+	"partner": true, "srcm": true, "dek-registry": true, "kafka-quotas": true,
+	// Collections and actions
+	"acls": true, "api-keys": true, "broker-configs": true, "brokers": true, "clusters": true,
+	"compatibility": true, "config": true, "configs": true, "connector-plugins": true,
+	"connectors": true, "consumer-groups": true, "consumers": true, "deks": true,
+	"entitlements": true, "environments": true, "exporters": true, "ids": true,
+	"keks": true, "lag-summary": true, "lags": true, "latest": true, "links": true,
+	"mirrors": true, "mode": true, "offsets": true, "organizations": true, "partitions": true,
+	"pause": true, "records": true, "referencedby": true, "restart": true, "resume": true,
+	"schemas": true, "service-accounts": true, "status": true, "stop": true, "subjects": true,
+	"tasks": true, "topics": true, "validate": true, "versions": true, "client-quotas": true,
+	"regions": true, "alter": true, "delete-records": true, "custom-connector-plugins": true,
+}
+
+var apiVersionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// PathTemplate returns a low-cardinality form of a request path with resource
+// identifiers replaced by "{id}" and any query string removed, e.g.
+// "/kafka/v3/clusters/lkc-1/topics/orders" -> "/kafka/v3/clusters/{id}/topics/{id}".
+// It is suitable for span names and metric labels.
+func PathTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, seg := range segments {
+		if seg == "" || staticPathSegments[seg] || apiVersionSegment.MatchString(seg) {
+			continue
+		}
+		segments[i] = "{id}"
+	}
+	return "/" + strings.Join(segments, "/")
+}
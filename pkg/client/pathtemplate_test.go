@@ -0,0 +1,22 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestPathTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/kafka/v3/clusters/lkc-1/topics/orders/configs":                     "/kafka/v3/clusters/{id}/topics/{id}/configs",
+		"/cmk/v2/clusters?environment=env-1":                                 "/cmk/v2/clusters",
+		"/schema-registry/v1/schemas/ids/42":                                 "/schema-registry/v1/schemas/ids/{id}",
+		"/schema-registry/v1/subjects/orders-value/versions/latest":          "/schema-registry/v1/subjects/{id}/versions/latest",
+		"/connect/v1/environments/env-1/clusters/lcc-1/connectors/s3/status": "/connect/v1/environments/{id}/clusters/{id}/connectors/{id}/status",
+	}
+	for path, want := range tests {
+		if got := client.PathTemplate(path); got != want {
+			t.Errorf("PathTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
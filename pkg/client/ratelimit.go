@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// RateLimit configures a token bucket: requests are admitted at RequestsPerSecond
+// on average, with up to Burst requests allowed back-to-back.
+// A RequestsPerSecond of zero or less disables limiting.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimiterConfig configures client-side rate limiting so bulk operations
+// stay below Confluent API quotas instead of tripping 429 responses.
+type RateLimiterConfig struct {
+	// Default is the limit applied to every endpoint class without an explicit entry in Classes.
+	// All such classes share a single bucket.
+	Default RateLimit
+	// Classes holds per-endpoint-class limits, keyed by the class returned by Classify
+	// (e.g., "kafka", "iam", "connect"). Each class gets its own bucket.
+	Classes map[string]RateLimit
+	// Classify maps a request to its endpoint class (optional, defaults to EndpointClass).
+	Classify func(method, path string) string
+}
+
+// EndpointClass returns the API family of a request path, which is its first path
+// segment (e.g., "/kafka/v3/clusters" -> "kafka", "/cmk/v2/clusters" -> "cmk").
+func EndpointClass(method, path string) string {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.IndexAny(path, "/?"); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// rateLimiter holds the token buckets for a client.
+type rateLimiter struct {
+	clock    clock.Clock
+	classify func(method, path string) string
+	fallback *tokenBucket
+	classes  map[string]*tokenBucket
+}
+
+// newRateLimiter builds a rate limiter from config, returning nil when no limits are configured.
+func newRateLimiter(cfg *RateLimiterConfig, clk clock.Clock) *rateLimiter {
+	if cfg == nil {
+		return nil
+	}
+	rl := &rateLimiter{
+		clock:    clk,
+		classify: cfg.Classify,
+		fallback: newTokenBucket(cfg.Default),
+		classes:  make(map[string]*tokenBucket, len(cfg.Classes)),
+	}
+	if rl.classify == nil {
+		rl.classify = EndpointClass
+	}
+	for class, limit := range cfg.Classes {
+		rl.classes[class] = newTokenBucket(limit)
+	}
+	return rl
+}
+
+// Wait blocks until the bucket for the request's endpoint class admits it,
+// or returns the context error if ctx is done first.
+func (rl *rateLimiter) Wait(ctx context.Context, method, path string) error {
+	bucket, ok := rl.classes[rl.classify(method, path)]
+	if !ok {
+		bucket = rl.fallback
+	}
+	return bucket.wait(ctx, rl.clock)
+}
+
+// tokenBucket is a goroutine-safe token bucket. A nil bucket admits everything.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token taken by reserve that was never used.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens++
+}
+
+func (b *tokenBucket) wait(ctx context.Context, clk clock.Clock) error {
+	if b == nil {
+		return nil
+	}
+	delay := b.reserve(clk.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-clk.After(delay):
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
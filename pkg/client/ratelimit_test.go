@@ -0,0 +1,96 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestEndpointClass(t *testing.T) {
+	tests := map[string]string{
+		"/kafka/v3/clusters/lkc-1/topics":  "kafka",
+		"cmk/v2/clusters":                  "cmk",
+		"/iam/v2/api-keys?owner=sa-1":      "iam",
+		"/schema-registry/v1/subjects":     "schema-registry",
+		"/connect/v1/environments/env-123": "connect",
+	}
+	for path, want := range tests {
+		if got := client.EndpointClass("GET", path); got != want {
+			t.Errorf("EndpointClass(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestClientDo_RateLimiterDelaysRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		RateLimiter: &client.RateLimiterConfig{
+			Default: client.RateLimit{RequestsPerSecond: 20, Burst: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/kafka/v3/clusters"}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+	// Burst of 1 at 20 RPS: the 2nd and 3rd requests wait ~50ms each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected rate limiter to delay requests, took only %v", elapsed)
+	}
+}
+
+func TestClientDo_RateLimiterPerClass(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		RateLimiter: &client.RateLimiterConfig{
+			Classes: map[string]client.RateLimit{
+				"kafka": {RequestsPerSecond: 0.1, Burst: 1},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	// Unlisted classes are unlimited when Default is unset.
+	for i := 0; i < 5; i++ {
+		if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/iam/v2/service-accounts"}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+
+	if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/kafka/v3/clusters"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.Do(ctx, client.Request{Method: "GET", Path: "/kafka/v3/clusters"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded while waiting for kafka bucket, got %v", err)
+	}
+}
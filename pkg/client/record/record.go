@@ -0,0 +1,249 @@
+// Package record captures client.Client API interactions to sanitized golden files
+// ("cassettes") and replays them, so managers can be tested with real API responses
+// in CI without live Confluent credentials.
+//
+// Record once against the real API:
+//
+//	rec := record.NewRecorder()
+//	c, err := client.NewClient(client.Config{
+//		// ... real credentials
+//		Middlewares: []client.Middleware{rec.Middleware()},
+//	})
+//	// ... exercise the managers
+//	err = rec.Save("testdata/topics.json")
+//
+// Then replay in tests:
+//
+//	replayer, err := record.LoadReplayer("testdata/topics.json")
+//	c, err := client.NewClient(client.Config{
+//		BaseURL: "https://api.confluent.cloud", APIKey: "test", APISecret: "test",
+//		Middlewares: []client.Middleware{replayer.Middleware()},
+//	})
+//
+// Recorded request and response bodies have secret fields redacted and credential
+// headers are never stored. Review cassettes before committing them all the same.
+package record
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// ErrNoInteraction is returned by a Replayer for requests that match no unused recorded interaction.
+var ErrNoInteraction = errors.New("no recorded interaction matches request")
+
+// Interaction is one recorded request and its response.
+type Interaction struct {
+	Method string `json:"method"`
+	// URI is the request path and query, without scheme and host
+	URI             string      `json:"uri"`
+	RequestBody     string      `json:"request_body,omitempty"`
+	StatusCode      int         `json:"status_code"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    string      `json:"response_body,omitempty"`
+}
+
+// Cassette is the golden file format: interactions in the order they were recorded.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Load reads a cassette from a file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to a file as indented JSON.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// Recorder captures the interactions of a client through its Middleware.
+// It is safe for concurrent use.
+type Recorder struct {
+	// Sanitize is applied to every interaction after the built-in redaction, e.g. to
+	// replace organization or cluster IDs (optional)
+	Sanitize func(*Interaction)
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder creates an empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Middleware returns a client middleware that records every round trip that receives a response.
+func (r *Recorder) Middleware() client.Middleware {
+	return func(next client.RoundTripFunc) client.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			reqBody, err := readBody(&req.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := next(req)
+			if err != nil {
+				return resp, err
+			}
+			respBody, err := readBody(&resp.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			headers := client.RedactHeaders(resp.Header)
+			headers.Del("Set-Cookie")
+			in := Interaction{
+				Method:          req.Method,
+				URI:             req.URL.RequestURI(),
+				RequestBody:     string(client.RedactBody(reqBody)),
+				StatusCode:      resp.StatusCode,
+				ResponseHeaders: headers,
+				ResponseBody:    string(client.RedactBody(respBody)),
+			}
+			if r.Sanitize != nil {
+				r.Sanitize(&in)
+			}
+
+			r.mu.Lock()
+			r.cassette.Interactions = append(r.cassette.Interactions, in)
+			r.mu.Unlock()
+			return resp, nil
+		}
+	}
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes the recorded interactions to a file.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// Replayer serves recorded interactions instead of sending requests.
+// Each interaction is served at most once, so repeated requests replay in recorded order.
+// It is safe for concurrent use.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer creates a replayer serving the interactions of a cassette.
+func NewReplayer(c *Cassette) *Replayer {
+	return &Replayer{
+		interactions: c.Interactions,
+		used:         make([]bool, len(c.Interactions)),
+	}
+}
+
+// LoadReplayer creates a replayer from a cassette file.
+func LoadReplayer(path string) (*Replayer, error) {
+	c, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(c), nil
+}
+
+// Middleware returns a client middleware that answers every request from the cassette
+// without calling the rest of the chain. A request matches the first unused interaction
+// with the same method and URI and, if one was recorded, the same redacted request body.
+// Unmatched requests fail with ErrNoInteraction.
+func (p *Replayer) Middleware() client.Middleware {
+	return func(next client.RoundTripFunc) client.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			reqBody, err := readBody(&req.Body)
+			if err != nil {
+				return nil, err
+			}
+			in, ok := p.match(req.Method, req.URL.RequestURI(), string(client.RedactBody(reqBody)))
+			if !ok {
+				return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+			}
+			header := in.ResponseHeaders.Clone()
+			if header == nil {
+				header = http.Header{}
+			}
+			return &http.Response{
+				Status:     fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+				StatusCode: in.StatusCode,
+				Header:     header,
+				Body:       io.NopCloser(bytes.NewReader([]byte(in.ResponseBody))),
+				Request:    req,
+			}, nil
+		}
+	}
+}
+
+// Unused returns the interactions that have not been replayed, e.g. to assert
+// at the end of a test that the code under test made every recorded call.
+func (p *Replayer) Unused() []Interaction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var unused []Interaction
+	for i, in := range p.interactions {
+		if !p.used[i] {
+			unused = append(unused, in)
+		}
+	}
+	return unused
+}
+
+func (p *Replayer) match(method, uri, body string) (Interaction, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, in := range p.interactions {
+		if p.used[i] || in.Method != method || in.URI != uri {
+			continue
+		}
+		if in.RequestBody != "" && in.RequestBody != body {
+			continue
+		}
+		p.used[i] = true
+		return in, true
+	}
+	return Interaction{}, false
+}
+
+// readBody reads and replaces *body so that it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
@@ -0,0 +1,97 @@
+package record_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/client/record"
+)
+
+func newClient(t *testing.T, baseURL string, mw client.Middleware) *client.Client {
+	t.Helper()
+	c, err := client.NewClient(client.Config{
+		BaseURL:     baseURL,
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		Middlewares: []client.Middleware{mw},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	return c
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"id":"KEY1","spec":{"secret":"s3cr3t"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[{"id":"KEY1"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	create := client.Request{Method: "POST", Path: "/iam/v2/api-keys", Body: map[string]string{"display_name": "orders"}}
+	list := client.Request{Method: "GET", Path: "/iam/v2/api-keys?page_size=10"}
+
+	rec := record.NewRecorder()
+	live := newClient(t, server.URL, rec.Middleware())
+	for _, req := range []client.Request{create, list} {
+		if _, err := live.Do(ctx, req); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := rec.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	cassette, err := record.Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(cassette.Interactions) != 2 {
+		t.Fatalf("Expected 2 interactions, got %d", len(cassette.Interactions))
+	}
+	first := cassette.Interactions[0]
+	if strings.Contains(first.ResponseBody, "s3cr3t") || first.ResponseHeaders.Get("Set-Cookie") != "" {
+		t.Errorf("Expected sanitized interaction, got %+v", first)
+	}
+	if cassette.Interactions[1].URI != "/iam/v2/api-keys?page_size=10" {
+		t.Errorf("Unexpected URI %q", cassette.Interactions[1].URI)
+	}
+
+	server.Close()
+	replayer := record.NewReplayer(cassette)
+	replay := newClient(t, "https://api.confluent.cloud", replayer.Middleware())
+
+	resp, err := replay.Do(ctx, create)
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted || !strings.Contains(string(resp.Body), "KEY1") {
+		t.Errorf("Unexpected replayed response %d %s", resp.StatusCode, resp.Body)
+	}
+	if len(replayer.Unused()) != 1 {
+		t.Errorf("Expected 1 unused interaction, got %d", len(replayer.Unused()))
+	}
+	if _, err := replay.Do(ctx, list); err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+
+	// Each interaction is served once
+	if _, err := replay.Do(ctx, list); !errors.Is(err, record.ErrNoInteraction) {
+		t.Errorf("Expected ErrNoInteraction, got %v", err)
+	}
+}
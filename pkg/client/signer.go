@@ -0,0 +1,59 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+)
+
+// Signer signs outgoing requests, e.g. for private gateways that require HMAC
+// request signing. Sign is called as the last step before a request is sent, after
+// middlewares (e.g. traceparent), the response cache (If-None-Match), and response
+// decompression (Accept-Encoding) have set their headers, so the signed headers are
+// those on the wire. It may add or modify headers on req. bodySHA256 is the
+// hex-encoded SHA-256 of the request body as sent (the hash of an empty body for
+// requests without one).
+type Signer interface {
+	Sign(req *http.Request, bodySHA256 string) error
+}
+
+// SignerFunc adapts a function to the Signer interface.
+type SignerFunc func(req *http.Request, bodySHA256 string) error
+
+// Sign implements Signer.
+func (f SignerFunc) Sign(req *http.Request, bodySHA256 string) error {
+	return f(req, bodySHA256)
+}
+
+func bodyHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+type bodyHashContextKey struct{}
+
+// signError is a Signer failure, reported by do rather than as a network error.
+type signError struct {
+	err error
+}
+
+func (e *signError) Error() string {
+	return e.err.Error()
+}
+
+// signing signs requests with signer before sending them with next.
+func signing(signer Signer, next RoundTripFunc) RoundTripFunc {
+	if signer == nil {
+		return next
+	}
+	return func(req *http.Request) (*http.Response, error) {
+		hash, ok := req.Context().Value(bodyHashContextKey{}).(string)
+		if !ok {
+			hash = bodyHash(nil)
+		}
+		if err := signer.Sign(req, hash); err != nil {
+			return nil, &signError{err: err}
+		}
+		return next(req)
+	}
+}
@@ -0,0 +1,115 @@
+package client_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_SignerAddsSignature(t *testing.T) {
+	key := []byte("gateway-key")
+	sign := func(method, path, bodyHash string) string {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(method + "\n" + path + "\n" + bodyHash))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := []byte(`{"name":"orders"}`)
+		sum := sha256.Sum256(body)
+		want := sign(r.Method, r.URL.Path, hex.EncodeToString(sum[:]))
+		if got := r.Header.Get("X-Gateway-Signature"); got != want {
+			t.Errorf("X-Gateway-Signature = %q, want %q", got, want)
+		}
+		if r.Header.Get("Authorization") == "" {
+			t.Error("expected standard headers to be set before signing")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		Signer: client.SignerFunc(func(req *http.Request, bodySHA256 string) error {
+			req.Header.Set("X-Gateway-Signature", sign(req.Method, req.URL.Path, bodySHA256))
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	_, err = c.Do(context.Background(), client.Request{
+		Method: "POST",
+		Path:   "/org/v2/environments",
+		Body:   map[string]string{"name": "orders"},
+	})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+}
+
+func TestClientDo_SignerSeesHeadersOnTheWire(t *testing.T) {
+	var signed http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	traceparent := func(next client.RoundTripFunc) client.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("Traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+			return next(req)
+		}
+	}
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		Middlewares: []client.Middleware{traceparent},
+		Signer: client.SignerFunc(func(req *http.Request, bodySHA256 string) error {
+			signed = req.Header.Clone()
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if signed.Get("Traceparent") == "" || signed.Get("Accept-Encoding") != "gzip" {
+		t.Errorf("Expected middleware and transport headers to be signed, got %v", signed)
+	}
+}
+
+func TestClientDo_SignerError(t *testing.T) {
+	c, err := client.NewClient(client.Config{
+		BaseURL:   "http://127.0.0.1:1",
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		Signer: client.SignerFunc(func(req *http.Request, bodySHA256 string) error {
+			return errors.New("no signing key")
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	_, err = c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"})
+	if err == nil || api.IsNetworkError(err) || !strings.Contains(err.Error(), "failed to sign request: no signing key") {
+		t.Errorf("Expected signing error, got %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UnknownFieldsError is returned by strict decoding when the response body has
+// fields the target type does not model.
+type UnknownFieldsError struct {
+	// Fields are the dotted paths of the unknown fields (e.g., "data[].spec.new_field")
+	Fields []string
+}
+
+// Error implements the error interface.
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("response has unknown fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// DecodeJSONStrict decodes the response body like DecodeJSON but fails with an
+// *UnknownFieldsError if the body has fields v does not model.
+func (r *Response) DecodeJSONStrict(v interface{}) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		return err
+	}
+	r.drift.checkBody(r.Body, v)
+	if fields := unknownFields(r.Body, v); len(fields) > 0 {
+		r.reportUnknownFields(fields)
+		return &UnknownFieldsError{Fields: fields}
+	}
+	return nil
+}
+
+// reportUnknownFields passes unknown fields to the client's OnUnknownFields callback, if any.
+func (r *Response) reportUnknownFields(fields []string) {
+	if r.onUnknownFields != nil {
+		r.onUnknownFields(fields)
+	}
+}
@@ -0,0 +1,70 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestResponse_StrictDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"env-1","display_name":"prod","stream_governance":{}}`))
+	}))
+	defer server.Close()
+
+	var reported []string
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		OnUnknownFields: func(method, path string, fields []string) {
+			if method != "GET" || path != "/org/v2/environments/{id}" {
+				t.Errorf("unexpected endpoint %s %s", method, path)
+			}
+			reported = append(reported, fields...)
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	resp, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments/env-1"})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	var env api.Environment
+	if err := resp.DecodeJSON(&env); err != nil {
+		t.Fatalf("Expected DecodeJSON to ignore unknown fields, got %v", err)
+	}
+	if !reflect.DeepEqual(reported, []string{"stream_governance"}) {
+		t.Errorf("OnUnknownFields reported %v", reported)
+	}
+
+	reported = nil
+	err = resp.DecodeJSONStrict(&env)
+	var unknown *client.UnknownFieldsError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("Expected *UnknownFieldsError, got %v", err)
+	}
+	if !reflect.DeepEqual(unknown.Fields, []string{"stream_governance"}) {
+		t.Errorf("Fields = %v", unknown.Fields)
+	}
+	if env.DisplayName != "prod" {
+		t.Errorf("Expected known fields to be decoded, got %+v", env)
+	}
+	if !reflect.DeepEqual(reported, []string{"stream_governance"}) {
+		t.Errorf("OnUnknownFields reported %v", reported)
+	}
+
+	var loose map[string]interface{}
+	if err := resp.DecodeJSONStrict(&loose); err != nil {
+		t.Errorf("Expected maps to accept any field, got %v", err)
+	}
+}
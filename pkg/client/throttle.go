@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// AdaptiveThrottleConfig slows requests down as the server-reported rate-limit quota
+// (RateLimit-* / X-RateLimit-* response headers) of an endpoint class runs low, spreading
+// the remaining requests over the rest of the window instead of running into a 429.
+type AdaptiveThrottleConfig struct {
+	// Threshold is the fraction of the limit remaining below which requests are delayed
+	// (optional, defaults to 0.1)
+	Threshold float64
+	// MaxDelay caps the delay added before a single request (optional, defaults to 10 seconds)
+	MaxDelay time.Duration
+}
+
+// adaptiveThrottle tracks the last reported quota of each endpoint class.
+type adaptiveThrottle struct {
+	threshold float64
+	maxDelay  time.Duration
+	clock     clock.Clock
+
+	mu     sync.Mutex
+	quotas map[string]*observedQuota
+}
+
+type observedQuota struct {
+	limit     int
+	remaining int
+	resetAt   time.Time
+}
+
+// newAdaptiveThrottle builds a throttle from config, returning nil when disabled.
+func newAdaptiveThrottle(cfg *AdaptiveThrottleConfig, clk clock.Clock) *adaptiveThrottle {
+	if cfg == nil {
+		return nil
+	}
+	t := &adaptiveThrottle{
+		threshold: cfg.Threshold,
+		maxDelay:  cfg.MaxDelay,
+		clock:     clk,
+		quotas:    make(map[string]*observedQuota),
+	}
+	if t.threshold <= 0 {
+		t.threshold = 0.1
+	}
+	if t.maxDelay <= 0 {
+		t.maxDelay = 10 * time.Second
+	}
+	return t
+}
+
+// observe records the quota reported in response headers.
+func (t *adaptiveThrottle) observe(class string, h http.Header) {
+	info, ok := api.ParseRateLimitHeaders(h)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.quotas[class] = &observedQuota{
+		limit:     info.Limit,
+		remaining: info.Remaining,
+		resetAt:   t.clock.Now().Add(info.Reset),
+	}
+}
+
+// delay returns how long to wait before sending a request of the class, and counts
+// the request against the remaining quota so concurrent callers are spread out too.
+func (t *adaptiveThrottle) delay(class string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	q, ok := t.quotas[class]
+	if !ok {
+		return 0
+	}
+	window := q.resetAt.Sub(t.clock.Now())
+	if window <= 0 {
+		delete(t.quotas, class)
+		return 0
+	}
+	remaining := q.remaining
+	if remaining > 0 {
+		q.remaining--
+	}
+	if q.limit > 0 && float64(remaining) > t.threshold*float64(q.limit) {
+		return 0
+	}
+
+	d := window / time.Duration(remaining+1)
+	if d > t.maxDelay {
+		d = t.maxDelay
+	}
+	return d
+}
+
+// wait blocks for the delay of a request of the class, or returns the context error.
+func (t *adaptiveThrottle) wait(ctx context.Context, class string) error {
+	d := t.delay(class)
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-t.clock.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
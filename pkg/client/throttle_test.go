@@ -0,0 +1,68 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+func TestClientDo_AdaptiveThrottle(t *testing.T) {
+	var remaining atomic.Int32
+	remaining.Store(50)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(int(remaining.Load())))
+		w.Header().Set("X-RateLimit-Reset", "30")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	c, err := client.NewClient(client.Config{
+		BaseURL:          server.URL,
+		APIKey:           "test-key",
+		APISecret:        "test-secret",
+		Clock:            fake,
+		AdaptiveThrottle: &client.AdaptiveThrottleConfig{MaxDelay: 20 * time.Second},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	ctx := context.Background()
+	req := client.Request{Method: "GET", Path: "/iam/v2/api-keys"}
+
+	// Plenty of quota left: no delay
+	for i := 0; i < 2; i++ {
+		if _, err := c.Do(ctx, req); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+
+	// Quota nearly exhausted: the next request waits for its share of the window
+	remaining.Store(2)
+	if _, err := c.Do(ctx, req); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Do(ctx, req)
+		done <- err
+	}()
+	fake.BlockUntil(1)
+	select {
+	case <-done:
+		t.Fatal("Expected request to be throttled")
+	default:
+	}
+	fake.Advance(10 * time.Second)
+	if err := <-done; err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+}
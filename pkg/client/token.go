@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Token is a short-lived bearer token for data-plane APIs.
+type Token struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// valid reports whether the token can be used for at least another skew.
+func (t *Token) valid(now time.Time, skew time.Duration) bool {
+	return t != nil && t.AccessToken != "" && (t.ExpiresAt.IsZero() || now.Add(skew).Before(t.ExpiresAt))
+}
+
+// TokenSource supplies bearer tokens. When Config.TokenSource is set, the client
+// sends "Authorization: Bearer <token>" instead of basic auth.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// DefaultTokenExchangePath is the Confluent Cloud token-exchange endpoint.
+const DefaultTokenExchangePath = "/sts/v1/oauth2/token"
+
+// tokenExchangeGrantType is the OAuth 2.0 token-exchange grant (RFC 8693).
+const tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+// TokenExchangeConfig configures a TokenExchanger.
+type TokenExchangeConfig struct {
+	// Path is the token-exchange endpoint (optional, defaults to DefaultTokenExchangePath)
+	Path string
+	// Scope restricts the token, e.g. to a Flink region or Tableflow (optional)
+	Scope string
+	// Audience is the data-plane API the token is for (optional)
+	Audience string
+	// RefreshBefore is how long before expiry a token is refreshed (optional, defaults to 1 minute)
+	RefreshBefore time.Duration
+}
+
+// TokenExchanger exchanges Cloud API credentials for short-lived scoped tokens,
+// caching each token and refreshing it shortly before it expires. It is a TokenSource,
+// so it can be plugged into a data-plane client:
+//
+//	cloud, _ := client.NewClient(client.Config{BaseURL: "https://api.confluent.cloud", APIKey: key, APISecret: secret})
+//	flink, _ := client.NewClient(client.Config{
+//		BaseURL:     "https://flink.us-east-1.aws.confluent.cloud",
+//		TokenSource: client.NewTokenExchanger(cloud, client.TokenExchangeConfig{Scope: "flink"}),
+//	})
+type TokenExchanger struct {
+	c      *Client
+	config TokenExchangeConfig
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewTokenExchanger creates a token exchanger that authenticates with c's credentials.
+func NewTokenExchanger(c *Client, config TokenExchangeConfig) *TokenExchanger {
+	if config.Path == "" {
+		config.Path = DefaultTokenExchangePath
+	}
+	if config.RefreshBefore <= 0 {
+		config.RefreshBefore = time.Minute
+	}
+	return &TokenExchanger{c: c, config: config}
+}
+
+// Token implements TokenSource, returning the cached token while it is fresh.
+func (e *TokenExchanger) Token(ctx context.Context) (*Token, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.token.valid(e.c.clock.Now(), e.config.RefreshBefore) {
+		return e.token, nil
+	}
+
+	body := map[string]string{"grant_type": tokenExchangeGrantType}
+	if e.config.Scope != "" {
+		body["scope"] = e.config.Scope
+	}
+	if e.config.Audience != "" {
+		body["audience"] = e.config.Audience
+	}
+	resp, err := e.c.Do(ctx, Request{Method: "POST", Path: e.config.Path, Body: body})
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange token: %w", err)
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse token exchange response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return nil, fmt.Errorf("token exchange response did not include an access token")
+	}
+
+	token := &Token{AccessToken: result.AccessToken}
+	if result.ExpiresIn > 0 {
+		token.ExpiresAt = e.c.clock.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	e.token = token
+	return token, nil
+}
+
+// Invalidate drops the cached token so the next call exchanges a new one,
+// e.g. after the data-plane API rejected it with 401.
+func (e *TokenExchanger) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.token = nil
+}
@@ -0,0 +1,60 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestTokenExchanger_CachesAndAuthenticatesDataPlane(t *testing.T) {
+	exchanges := 0
+	cloud := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != client.DefaultTokenExchangePath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if user, _, ok := r.BasicAuth(); !ok || user != "cloud-key" {
+			t.Error("expected token exchange to use cloud credentials")
+		}
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["scope"] != "flink" {
+			t.Errorf("unexpected scope %q", body["scope"])
+		}
+		exchanges++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "tok-1", "expires_in": 3600})
+	}))
+	defer cloud.Close()
+
+	flink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer tok-1" {
+			t.Errorf("Authorization = %q, want bearer token", got)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer flink.Close()
+
+	cloudClient, err := client.NewClient(client.Config{BaseURL: cloud.URL, APIKey: "cloud-key", APISecret: "cloud-secret"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	flinkClient, err := client.NewClient(client.Config{
+		BaseURL:     flink.URL,
+		TokenSource: client.NewTokenExchanger(cloudClient, client.TokenExchangeConfig{Scope: "flink"}),
+	})
+	if err != nil {
+		t.Fatalf("NewClient with TokenSource failed: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := flinkClient.Do(context.Background(), client.Request{Method: "GET", Path: "/sql/v1/statements"}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+	if exchanges != 1 {
+		t.Errorf("expected 1 token exchange, got %d", exchanges)
+	}
+}
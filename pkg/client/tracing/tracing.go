@@ -0,0 +1,101 @@
+// Package tracing creates a span for every client.Client round trip and propagates
+// trace context on outgoing requests.
+//
+// The package defines a minimal Tracer interface instead of depending on a tracing
+// SDK; adapting an OpenTelemetry tracer takes a few lines:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (o otelTracer) Start(ctx context.Context, name string) (context.Context, tracing.Span) {
+//		ctx, span := o.t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{ctx: ctx, span: span}
+//	}
+//
+// where otelSpan maps SetAttribute/RecordError/End onto the OpenTelemetry span and
+// Inject calls otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(h)).
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Attribute keys set on client spans, following OpenTelemetry HTTP semantic conventions.
+const (
+	AttrHTTPMethod     = "http.request.method"
+	AttrURLPath        = "url.path" // sanitized path template, see client.PathTemplate
+	AttrServerAddress  = "server.address"
+	AttrHTTPStatusCode = "http.response.status_code"
+	AttrRequestID      = "confluent.request_id"
+	AttrAPIFamily      = "confluent.api_family"
+)
+
+// RequestIDHeader is the response header carrying the Confluent request ID.
+const RequestIDHeader = api.RequestIDHeader
+
+// Tracer starts client spans.
+type Tracer interface {
+	// Start begins a span named name as a child of any span in ctx
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is an in-progress client span.
+type Span interface {
+	// SetAttribute records a string attribute on the span
+	SetAttribute(key string, value string)
+	// RecordError marks the span as failed
+	RecordError(err error)
+	// Inject writes trace propagation headers (e.g., traceparent) into h
+	Inject(h http.Header)
+	// End completes the span
+	End()
+}
+
+// Middleware returns a client middleware that traces every round trip with tracer.
+// Spans are named "<METHOD> <path template>" and annotated with the method,
+// sanitized path, status code, and Confluent request ID.
+func Middleware(tracer Tracer) client.Middleware {
+	return func(next client.RoundTripFunc) client.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			template := client.PathTemplate(req.URL.Path)
+			ctx, span := tracer.Start(req.Context(), req.Method+" "+template)
+			defer span.End()
+
+			span.SetAttribute(AttrHTTPMethod, req.Method)
+			span.SetAttribute(AttrURLPath, template)
+			span.SetAttribute(AttrServerAddress, req.URL.Hostname())
+			span.SetAttribute(AttrAPIFamily, client.EndpointClass(req.Method, req.URL.Path))
+
+			req = req.WithContext(ctx)
+			span.Inject(req.Header)
+
+			resp, err := next(req)
+			if err != nil {
+				span.RecordError(err)
+				return resp, err
+			}
+
+			span.SetAttribute(AttrHTTPStatusCode, strconv.Itoa(resp.StatusCode))
+			if id := resp.Header.Get(RequestIDHeader); id != "" {
+				span.SetAttribute(AttrRequestID, id)
+			}
+			if resp.StatusCode >= 500 {
+				span.RecordError(&statusError{code: resp.StatusCode})
+			}
+			return resp, nil
+		}
+	}
+}
+
+// statusError marks spans for server-side failures.
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return "HTTP " + strconv.Itoa(e.code) + " " + http.StatusText(e.code)
+}
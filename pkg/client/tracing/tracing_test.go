@@ -0,0 +1,82 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/client/tracing"
+)
+
+type fakeSpan struct {
+	name  string
+	attrs map[string]string
+	err   error
+	ended bool
+}
+
+func (s *fakeSpan) SetAttribute(key string, value string) { s.attrs[key] = value }
+func (s *fakeSpan) RecordError(err error)                 { s.err = err }
+func (s *fakeSpan) Inject(h http.Header) {
+	h.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+}
+func (s *fakeSpan) End() { s.ended = true }
+
+type fakeTracer struct {
+	spans []*fakeSpan
+}
+
+func (t *fakeTracer) Start(ctx context.Context, name string) (context.Context, tracing.Span) {
+	span := &fakeSpan{name: name, attrs: map[string]string{}}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestMiddleware_CreatesSpanAndPropagates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") == "" {
+			t.Error("Expected traceparent header to be propagated")
+		}
+		w.Header().Set(tracing.RequestIDHeader, "req-abc")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tracer := &fakeTracer{}
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		Middlewares: []client.Middleware{tracing.Middleware(tracer)},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	_, _ = c.Do(context.Background(), client.Request{Method: "DELETE", Path: "/kafka/v3/clusters/lkc-1/topics/orders"})
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "DELETE /kafka/v3/clusters/{id}/topics/{id}" {
+		t.Errorf("Unexpected span name %q", span.name)
+	}
+	want := map[string]string{
+		tracing.AttrHTTPMethod:     "DELETE",
+		tracing.AttrURLPath:        "/kafka/v3/clusters/{id}/topics/{id}",
+		tracing.AttrHTTPStatusCode: "503",
+		tracing.AttrRequestID:      "req-abc",
+		tracing.AttrAPIFamily:      "kafka",
+	}
+	for k, v := range want {
+		if span.attrs[k] != v {
+			t.Errorf("Expected attribute %s=%q, got %q", k, v, span.attrs[k])
+		}
+	}
+	if span.err == nil || !span.ended {
+		t.Errorf("Expected ended span with recorded error, got %+v", span)
+	}
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Transport timeouts used by the default HTTP client. They bound connection setup
+// and time-to-first-byte only; the overall request duration is governed by the
+// caller's context, Config.RequestTimeout, and Request.Timeout.
+const (
+	defaultDialTimeout           = 10 * time.Second
+	defaultKeepAlive             = 30 * time.Second
+	defaultTLSHandshakeTimeout   = 10 * time.Second
+	defaultResponseHeaderTimeout = 60 * time.Second
+	defaultIdleConnTimeout       = 90 * time.Second
+	defaultExpectContinueTimeout = 1 * time.Second
+	defaultMaxIdleConnsPerHost   = 10
+)
+
+// newDefaultHTTPClient returns the HTTP client used when Config.HTTPClient is nil.
+func newDefaultHTTPClient() *http.Client {
+	return &http.Client{Transport: newDefaultTransport()}
+}
+
+func newDefaultTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   defaultDialTimeout,
+		KeepAlive: defaultKeepAlive,
+	}
+	return &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   defaultMaxIdleConnsPerHost,
+		IdleConnTimeout:       defaultIdleConnTimeout,
+		TLSHandshakeTimeout:   defaultTLSHandshakeTimeout,
+		ResponseHeaderTimeout: defaultResponseHeaderTimeout,
+		ExpectContinueTimeout: defaultExpectContinueTimeout,
+	}
+}
+
+// TransportConfig tunes connection pooling of the default transport, e.g. for
+// high-throughput reconcilers issuing many concurrent requests to one endpoint.
+// Zero fields keep the defaults.
+type TransportConfig struct {
+	// MaxIdleConns caps idle connections across all hosts (default 100)
+	MaxIdleConns int
+	// MaxIdleConnsPerHost caps idle connections kept per host (default 10); raise it to
+	// the expected request concurrency so connections are reused instead of re-dialed
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost caps all connections per host, including active ones (default unlimited)
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept open (default 90 seconds)
+	IdleConnTimeout time.Duration
+	// KeepAlive is the TCP keep-alive probe interval (default 30 seconds)
+	KeepAlive time.Duration
+	// DisableKeepAlives opens a new connection for every request
+	DisableKeepAlives bool
+	// DisableHTTP2 restricts connections to HTTP/1.1. HTTP/2 is otherwise negotiated
+	// whenever the server supports it, including with a custom TLS configuration.
+	DisableHTTP2 bool
+}
+
+// apply sets the non-zero fields of the configuration on transport.
+func (c *TransportConfig) apply(transport *http.Transport) {
+	if c.MaxIdleConns > 0 {
+		transport.MaxIdleConns = c.MaxIdleConns
+	}
+	if c.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = c.MaxIdleConnsPerHost
+	}
+	if c.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = c.MaxConnsPerHost
+	}
+	if c.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = c.IdleConnTimeout
+	}
+	if c.KeepAlive != 0 {
+		dialer := &net.Dialer{Timeout: defaultDialTimeout, KeepAlive: c.KeepAlive}
+		transport.DialContext = dialer.DialContext
+	}
+	transport.DisableKeepAlives = c.DisableKeepAlives
+	if c.DisableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+}
+
+// TLSConfig configures TLS for connections to the API, e.g. for Confluent Platform
+// deployments with a private CA or mutual TLS.
+type TLSConfig struct {
+	// CAFile is a PEM bundle of CA certificates to trust in addition to the system roots
+	CAFile string
+	// CAPEM is PEM-encoded CA certificates to trust in addition to the system roots
+	CAPEM []byte
+	// CertFile and KeyFile are the PEM client certificate and key for mutual TLS
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the host name used to verify the server certificate
+	ServerName string
+	// MinVersion is the minimum TLS version (defaults to TLS 1.2)
+	MinVersion uint16
+	// InsecureSkipVerify disables server certificate verification. Only use it in test rigs.
+	InsecureSkipVerify bool
+}
+
+// build converts the configuration to a *tls.Config.
+func (c *TLSConfig) build() (*tls.Config, error) {
+	cfg := &tls.Config{
+		ServerName:         c.ServerName,
+		MinVersion:         c.MinVersion,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if cfg.MinVersion == 0 {
+		cfg.MinVersion = tls.VersionTLS12
+	}
+
+	if c.CAFile != "" || len(c.CAPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		pems := [][]byte{c.CAPEM}
+		if c.CAFile != "" {
+			data, err := os.ReadFile(c.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA file: %w", err)
+			}
+			pems = append(pems, data)
+		}
+		for _, pem := range pems {
+			if len(pem) > 0 && !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no valid CA certificates found")
+			}
+		}
+		cfg.RootCAs = pool
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// newHTTPClient builds the HTTP client for config: config.HTTPClient when set,
+// otherwise a client with the default transport tuned by config.Transport, config.TLS,
+// and config.ProxyURL.
+func newHTTPClient(config Config) (*http.Client, error) {
+	if config.HTTPClient != nil {
+		if config.TLS != nil || config.ProxyURL != "" || config.Transport != nil {
+			return nil, fmt.Errorf("TLS, ProxyURL, and Transport cannot be combined with HTTPClient")
+		}
+		return config.HTTPClient, nil
+	}
+	if config.TLS == nil && config.ProxyURL == "" && config.Transport == nil {
+		return newDefaultHTTPClient(), nil
+	}
+
+	transport := newDefaultTransport()
+	if config.Transport != nil {
+		config.Transport.apply(transport)
+	}
+	if config.TLS != nil {
+		tlsConfig, err := config.TLS.build()
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid ProxyURL %q", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{Transport: transport}, nil
+}
@@ -0,0 +1,154 @@
+package client_test
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:        server.URL,
+		APIKey:         "test-key",
+		APISecret:      "test-secret",
+		RequestTimeout: 20 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	_, err = c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded with client timeout, got %v", err)
+	}
+
+	_, err = c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments", Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("Expected per-request timeout to override client timeout, got %v", err)
+	}
+}
+
+func TestNewClient_TLSWithCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	c, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		TLS:       &client.TLSConfig{CAPEM: caPEM},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/"}); err != nil {
+		t.Fatalf("Expected request trusted by custom CA to succeed, got %v", err)
+	}
+
+	untrusted, err := client.NewClient(client.Config{
+		BaseURL:   server.URL,
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := untrusted.Do(context.Background(), client.Request{Method: "GET", Path: "/"}); err == nil {
+		t.Fatal("Expected request to untrusted server to fail")
+	}
+}
+
+func TestNewClient_InvalidTransportConfig(t *testing.T) {
+	base := client.Config{BaseURL: "https://localhost", APIKey: "k", APISecret: "s"}
+
+	cfg := base
+	cfg.ProxyURL = "://bad"
+	if _, err := client.NewClient(cfg); err == nil {
+		t.Error("Expected invalid ProxyURL to be rejected")
+	}
+
+	cfg = base
+	cfg.TLS = &client.TLSConfig{CAPEM: []byte("not a certificate")}
+	if _, err := client.NewClient(cfg); err == nil {
+		t.Error("Expected invalid CA PEM to be rejected")
+	}
+
+	cfg = base
+	cfg.HTTPClient = &http.Client{}
+	cfg.ProxyURL = "http://proxy:3128"
+	if _, err := client.NewClient(cfg); err == nil {
+		t.Error("Expected ProxyURL with HTTPClient to be rejected")
+	}
+}
+
+func TestClientDo_TransportConfig(t *testing.T) {
+	var conns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	for _, tc := range []struct {
+		transport *client.TransportConfig
+		wantConns int32
+	}{
+		{&client.TransportConfig{MaxIdleConnsPerHost: 32, KeepAlive: time.Minute, DisableHTTP2: true}, 1},
+		{&client.TransportConfig{DisableKeepAlives: true}, 3},
+	} {
+		conns.Store(0)
+		c, err := client.NewClient(client.Config{
+			BaseURL:   server.URL,
+			APIKey:    "test-key",
+			APISecret: "test-secret",
+			Transport: tc.transport,
+		})
+		if err != nil {
+			t.Fatalf("NewClient failed: %v", err)
+		}
+		for i := 0; i < 3; i++ {
+			if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"}); err != nil {
+				t.Fatalf("Do failed: %v", err)
+			}
+		}
+		if got := conns.Load(); got != tc.wantConns {
+			t.Errorf("%+v: opened %d connections, want %d", tc.transport, got, tc.wantConns)
+		}
+	}
+
+	_, err := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		APIKey:     "test-key",
+		APISecret:  "test-secret",
+		HTTPClient: &http.Client{},
+		Transport:  &client.TransportConfig{},
+	})
+	if err == nil {
+		t.Error("Expected Transport with HTTPClient to be rejected")
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// List is the envelope of Confluent list responses ({"data": [...]}).
+type List[T any] struct {
+	Data []T `json:"data"`
+}
+
+// DoJSON executes req and decodes the JSON response into a new T.
+// Request errors are returned as-is (typically *api.Error); decode errors are wrapped.
+func DoJSON[T any](ctx context.Context, d Doer, req Request) (*T, error) {
+	resp, err := d.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var v T
+	if err := resp.DecodeJSON(&v); err != nil {
+		return nil, fmt.Errorf("failed to parse %s %s response: %w", req.Method, PathTemplate(req.Path), err)
+	}
+	return &v, nil
+}
+
+// GetJSON sends a GET request to path and decodes the JSON response into a new T.
+func GetJSON[T any](ctx context.Context, d Doer, path string) (*T, error) {
+	return DoJSON[T](ctx, d, Request{Method: http.MethodGet, Path: path})
+}
+
+// GetList sends a GET request to a list endpoint and returns the decoded data items.
+func GetList[T any](ctx context.Context, d Doer, path string) ([]T, error) {
+	list, err := GetJSON[List[T]](ctx, d, path)
+	if err != nil {
+		return nil, err
+	}
+	return list.Data, nil
+}
+
+// PostJSON sends body as JSON in a POST request to path and decodes the JSON response into a new Resp.
+func PostJSON[Req, Resp any](ctx context.Context, d Doer, path string, body Req) (*Resp, error) {
+	return DoJSON[Resp](ctx, d, Request{Method: http.MethodPost, Path: path, Body: body})
+}
+
+// PutJSON sends body as JSON in a PUT request to path and decodes the JSON response into a new Resp.
+func PutJSON[Req, Resp any](ctx context.Context, d Doer, path string, body Req) (*Resp, error) {
+	return DoJSON[Resp](ctx, d, Request{Method: http.MethodPut, Path: path, Body: body})
+}
+
+// PatchJSON sends body as JSON in a PATCH request to path and decodes the JSON response into a new Resp.
+func PatchJSON[Req, Resp any](ctx context.Context, d Doer, path string, body Req) (*Resp, error) {
+	return DoJSON[Resp](ctx, d, Request{Method: http.MethodPatch, Path: path, Body: body})
+}
+
+// Delete sends a DELETE request to path, discarding the response body.
+func Delete(ctx context.Context, d Doer, path string) error {
+	_, err := d.Do(ctx, Request{Method: http.MethodDelete, Path: path})
+	return err
+}
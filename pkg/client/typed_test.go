@@ -0,0 +1,55 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestTypedHelpers(t *testing.T) {
+	var requests []client.Request
+	fake := client.DoerFunc(func(ctx context.Context, req client.Request) (*client.Response, error) {
+		requests = append(requests, req)
+		switch {
+		case req.Method == http.MethodGet && req.Path == "/org/v2/environments":
+			return &client.Response{StatusCode: http.StatusOK, Body: []byte(`{"data":[{"id":"env-1"},{"id":"env-2"}]}`)}, nil
+		case req.Method == http.MethodPost:
+			body, _ := json.Marshal(req.Body)
+			return &client.Response{StatusCode: http.StatusCreated, Body: body}, nil
+		case req.Method == http.MethodGet && req.Path == "/org/v2/environments/env-bad":
+			return &client.Response{StatusCode: http.StatusOK, Body: []byte(`{"id":`)}, nil
+		default:
+			return nil, api.NewError(http.StatusNotFound, []byte(`{"message":"not found"}`), nil)
+		}
+	})
+	ctx := context.Background()
+
+	envs, err := client.GetList[api.Environment](ctx, fake, "/org/v2/environments")
+	if err != nil || len(envs) != 2 || envs[1].ID != "env-2" {
+		t.Fatalf("GetList = %v, %v", envs, err)
+	}
+
+	created, err := client.PostJSON[api.Environment, api.Environment](ctx, fake, "/org/v2/environments", api.Environment{ID: "env-3"})
+	if err != nil || created.ID != "env-3" {
+		t.Fatalf("PostJSON = %v, %v", created, err)
+	}
+
+	var apiErr *api.Error
+	if _, err := client.GetJSON[api.Environment](ctx, fake, "/org/v2/environments/env-missing"); !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+		t.Errorf("Expected *api.Error not found, got %v", err)
+	}
+	if _, err := client.GetJSON[api.Environment](ctx, fake, "/org/v2/environments/env-bad"); err == nil || errors.As(err, &apiErr) {
+		t.Errorf("Expected decode error, got %v", err)
+	}
+	if err := client.Delete(ctx, fake, "/org/v2/environments/env-1"); err == nil {
+		t.Error("Expected error from Delete")
+	}
+	if requests[len(requests)-1].Method != http.MethodDelete {
+		t.Errorf("Expected DELETE, got %s", requests[len(requests)-1].Method)
+	}
+}
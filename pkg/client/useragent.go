@@ -0,0 +1,56 @@
+package client
+
+import (
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+const modulePath = "github.com/creiche/confluent-go"
+
+var (
+	versionOnce sync.Once
+	version     string
+)
+
+// Version returns the version of this SDK as recorded in the binary's build info
+// (e.g., "v1.2.0"), or "dev" when it cannot be determined.
+func Version() string {
+	versionOnce.Do(func() {
+		version = "dev"
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			return
+		}
+		if info.Main.Path == modulePath && info.Main.Version != "" && info.Main.Version != "(devel)" {
+			version = info.Main.Version
+			return
+		}
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				if dep.Replace != nil && dep.Replace.Version != "" {
+					version = dep.Replace.Version
+				} else if dep.Version != "" {
+					version = dep.Version
+				}
+				return
+			}
+		}
+	})
+	return version
+}
+
+// DefaultUserAgent returns the User-Agent sent when Config.UserAgent is empty,
+// e.g. "confluent-go/v1.2.0".
+func DefaultUserAgent() string {
+	return "confluent-go/" + Version()
+}
+
+// userAgent builds the User-Agent header, appending the caller-supplied suffix to the default.
+func userAgent(suffix string) string {
+	suffix = strings.TrimSpace(suffix)
+	if suffix == "" {
+		return DefaultUserAgent()
+	}
+	return DefaultUserAgent() + " " + suffix
+}
@@ -0,0 +1,44 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func TestClientDo_UserAgent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tests := map[string]string{
+		"":                      client.DefaultUserAgent(),
+		"orders-operator/2.3.1": client.DefaultUserAgent() + " orders-operator/2.3.1",
+	}
+	for suffix, want := range tests {
+		c, err := client.NewClient(client.Config{
+			BaseURL:   server.URL,
+			APIKey:    "test-key",
+			APISecret: "test-secret",
+			UserAgent: suffix,
+		})
+		if err != nil {
+			t.Fatalf("NewClient failed: %v", err)
+		}
+		if _, err := c.Do(context.Background(), client.Request{Method: "GET", Path: "/org/v2/environments"}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+		if got != want {
+			t.Errorf("User-Agent = %q, want %q", got, want)
+		}
+	}
+	if client.DefaultUserAgent() != "confluent-go/"+client.Version() {
+		t.Errorf("unexpected default User-Agent %q", client.DefaultUserAgent())
+	}
+}
@@ -0,0 +1,99 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/retry"
+)
+
+func TestClientWith(t *testing.T) {
+	var paths, envHeaders []string
+	var failures int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		envHeaders = append(envHeaders, r.Header.Get("X-Environment"))
+		if r.URL.Path == "/kafka/v3/clusters/lkc-1/topics/flaky" && failures == 0 {
+			failures++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	base, err := client.NewClient(client.Config{BaseURL: server.URL, APIKey: "test-key", APISecret: "test-secret"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	cluster := base.With(
+		client.WithBasePath("/kafka/v3/clusters/lkc-1"),
+		client.WithHeaders(map[string]string{"X-Environment": "env-1"}),
+		client.WithTimeout(time.Second),
+		client.WithRetry(retry.DefaultStrategy().WithInitialBackoff(time.Millisecond)),
+	)
+	ctx := context.Background()
+
+	if _, err := cluster.Do(ctx, client.Request{Method: "GET", Path: "/topics/flaky"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if _, err := cluster.Do(ctx, client.Request{Method: "GET", Path: "topics", Headers: map[string]string{"X-Environment": "env-2"}}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if _, err := base.Do(ctx, client.Request{Method: "GET", Path: "/org/v2/environments"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	wantPaths := []string{"/kafka/v3/clusters/lkc-1/topics/flaky", "/kafka/v3/clusters/lkc-1/topics/flaky", "/kafka/v3/clusters/lkc-1/topics", "/org/v2/environments"}
+	wantHeaders := []string{"env-1", "env-1", "env-2", ""}
+	if len(paths) != len(wantPaths) {
+		t.Fatalf("Expected %d requests, got %v", len(wantPaths), paths)
+	}
+	for i := range wantPaths {
+		if paths[i] != wantPaths[i] || envHeaders[i] != wantHeaders[i] {
+			t.Errorf("request %d: got %s (%q), want %s (%q)", i, paths[i], envHeaders[i], wantPaths[i], wantHeaders[i])
+		}
+	}
+}
+
+func TestClientWithRetry_ReplaysStreamedBodies(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	base, err := client.NewClient(client.Config{BaseURL: server.URL, APIKey: "test-key", APISecret: "test-secret"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	c := base.With(client.WithRetry(retry.DefaultStrategy().WithInitialBackoff(time.Millisecond)))
+	ctx := context.Background()
+
+	if _, err := c.Do(ctx, client.Request{Method: "PUT", Path: "/streamed", Body: strings.NewReader(`{"a":1}`)}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	multipart := &client.Multipart{Files: []client.MultipartFile{{FieldName: "file", FileName: "p.zip", Content: strings.NewReader("archive")}}}
+	if _, err := c.Do(ctx, client.Request{Method: "PUT", Path: "/multipart", Multipart: multipart}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	if len(bodies) != 4 || bodies[0] != `{"a":1}` || bodies[1] != bodies[0] {
+		t.Fatalf("Expected the streamed body on both attempts, got %q", bodies)
+	}
+	if !strings.Contains(bodies[2], "archive") || bodies[3] != bodies[2] {
+		t.Errorf("Expected the multipart body on both attempts, got %q", bodies[2:])
+	}
+}
@@ -0,0 +1,159 @@
+// Package clock abstracts time so that retry, wait, and watch behavior can be
+// tested deterministically or replayed at accelerated speed.
+//
+// Components that sleep or read the current time accept a Clock and default to
+// Real. Tests substitute a Fake and advance it explicitly:
+//
+//	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+//	strategy := retry.DefaultStrategy().WithClock(fake)
+//	go func() { done <- strategy.Do(ctx, op) }()
+//	fake.BlockUntil(1) // wait for the first backoff to start
+//	fake.Advance(time.Second)
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and timers.
+// Implementations must be safe for concurrent use.
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// After returns a channel that receives the current time once d has elapsed
+	After(d time.Duration) <-chan time.Time
+}
+
+// Real is the Clock backed by the time package.
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Since returns the time elapsed since t according to c.
+func Since(c Clock, t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// OrReal returns c, or Real if c is nil.
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real
+	}
+	return c
+}
+
+// Fake is a manually advanced Clock for tests. Time only moves when Advance or Set is called.
+type Fake struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+// NewFake creates a fake clock set to start.
+func NewFake(start time.Time) *Fake {
+	f := &Fake{now: start}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now implements Clock.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// After implements Clock. The channel fires once the clock is advanced past d.
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- f.now
+		return ch
+	}
+	f.waiters = append(f.waiters, fakeWaiter{deadline: f.now.Add(d), ch: ch})
+	f.cond.Broadcast()
+	return ch
+}
+
+// Advance moves the clock forward by d, firing every timer whose deadline has passed.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setLocked(f.now.Add(d))
+}
+
+// Set moves the clock to t, firing every timer whose deadline has passed.
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setLocked(t)
+}
+
+func (f *Fake) setLocked(t time.Time) {
+	f.now = t
+	sort.SliceStable(f.waiters, func(i, j int) bool { return f.waiters[i].deadline.Before(f.waiters[j].deadline) })
+	remaining := f.waiters[:0]
+	for _, w := range f.waiters {
+		if !w.deadline.After(t) {
+			w.ch <- t
+		} else {
+			remaining = append(remaining, w)
+		}
+	}
+	f.waiters = remaining
+}
+
+// BlockUntil blocks until at least n timers are waiting on the clock, which lets
+// tests advance time only after the code under test has started waiting.
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// Scaled is a Clock that runs factor times faster than real time from the moment it
+// is created, e.g. for replaying recorded sessions at accelerated speed.
+type Scaled struct {
+	factor    float64
+	realStart time.Time
+	start     time.Time
+}
+
+// NewScaled creates a clock starting at start that advances factor times faster than real time.
+func NewScaled(start time.Time, factor float64) *Scaled {
+	if factor <= 0 {
+		factor = 1
+	}
+	return &Scaled{factor: factor, realStart: time.Now(), start: start}
+}
+
+// Now implements Clock.
+func (s *Scaled) Now() time.Time {
+	elapsed := time.Since(s.realStart)
+	return s.start.Add(time.Duration(float64(elapsed) * s.factor))
+}
+
+// After implements Clock.
+func (s *Scaled) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	go func() {
+		<-time.After(time.Duration(float64(d) / s.factor))
+		ch <- s.Now()
+	}()
+	return ch
+}
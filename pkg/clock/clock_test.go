@@ -0,0 +1,49 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake_AdvanceFiresTimersInOrder(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+
+	short := f.After(time.Second)
+	long := f.After(time.Minute)
+	f.BlockUntil(2)
+
+	f.Advance(30 * time.Second)
+	select {
+	case got := <-short:
+		if !got.Equal(start.Add(30 * time.Second)) {
+			t.Errorf("short timer fired at %v", got)
+		}
+	default:
+		t.Fatal("short timer did not fire")
+	}
+	select {
+	case <-long:
+		t.Fatal("long timer fired early")
+	default:
+	}
+
+	f.Advance(30 * time.Second)
+	select {
+	case <-long:
+	default:
+		t.Fatal("long timer did not fire")
+	}
+	if Since(f, start) != time.Minute {
+		t.Errorf("Since = %v, want 1m", Since(f, start))
+	}
+}
+
+func TestScaled_RunsFaster(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewScaled(start, 1000)
+	<-s.After(time.Second) // ~1ms of real time
+	if s.Now().Sub(start) < time.Second {
+		t.Errorf("expected at least 1s of scaled time, got %v", s.Now().Sub(start))
+	}
+}
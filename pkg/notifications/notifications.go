@@ -0,0 +1,196 @@
+// Package notifications provides server-side helpers for receiving Confluent Cloud
+// notification webhooks.
+//
+// A Handler verifies the signature of each delivery, decodes it into a typed Event,
+// and passes it to a callback, enabling push-based reconciliation instead of polling:
+//
+//	events := make(chan notifications.Event, 16)
+//	h := notifications.NewHandler(notifications.NewHMACVerifier(secret), notifications.ChannelSink(events))
+//	http.Handle("/confluent/webhook", h)
+//
+//	for ev := range events {
+//		if ev.Resource.Type == notifications.ResourceTypeCluster {
+//			// enqueue reconcile for ev.Resource.ID
+//		}
+//	}
+package notifications
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultSignatureHeader is the header carrying the webhook payload signature.
+const DefaultSignatureHeader = "X-Confluent-Signature"
+
+// maxPayloadBytes bounds the size of an accepted webhook payload.
+const maxPayloadBytes = 1 << 20
+
+// Resource types referenced by notification events.
+const (
+	ResourceTypeCluster        = "CLUSTER"
+	ResourceTypeConnector      = "CONNECTOR"
+	ResourceTypeEnvironment    = "ENVIRONMENT"
+	ResourceTypeSchemaRegistry = "SCHEMA_REGISTRY"
+)
+
+// ErrInvalidSignature is returned by a Verifier when a payload signature is missing or does not match.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Event is a typed Confluent Cloud notification.
+type Event struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`     // e.g. CLUSTER_PROVISIONED, CONNECTOR_FAILED
+	Severity   string    `json:"severity"` // INFO, WARN, CRITICAL
+	OccurredAt time.Time `json:"occurred_at"`
+	Message    string    `json:"message"`
+	Resource   Resource  `json:"resource"`
+	// Raw holds the complete payload so fields not modeled here remain accessible
+	Raw json.RawMessage `json:"-"`
+}
+
+// Resource identifies the Confluent resource an event is about.
+type Resource struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	EnvironmentID string `json:"environment_id"`
+}
+
+// Verifier authenticates a webhook delivery before it is decoded.
+type Verifier interface {
+	// Verify returns an error if the request is not an authentic delivery of body
+	Verify(r *http.Request, body []byte) error
+}
+
+// HMACVerifier verifies deliveries signed with HMAC-SHA256 over the raw request body.
+// The signature header holds the hex digest, optionally prefixed with "sha256=".
+type HMACVerifier struct {
+	secret []byte
+	header string
+}
+
+// NewHMACVerifier creates a verifier for the shared webhook secret using DefaultSignatureHeader.
+func NewHMACVerifier(secret string) *HMACVerifier {
+	return &HMACVerifier{secret: []byte(secret), header: DefaultSignatureHeader}
+}
+
+// WithHeader overrides the header that carries the signature.
+func (v *HMACVerifier) WithHeader(header string) *HMACVerifier {
+	if header != "" {
+		v.header = header
+	}
+	return v
+}
+
+// Verify implements Verifier.
+func (v *HMACVerifier) Verify(r *http.Request, body []byte) error {
+	signature := strings.TrimPrefix(r.Header.Get(v.header), "sha256=")
+	if signature == "" {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal(got, Sign(v.secret, body)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Sign computes the HMAC-SHA256 signature of body, e.g. for testing webhook receivers.
+func Sign(secret []byte, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// Handler is an http.Handler that receives notification webhooks.
+// Unauthentic deliveries are rejected with 401, malformed payloads with 400, and
+// callback failures with 500 so the sender retries the delivery.
+type Handler struct {
+	verifier Verifier
+	onEvent  func(ctx context.Context, event Event) error
+}
+
+// NewHandler creates a webhook handler. verifier may be nil to accept unsigned
+// deliveries (not recommended outside tests).
+func NewHandler(verifier Verifier, onEvent func(ctx context.Context, event Event) error) *Handler {
+	return &Handler{verifier: verifier, onEvent: onEvent}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadBytes+1))
+	if err != nil {
+		http.Error(w, "failed to read payload", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxPayloadBytes {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if h.verifier != nil {
+		if err := h.verifier.Verify(r, body); err != nil {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	event, err := ParseEvent(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.onEvent != nil {
+		if err := h.onEvent(r.Context(), event); err != nil {
+			http.Error(w, "failed to process event", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ParseEvent decodes a notification payload into an Event.
+func ParseEvent(body []byte) (Event, error) {
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return Event{}, fmt.Errorf("failed to parse notification payload: %w", err)
+	}
+	if event.Type == "" {
+		return Event{}, fmt.Errorf("notification payload missing required 'type' field")
+	}
+	event.Raw = append(json.RawMessage(nil), body...)
+	return event, nil
+}
+
+// ChannelSink returns an event callback that sends events to ch, blocking until the
+// event is received or the request context is cancelled.
+func ChannelSink(ch chan<- Event) func(ctx context.Context, event Event) error {
+	return func(ctx context.Context, event Event) error {
+		select {
+		case ch <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package notifications_test
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/notifications"
+)
+
+const payload = `{"id":"evt-1","type":"CLUSTER_PROVISIONED","severity":"INFO","occurred_at":"2024-05-01T10:00:00Z","resource":{"id":"lkc-123","type":"CLUSTER","environment_id":"env-1"}}`
+
+func signedRequest(secret string, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	sig := notifications.Sign([]byte(secret), []byte(body))
+	req.Header.Set(notifications.DefaultSignatureHeader, "sha256="+hex.EncodeToString(sig))
+	return req
+}
+
+func TestHandler_DeliversVerifiedEvent(t *testing.T) {
+	events := make(chan notifications.Event, 1)
+	h := notifications.NewHandler(notifications.NewHMACVerifier("s3cret"), notifications.ChannelSink(events))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest("s3cret", payload))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+	ev := <-events
+	if ev.Type != "CLUSTER_PROVISIONED" || ev.Resource.ID != "lkc-123" || ev.Resource.Type != notifications.ResourceTypeCluster {
+		t.Errorf("Unexpected event: %+v", ev)
+	}
+	if ev.OccurredAt.IsZero() || len(ev.Raw) == 0 {
+		t.Errorf("Expected timestamp and raw payload to be populated: %+v", ev)
+	}
+}
+
+func TestHandler_RejectsBadSignature(t *testing.T) {
+	called := false
+	h := notifications.NewHandler(notifications.NewHMACVerifier("s3cret"), func(ctx context.Context, ev notifications.Event) error {
+		called = true
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest("wrong-secret", payload))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for bad signature, got %d", rec.Code)
+	}
+
+	unsigned := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, unsigned)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for missing signature, got %d", rec.Code)
+	}
+	if called {
+		t.Error("Callback must not run for unverified deliveries")
+	}
+}
+
+func TestParseEvent_RequiresType(t *testing.T) {
+	if _, err := notifications.ParseEvent([]byte(`{"id":"evt-1"}`)); err == nil {
+		t.Error("Expected error for payload without type")
+	}
+	if _, err := notifications.ParseEvent([]byte(`not json`)); err == nil {
+		t.Error("Expected error for malformed payload")
+	}
+}
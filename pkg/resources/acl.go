@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/creiche/confluent-go/pkg/api"
 	"github.com/creiche/confluent-go/pkg/client"
@@ -11,12 +12,24 @@ import (
 
 // ACLManager handles ACL-related operations via REST API.
 type ACLManager struct {
-	client *client.Client
+	client   client.Doer
+	basePath string
 }
 
-// NewACLManager creates a new ACL manager.
-func NewACLManager(c *client.Client) *ACLManager {
-	return &ACLManager{client: c}
+// NewACLManager creates a new ACL manager for the Confluent Cloud Kafka REST API.
+func NewACLManager(c client.Doer) *ACLManager {
+	return &ACLManager{client: c, basePath: KafkaBasePathCloud}
+}
+
+// WithBasePath sets the Kafka REST v3 base path, e.g. KafkaBasePathPlatform to target
+// a Confluent Platform REST Proxy or broker-embedded REST API (default KafkaBasePathCloud).
+func (am *ACLManager) WithBasePath(basePath string) *ACLManager {
+	am.basePath = strings.TrimSuffix(basePath, "/")
+	return am
+}
+
+func (am *ACLManager) clusterPath(clusterID string) string {
+	return am.basePath + "/clusters/" + clusterID
 }
 
 // ListACLs lists all ACL bindings in a cluster.
@@ -29,7 +42,7 @@ func NewACLManager(c *client.Client) *ACLManager {
 func (am *ACLManager) ListACLs(ctx context.Context, clusterID string) ([]api.ACLBinding, error) {
 	req := client.Request{
 		Method: "GET",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/acls", clusterID),
+		Path:   fmt.Sprintf("%s/acls", am.clusterPath(clusterID)),
 	}
 
 	resp, err := am.client.Do(ctx, req)
@@ -67,7 +80,7 @@ func (am *ACLManager) CreateACL(ctx context.Context, clusterID string, acl api.A
 
 	req := client.Request{
 		Method: "POST",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/acls", clusterID),
+		Path:   fmt.Sprintf("%s/acls", am.clusterPath(clusterID)),
 		Body:   body,
 	}
 
@@ -89,8 +102,8 @@ func (am *ACLManager) CreateACL(ctx context.Context, clusterID string, acl api.A
 func (am *ACLManager) DeleteACL(ctx context.Context, clusterID string, principal string, operation string, resourceType string, resourceName string) error {
 	req := client.Request{
 		Method: "DELETE",
-		Path: fmt.Sprintf("/kafka/v3/clusters/%s/acls?principal=%s&operation=%s&resource_type=%s&resource_name=%s",
-			clusterID, url.QueryEscape(principal), url.QueryEscape(operation), url.QueryEscape(resourceType), url.QueryEscape(resourceName)),
+		Path: fmt.Sprintf("%s/acls?principal=%s&operation=%s&resource_type=%s&resource_name=%s",
+			am.clusterPath(clusterID), url.QueryEscape(principal), url.QueryEscape(operation), url.QueryEscape(resourceType), url.QueryEscape(resourceName)),
 	}
 
 	_, err := am.client.Do(ctx, req)
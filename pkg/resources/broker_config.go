@@ -0,0 +1,190 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// BrokerConfigManager handles dynamic broker configuration via the Kafka REST v3 API.
+// Cluster-wide configs apply to every broker; per-broker configs override them.
+// Only dedicated clusters allow altering broker configs.
+type BrokerConfigManager struct {
+	client client.Doer
+}
+
+// NewBrokerConfigManager creates a new broker config manager.
+func NewBrokerConfigManager(c client.Doer) *BrokerConfigManager {
+	return &BrokerConfigManager{client: c}
+}
+
+// brokerConfigResource is the v3 wire format of a broker config; broker_id is a
+// number there and absent for cluster-wide configs.
+type brokerConfigResource struct {
+	BrokerID    *int32              `json:"broker_id"`
+	Name        string              `json:"name"`
+	Value       string              `json:"value"`
+	IsDefault   bool                `json:"is_default"`
+	IsReadOnly  bool                `json:"is_read_only"`
+	IsSensitive bool                `json:"is_sensitive"`
+	Source      string              `json:"source"`
+	Synonyms    []api.ConfigSynonym `json:"synonyms"`
+}
+
+func (r brokerConfigResource) config() api.BrokerConfig {
+	c := api.BrokerConfig{
+		Name:        r.Name,
+		Value:       r.Value,
+		IsDefault:   r.IsDefault,
+		IsReadOnly:  r.IsReadOnly,
+		IsSensitive: r.IsSensitive,
+		Source:      r.Source,
+		Synonyms:    r.Synonyms,
+	}
+	if r.BrokerID != nil {
+		c.BrokerID = strconv.Itoa(int(*r.BrokerID))
+	}
+	return c
+}
+
+// ListClusterConfigs lists the cluster-wide dynamic broker configs.
+// Returns errors:
+//   - *api.Error with IsNotFound() if cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (bm *BrokerConfigManager) ListClusterConfigs(ctx context.Context, clusterID string) ([]api.BrokerConfig, error) {
+	return bm.list(ctx, brokerConfigsPath(clusterID, ""))
+}
+
+// GetClusterConfig retrieves a cluster-wide broker config.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster or config does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (bm *BrokerConfigManager) GetClusterConfig(ctx context.Context, clusterID string, name string) (*api.BrokerConfig, error) {
+	return bm.get(ctx, brokerConfigsPath(clusterID, "")+"/"+name)
+}
+
+// UpdateClusterConfigs sets cluster-wide broker configs in a single request.
+// Returns errors:
+//   - *api.Error with IsBadRequest() if a config is unknown, read-only, or has an invalid value
+//   - *api.Error with IsForbidden() if the cluster type does not allow altering broker configs
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (bm *BrokerConfigManager) UpdateClusterConfigs(ctx context.Context, clusterID string, configs map[string]string) error {
+	return bm.alter(ctx, brokerConfigsPath(clusterID, ""), configs)
+}
+
+// ResetClusterConfig removes a cluster-wide broker config so it reverts to its default.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster or config does not exist
+//   - *api.Error with IsForbidden() if the cluster type does not allow altering broker configs
+func (bm *BrokerConfigManager) ResetClusterConfig(ctx context.Context, clusterID string, name string) error {
+	return bm.reset(ctx, brokerConfigsPath(clusterID, "")+"/"+name)
+}
+
+// ListBrokerConfigs lists the configs of a single broker.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster or broker does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (bm *BrokerConfigManager) ListBrokerConfigs(ctx context.Context, clusterID string, brokerID string) ([]api.BrokerConfig, error) {
+	return bm.list(ctx, brokerConfigsPath(clusterID, brokerID))
+}
+
+// GetBrokerConfig retrieves a config of a single broker.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster, broker, or config does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (bm *BrokerConfigManager) GetBrokerConfig(ctx context.Context, clusterID string, brokerID string, name string) (*api.BrokerConfig, error) {
+	return bm.get(ctx, brokerConfigsPath(clusterID, brokerID)+"/"+name)
+}
+
+// UpdateBrokerConfigs sets configs of a single broker in a single request.
+// Returns errors:
+//   - *api.Error with IsBadRequest() if a config is unknown, read-only, or has an invalid value
+//   - *api.Error with IsForbidden() if the cluster type does not allow altering broker configs
+func (bm *BrokerConfigManager) UpdateBrokerConfigs(ctx context.Context, clusterID string, brokerID string, configs map[string]string) error {
+	return bm.alter(ctx, brokerConfigsPath(clusterID, brokerID), configs)
+}
+
+// ResetBrokerConfig removes a config override from a single broker.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster, broker, or config does not exist
+//   - *api.Error with IsForbidden() if the cluster type does not allow altering broker configs
+func (bm *BrokerConfigManager) ResetBrokerConfig(ctx context.Context, clusterID string, brokerID string, name string) error {
+	return bm.reset(ctx, brokerConfigsPath(clusterID, brokerID)+"/"+name)
+}
+
+func (bm *BrokerConfigManager) list(ctx context.Context, path string) ([]api.BrokerConfig, error) {
+	resp, err := bm.client.Do(ctx, client.Request{Method: "GET", Path: path})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list broker configs: %w", err)
+	}
+
+	var result struct {
+		Data []brokerConfigResource `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse broker config list response: %w", err)
+	}
+
+	configs := make([]api.BrokerConfig, len(result.Data))
+	for i, r := range result.Data {
+		configs[i] = r.config()
+	}
+	return configs, nil
+}
+
+func (bm *BrokerConfigManager) get(ctx context.Context, path string) (*api.BrokerConfig, error) {
+	resp, err := bm.client.Do(ctx, client.Request{Method: "GET", Path: path})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get broker config: %w", err)
+	}
+
+	var r brokerConfigResource
+	if err := resp.DecodeJSON(&r); err != nil {
+		return nil, fmt.Errorf("failed to parse broker config response: %w", err)
+	}
+	config := r.config()
+	return &config, nil
+}
+
+func (bm *BrokerConfigManager) alter(ctx context.Context, path string, configs map[string]string) error {
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	data := make([]map[string]string, len(names))
+	for i, name := range names {
+		data[i] = map[string]string{"name": name, "value": configs[name]}
+	}
+
+	req := client.Request{
+		Method: "POST",
+		Path:   path + ":alter",
+		Body:   map[string]interface{}{"data": data},
+	}
+	if _, err := bm.client.Do(ctx, req); err != nil {
+		return fmt.Errorf("failed to update broker configs: %w", err)
+	}
+	return nil
+}
+
+func (bm *BrokerConfigManager) reset(ctx context.Context, path string) error {
+	if _, err := bm.client.Do(ctx, client.Request{Method: "DELETE", Path: path}); err != nil {
+		return fmt.Errorf("failed to reset broker config: %w", err)
+	}
+	return nil
+}
+
+// brokerConfigsPath returns the cluster-wide broker configs path, or the configs path
+// of a single broker when brokerID is set.
+func brokerConfigsPath(clusterID string, brokerID string) string {
+	if brokerID == "" {
+		return fmt.Sprintf("/kafka/v3/clusters/%s/broker-configs", clusterID)
+	}
+	return fmt.Sprintf("/kafka/v3/clusters/%s/brokers/%s/configs", clusterID, brokerID)
+}
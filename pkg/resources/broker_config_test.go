@@ -0,0 +1,61 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestBrokerConfigManager_ListBrokerConfigs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kafka/v3/clusters/lkc-123/brokers/1/configs" {
+			t.Errorf("Expected path /kafka/v3/clusters/lkc-123/brokers/1/configs, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"broker_id":1,"name":"num.io.threads","value":"16","source":"DYNAMIC_BROKER_CONFIG"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewBrokerConfigManager(c)
+
+	configs, err := mgr.ListBrokerConfigs(context.Background(), "lkc-123", "1")
+	if err != nil {
+		t.Fatalf("ListBrokerConfigs failed: %v", err)
+	}
+	if len(configs) != 1 || configs[0].BrokerID != "1" || configs[0].Source != resources.ConfigSourceDynamicBroker {
+		t.Errorf("Unexpected configs: %#v", configs)
+	}
+}
+
+func TestBrokerConfigManager_UpdateClusterConfigs(t *testing.T) {
+	var body struct {
+		Data []map[string]string `json:"data"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/kafka/v3/clusters/lkc-123/broker-configs:alter" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewBrokerConfigManager(c)
+
+	err := mgr.UpdateClusterConfigs(context.Background(), "lkc-123", map[string]string{
+		"log.retention.ms":          "604800000",
+		"auto.create.topics.enable": "false",
+	})
+	if err != nil {
+		t.Fatalf("UpdateClusterConfigs failed: %v", err)
+	}
+	if len(body.Data) != 2 || body.Data[0]["name"] != "auto.create.topics.enable" || body.Data[1]["value"] != "604800000" {
+		t.Errorf("Unexpected body: %v", body.Data)
+	}
+}
@@ -2,8 +2,10 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/creiche/confluent-go/pkg/api"
 	"github.com/creiche/confluent-go/pkg/client"
@@ -11,11 +13,11 @@ import (
 
 // ClusterManager handles cluster-related operations via REST API.
 type ClusterManager struct {
-	client *client.Client
+	client client.Doer
 }
 
 // NewClusterManager creates a new cluster manager.
-func NewClusterManager(c *client.Client) *ClusterManager {
+func NewClusterManager(c client.Doer) *ClusterManager {
 	return &ClusterManager{client: c}
 }
 
@@ -46,6 +48,23 @@ func (cm *ClusterManager) ListClusters(ctx context.Context, environmentID string
 	return result.Data, nil
 }
 
+// ListAllClusters lists the Kafka clusters of every environment in the organization,
+// querying up to concurrency environments at a time.
+// Environments whose clusters cannot be listed are reported as warnings on the result
+// rather than failing the operation; an error is returned only if the environments
+// themselves cannot be listed.
+func (cm *ClusterManager) ListAllClusters(ctx context.Context, concurrency int) (*Result[api.Cluster], error) {
+	envs, err := NewEnvironmentManager(cm.client).ListEnvironments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(envs))
+	for i, env := range envs {
+		ids[i] = env.ID
+	}
+	return fanOut(ctx, ids, concurrency, cm.ListClusters), nil
+}
+
 // GetCluster retrieves information about a specific cluster.
 // Returns errors:
 //   - *api.Error with IsNotFound() if cluster does not exist
@@ -164,3 +183,166 @@ func (cm *ClusterManager) UpdateCluster(ctx context.Context, clusterID string, d
 
 	return &cluster, nil
 }
+
+// Cluster types accepted by ClusterSpec.
+const (
+	ClusterTypeBasic      = "BASIC"
+	ClusterTypeStandard   = "STANDARD"
+	ClusterTypeEnterprise = "ENTERPRISE"
+	ClusterTypeDedicated  = "DEDICATED"
+	ClusterTypeFreight    = "FREIGHT"
+)
+
+// Cluster availability options. BASIC, STANDARD, and DEDICATED clusters use
+// SINGLE_ZONE/MULTI_ZONE; ENTERPRISE and FREIGHT clusters use LOW/HIGH.
+const (
+	AvailabilitySingleZone = "SINGLE_ZONE"
+	AvailabilityMultiZone  = "MULTI_ZONE"
+	AvailabilityLow        = "LOW"
+	AvailabilityHigh       = "HIGH"
+)
+
+// FreightClouds lists the cloud providers on which Freight clusters can be provisioned.
+var FreightClouds = []string{"AWS"}
+
+// ClusterSpec describes a Kafka cluster to be created with CreateClusterFromSpec.
+type ClusterSpec struct {
+	EnvironmentID string
+	DisplayName   string
+	Type          string // One of the ClusterType* constants
+	Cloud         string // AWS, GCP, AZURE
+	Region        string
+	// Availability is optional and defaults to SINGLE_ZONE, or HIGH for ENTERPRISE and FREIGHT clusters
+	Availability string
+	// CKU is the number of Confluent Kafka Units, required for DEDICATED clusters only
+	CKU int32
+}
+
+// Validate checks the spec for missing fields and for type-specific constraints
+// such as the Freight cluster cloud and availability requirements.
+func (s ClusterSpec) Validate() error {
+	if s.EnvironmentID == "" {
+		return fmt.Errorf("cluster spec: environment ID is required")
+	}
+	if s.DisplayName == "" {
+		return fmt.Errorf("cluster spec: display name is required")
+	}
+	if s.Cloud == "" || s.Region == "" {
+		return fmt.Errorf("cluster spec: cloud and region are required")
+	}
+
+	availability := s.availability()
+	switch s.Type {
+	case ClusterTypeBasic, ClusterTypeStandard, ClusterTypeDedicated:
+		if availability != AvailabilitySingleZone && availability != AvailabilityMultiZone {
+			return fmt.Errorf("cluster spec: %s clusters require %s or %s availability, got %q", s.Type, AvailabilitySingleZone, AvailabilityMultiZone, availability)
+		}
+	case ClusterTypeEnterprise:
+		if availability != AvailabilityLow && availability != AvailabilityHigh {
+			return fmt.Errorf("cluster spec: %s clusters require %s or %s availability, got %q", s.Type, AvailabilityLow, AvailabilityHigh, availability)
+		}
+	case ClusterTypeFreight:
+		if availability != AvailabilityHigh {
+			return fmt.Errorf("cluster spec: %s clusters require %s availability, got %q", s.Type, AvailabilityHigh, availability)
+		}
+		if !containsFold(FreightClouds, s.Cloud) {
+			return fmt.Errorf("cluster spec: %s clusters are not available on cloud %q (supported: %s)", s.Type, s.Cloud, strings.Join(FreightClouds, ", "))
+		}
+	default:
+		return fmt.Errorf("cluster spec: unsupported cluster type %q", s.Type)
+	}
+
+	if s.Type == ClusterTypeDedicated {
+		if s.CKU < 1 {
+			return fmt.Errorf("cluster spec: %s clusters require at least 1 CKU", s.Type)
+		}
+		if availability == AvailabilityMultiZone && s.CKU < 2 {
+			return fmt.Errorf("cluster spec: %s %s clusters require at least 2 CKUs", availability, s.Type)
+		}
+	} else if s.CKU != 0 {
+		return fmt.Errorf("cluster spec: CKU can only be set for %s clusters", ClusterTypeDedicated)
+	}
+
+	return nil
+}
+
+// availability returns the requested availability or the default for the cluster type.
+func (s ClusterSpec) availability() string {
+	if s.Availability != "" {
+		return s.Availability
+	}
+	if s.Type == ClusterTypeEnterprise || s.Type == ClusterTypeFreight {
+		return AvailabilityHigh
+	}
+	return AvailabilitySingleZone
+}
+
+// requestBody renders the spec as a CMK v2 create request.
+func (s ClusterSpec) requestBody() map[string]interface{} {
+	config := map[string]interface{}{
+		// CMK v2 uses title-cased kinds, e.g. "Freight"
+		"kind": strings.ToUpper(s.Type[:1]) + strings.ToLower(s.Type[1:]),
+	}
+	if s.Type == ClusterTypeDedicated {
+		config["cku"] = s.CKU
+	}
+
+	return map[string]interface{}{
+		"spec": map[string]interface{}{
+			"display_name": s.DisplayName,
+			"availability": s.availability(),
+			"cloud":        s.Cloud,
+			"region":       s.Region,
+			"config":       config,
+			"environment": map[string]string{
+				"id": s.EnvironmentID,
+			},
+		},
+	}
+}
+
+// CreateClusterFromSpec validates the spec client-side and creates a new Kafka cluster.
+// Returns errors:
+//   - a validation error, without calling the API, if the spec is invalid
+//   - *CapabilityError if the organization is not entitled to the Freight cluster type
+//   - *api.Error with IsBadRequest() if parameters are invalid
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (cm *ClusterManager) CreateClusterFromSpec(ctx context.Context, spec ClusterSpec) (*api.Cluster, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := client.Request{
+		Method: "POST",
+		Path:   "/cmk/v2/clusters",
+		Body:   spec.requestBody(),
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		var apiErr *api.Error
+		if spec.Type == ClusterTypeFreight && errors.As(err, &apiErr) && apiErr.IsForbidden() {
+			return nil, &CapabilityError{Feature: FeatureFreight, Err: err}
+		}
+		return nil, fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	var cluster api.Cluster
+	if err := resp.DecodeJSON(&cluster); err != nil {
+		return nil, fmt.Errorf("failed to parse create cluster response: %w", err)
+	}
+
+	return &cluster, nil
+}
+
+// containsFold reports whether values contains s, compared case-insensitively.
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Mirror topic statuses.
+const (
+	MirrorStatusActive            = "ACTIVE"
+	MirrorStatusPaused            = "PAUSED"
+	MirrorStatusStopped           = "STOPPED"
+	MirrorStatusPendingStopped    = "PENDING_STOPPED"
+	MirrorStatusFailed            = "FAILED"
+	MirrorStatusLinkFailed        = "LINK_FAILED"
+	MirrorStatusLinkPaused        = "LINK_PAUSED"
+	MirrorStatusSourceUnavailable = "SOURCE_UNAVAILABLE"
+)
+
+// MirrorTopicResult is the outcome of a mirror state change for one mirror topic.
+type MirrorTopicResult struct {
+	MirrorTopicName string
+	// Err is the *api.Error reported for this topic, or nil if the change was accepted
+	Err error
+}
+
+// CreateMirrorTopicRequest describes a mirror topic to create on the destination cluster.
+type CreateMirrorTopicRequest struct {
+	// SourceTopicName is the topic to mirror from the source cluster
+	SourceTopicName string
+	// MirrorTopicName names the mirror topic (optional, defaults to SourceTopicName,
+	// or SourceTopicName with the link's prefix applied)
+	MirrorTopicName string
+	// ReplicationFactor of the mirror topic (optional)
+	ReplicationFactor int16
+	// Configs overrides topic configs that are not mirrored from the source (optional)
+	Configs map[string]string
+}
+
+// ClusterLinkManager handles cluster links and their mirror topics via the Kafka REST v3
+// API. Mirror topic calls are made against the destination cluster of the link.
+type ClusterLinkManager struct {
+	client client.Doer
+}
+
+// NewClusterLinkManager creates a new cluster link manager.
+func NewClusterLinkManager(c client.Doer) *ClusterLinkManager {
+	return &ClusterLinkManager{client: c}
+}
+
+// ListLinks lists the cluster links of a cluster.
+// Returns errors:
+//   - *api.Error with IsNotFound() if cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (clm *ClusterLinkManager) ListLinks(ctx context.Context, clusterID string) ([]api.ClusterLink, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/links", clusterID),
+	}
+
+	resp, err := clm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster links: %w", err)
+	}
+
+	var result struct {
+		Data []api.ClusterLink `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse cluster link list response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// CreateMirrorTopic creates a mirror topic over a cluster link. The mirror starts in
+// MirrorStatusActive once its partitions have been set up.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the link or source topic does not exist
+//   - *api.Error with IsConflict() or IsBadRequest() if the mirror topic already exists
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (clm *ClusterLinkManager) CreateMirrorTopic(ctx context.Context, clusterID string, linkName string, mirror CreateMirrorTopicRequest) error {
+	body := map[string]interface{}{
+		"source_topic_name": mirror.SourceTopicName,
+	}
+	if mirror.MirrorTopicName != "" {
+		body["mirror_topic_name"] = mirror.MirrorTopicName
+	}
+	if mirror.ReplicationFactor > 0 {
+		body["replication_factor"] = mirror.ReplicationFactor
+	}
+	if len(mirror.Configs) > 0 {
+		body["configs"] = topicConfigsToArray(mirror.Configs)
+	}
+
+	req := client.Request{
+		Method: "POST",
+		Path:   mirrorsPath(clusterID, linkName),
+		Body:   body,
+	}
+
+	if _, err := clm.client.Do(ctx, req); err != nil {
+		return fmt.Errorf("failed to create mirror topic for %s: %w", mirror.SourceTopicName, err)
+	}
+
+	return nil
+}
+
+// ListMirrorTopics lists the mirror topics of a cluster link, or of every link on the
+// cluster when linkName is empty, optionally filtered by status (e.g. MirrorStatusActive).
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster or link does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (clm *ClusterLinkManager) ListMirrorTopics(ctx context.Context, clusterID string, linkName string, status string) ([]api.MirrorTopic, error) {
+	if linkName == "" {
+		linkName = "-"
+	}
+	path := mirrorsPath(clusterID, linkName)
+	if status != "" {
+		path += "?" + url.Values{"mirror_status": {status}}.Encode()
+	}
+	req := client.Request{
+		Method: "GET",
+		Path:   path,
+	}
+
+	resp, err := clm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mirror topics: %w", err)
+	}
+
+	var result struct {
+		Data []api.MirrorTopic `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse mirror topic list response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// GetMirrorTopic retrieves the status and per-partition lag of a mirror topic.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the link or mirror topic does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (clm *ClusterLinkManager) GetMirrorTopic(ctx context.Context, clusterID string, linkName string, mirrorTopicName string) (*api.MirrorTopic, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   mirrorsPath(clusterID, linkName) + "/" + url.PathEscape(mirrorTopicName),
+	}
+
+	resp, err := clm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe mirror topic %s: %w", mirrorTopicName, err)
+	}
+
+	var mirror api.MirrorTopic
+	if err := resp.DecodeJSON(&mirror); err != nil {
+		return nil, fmt.Errorf("failed to parse mirror topic response: %w", err)
+	}
+
+	return &mirror, nil
+}
+
+// PromoteMirrorTopics stops mirroring and makes the mirror topics writable once they have
+// caught up with their source topics. Promotion needs the source cluster to be reachable;
+// use FailoverMirrorTopics when it is not. With validateOnly, the request is checked but
+// no topic is changed.
+// The returned error is only set when the request itself failed; per-topic failures,
+// such as a topic that is still lagging, are reported in each MirrorTopicResult.
+func (clm *ClusterLinkManager) PromoteMirrorTopics(ctx context.Context, clusterID string, linkName string, mirrorTopicNames []string, validateOnly bool) ([]MirrorTopicResult, error) {
+	return clm.alterMirrors(ctx, clusterID, linkName, "promote", mirrorTopicNames, validateOnly)
+}
+
+// FailoverMirrorTopics stops mirroring and makes the mirror topics writable immediately,
+// without waiting to catch up with the source cluster. Use it during a disaster recovery
+// failover when the source region is unavailable; records not yet mirrored are lost.
+// Results are reported as in PromoteMirrorTopics.
+func (clm *ClusterLinkManager) FailoverMirrorTopics(ctx context.Context, clusterID string, linkName string, mirrorTopicNames []string, validateOnly bool) ([]MirrorTopicResult, error) {
+	return clm.alterMirrors(ctx, clusterID, linkName, "failover", mirrorTopicNames, validateOnly)
+}
+
+// PauseMirrorTopics pauses mirroring of the mirror topics, which stay read-only.
+// Results are reported as in PromoteMirrorTopics.
+func (clm *ClusterLinkManager) PauseMirrorTopics(ctx context.Context, clusterID string, linkName string, mirrorTopicNames []string, validateOnly bool) ([]MirrorTopicResult, error) {
+	return clm.alterMirrors(ctx, clusterID, linkName, "pause", mirrorTopicNames, validateOnly)
+}
+
+// ResumeMirrorTopics resumes mirroring of paused mirror topics.
+// Results are reported as in PromoteMirrorTopics.
+func (clm *ClusterLinkManager) ResumeMirrorTopics(ctx context.Context, clusterID string, linkName string, mirrorTopicNames []string, validateOnly bool) ([]MirrorTopicResult, error) {
+	return clm.alterMirrors(ctx, clusterID, linkName, "resume", mirrorTopicNames, validateOnly)
+}
+
+// alterMirrors applies a mirror state change (promote, failover, pause, or resume) and
+// converts the per-topic outcomes reported in the response body.
+func (clm *ClusterLinkManager) alterMirrors(ctx context.Context, clusterID string, linkName string, action string, mirrorTopicNames []string, validateOnly bool) ([]MirrorTopicResult, error) {
+	path := mirrorsPath(clusterID, linkName) + ":" + action
+	if validateOnly {
+		path += "?validate_only=true"
+	}
+	req := client.Request{
+		Method: "POST",
+		Path:   path,
+		Body:   map[string]interface{}{"mirror_topic_names": mirrorTopicNames},
+	}
+
+	resp, err := clm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s mirror topics on link %s: %w", action, linkName, err)
+	}
+
+	var result struct {
+		Data []struct {
+			MirrorTopicName string `json:"mirror_topic_name"`
+			ErrorCode       int    `json:"error_code"`
+			ErrorMessage    string `json:"error_message"`
+		} `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse mirror %s response: %w", action, err)
+	}
+
+	results := make([]MirrorTopicResult, len(result.Data))
+	for i, r := range result.Data {
+		results[i] = MirrorTopicResult{
+			MirrorTopicName: r.MirrorTopicName,
+			Err:             kafkaRESTError(r.ErrorCode, r.ErrorMessage),
+		}
+	}
+	return results, nil
+}
+
+func mirrorsPath(clusterID string, linkName string) string {
+	return fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors", clusterID, url.PathEscape(linkName))
+}
@@ -0,0 +1,120 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestClusterLinkManager_CreateMirrorTopic(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/kafka/v3/clusters/lkc-dr/links/east-west/mirrors" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewClusterLinkManager(c)
+
+	err := mgr.CreateMirrorTopic(context.Background(), "lkc-dr", "east-west", resources.CreateMirrorTopicRequest{SourceTopicName: "orders"})
+	if err != nil {
+		t.Fatalf("CreateMirrorTopic failed: %v", err)
+	}
+	if body["source_topic_name"] != "orders" {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if _, ok := body["mirror_topic_name"]; ok {
+		t.Errorf("Expected mirror_topic_name to be omitted, got %v", body)
+	}
+}
+
+func TestClusterLinkManager_GetMirrorTopic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kafka/v3/clusters/lkc-dr/links/east-west/mirrors/orders" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"link_name":"east-west","mirror_topic_name":"orders","source_topic_name":"orders","num_partitions":2,
+			"mirror_status":"ACTIVE","mirror_lags":[{"partition":0,"lag":0},{"partition":1,"lag":12}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewClusterLinkManager(c)
+
+	mirror, err := mgr.GetMirrorTopic(context.Background(), "lkc-dr", "east-west", "orders")
+	if err != nil {
+		t.Fatalf("GetMirrorTopic failed: %v", err)
+	}
+	if mirror.MirrorStatus != resources.MirrorStatusActive || len(mirror.MirrorLags) != 2 || mirror.MirrorLags[1].Lag != 12 {
+		t.Errorf("Unexpected mirror topic: %#v", mirror)
+	}
+}
+
+func TestClusterLinkManager_ListMirrorTopics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kafka/v3/clusters/lkc-dr/links/-/mirrors" || r.URL.Query().Get("mirror_status") != "PAUSED" {
+			t.Errorf("Unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"link_name":"east-west","mirror_topic_name":"orders","mirror_status":"PAUSED"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewClusterLinkManager(c)
+
+	mirrors, err := mgr.ListMirrorTopics(context.Background(), "lkc-dr", "", resources.MirrorStatusPaused)
+	if err != nil {
+		t.Fatalf("ListMirrorTopics failed: %v", err)
+	}
+	if len(mirrors) != 1 || mirrors[0].LinkName != "east-west" {
+		t.Errorf("Unexpected mirror topics: %#v", mirrors)
+	}
+}
+
+func TestClusterLinkManager_PromoteMirrorTopics(t *testing.T) {
+	var body struct {
+		MirrorTopicNames []string `json:"mirror_topic_names"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/kafka/v3/clusters/lkc-dr/links/east-west/mirrors:promote" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("validate_only") != "" {
+			t.Errorf("Unexpected validate_only: %s", r.URL.RawQuery)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"mirror_topic_name":"orders","error_code":null,"error_message":null},
+			{"mirror_topic_name":"payments","error_code":400,"error_message":"Topic has not caught up"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewClusterLinkManager(c)
+
+	results, err := mgr.PromoteMirrorTopics(context.Background(), "lkc-dr", "east-west", []string{"orders", "payments"}, false)
+	if err != nil {
+		t.Fatalf("PromoteMirrorTopics failed: %v", err)
+	}
+	if len(body.MirrorTopicNames) != 2 {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if len(results) != 2 || results[0].Err != nil {
+		t.Fatalf("Unexpected results: %#v", results)
+	}
+	apiErr, ok := results[1].Err.(*api.Error)
+	if !ok || !apiErr.IsBadRequest() {
+		t.Errorf("Expected bad request error for payments, got %v", results[1].Err)
+	}
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// ConnectCluster is the managed Connect cluster that runs the connectors of a Kafka
+// cluster. In Confluent Cloud it shares the ID of its Kafka cluster and is provisioned
+// and deleted along with it, so it has no create or delete call of its own: use
+// ClusterManager.CreateClusterFromSpec and ClusterManager.DeleteCluster.
+type ConnectCluster struct {
+	// ID is the clusterID argument of ConnectorManager methods
+	ID            string
+	EnvironmentID string
+	KafkaCluster  api.Cluster
+	// ConnectorCount is only set by GetConnectCluster
+	ConnectorCount int
+}
+
+// ConnectClusterManager discovers the managed Connect clusters of an environment.
+type ConnectClusterManager struct {
+	clusters   *ClusterManager
+	connectors *ConnectorManager
+}
+
+// NewConnectClusterManager creates a new Connect cluster manager.
+func NewConnectClusterManager(c client.Doer) *ConnectClusterManager {
+	return &ConnectClusterManager{
+		clusters:   NewClusterManager(c),
+		connectors: NewConnectorManager(c),
+	}
+}
+
+// ListConnectClusters lists the Connect clusters of an environment, one per Kafka cluster.
+// Returns errors:
+//   - *api.Error with IsNotFound() for invalid environment ID
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (ccm *ConnectClusterManager) ListConnectClusters(ctx context.Context, environmentID string) ([]ConnectCluster, error) {
+	clusters, err := ccm.clusters.ListClusters(ctx, environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	connectClusters := make([]ConnectCluster, 0, len(clusters))
+	for _, c := range clusters {
+		connectClusters = append(connectClusters, ConnectCluster{ID: c.ID, EnvironmentID: environmentID, KafkaCluster: c})
+	}
+	return connectClusters, nil
+}
+
+// GetConnectCluster retrieves a Connect cluster and counts its connectors, which also
+// confirms the Connect API of a newly created Kafka cluster is ready.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster does not exist or its Connect API is
+//     not yet available
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (ccm *ConnectClusterManager) GetConnectCluster(ctx context.Context, environmentID string, clusterID string) (*ConnectCluster, error) {
+	cluster, err := ccm.clusters.GetCluster(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	names, err := ccm.connectors.ListConnectors(ctx, environmentID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConnectCluster{
+		ID:             cluster.ID,
+		EnvironmentID:  environmentID,
+		KafkaCluster:   *cluster,
+		ConnectorCount: len(names),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestConnectClusterManager_ListConnectClusters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cmk/v2/clusters" || r.URL.Query().Get("environment") != "env-1" {
+			t.Errorf("Unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"lkc-1","name":"orders"},{"id":"lkc-2","name":"billing"}]}`))
+	}))
+	defer server.Close()
+
+	ccm := resources.NewConnectClusterManager(newTestClient(t, server.URL))
+	clusters, err := ccm.ListConnectClusters(context.Background(), "env-1")
+	if err != nil {
+		t.Fatalf("ListConnectClusters failed: %v", err)
+	}
+	if len(clusters) != 2 || clusters[1].ID != "lkc-2" || clusters[1].EnvironmentID != "env-1" || clusters[1].KafkaCluster.Name != "billing" {
+		t.Errorf("Unexpected clusters: %+v", clusters)
+	}
+}
+
+func TestConnectClusterManager_GetConnectCluster(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/cmk/v2/clusters/lkc-1":
+			_, _ = w.Write([]byte(`{"id":"lkc-1","name":"orders"}`))
+		case "/connect/v1/environments/env-1/clusters/lkc-1/connectors":
+			_, _ = w.Write([]byte(`["a","b"]`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	ccm := resources.NewConnectClusterManager(newTestClient(t, server.URL))
+	cluster, err := ccm.GetConnectCluster(context.Background(), "env-1", "lkc-1")
+	if err != nil {
+		t.Fatalf("GetConnectCluster failed: %v", err)
+	}
+	if cluster.ID != "lkc-1" || cluster.ConnectorCount != 2 {
+		t.Errorf("Unexpected cluster: %+v", cluster)
+	}
+}
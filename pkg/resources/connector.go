@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/creiche/confluent-go/pkg/api"
 	"github.com/creiche/confluent-go/pkg/client"
@@ -11,14 +13,35 @@ import (
 
 // ConnectorManager handles Kafka Connect connector operations via REST API.
 type ConnectorManager struct {
-	client *client.Client
+	client   client.Doer
+	validate bool
 }
 
 // NewConnectorManager creates a new connector manager.
-func NewConnectorManager(c *client.Client) *ConnectorManager {
+func NewConnectorManager(c client.Doer) *ConnectorManager {
 	return &ConnectorManager{client: c}
 }
 
+// WithValidation makes CreateConnector validate the config with ValidateConnectorConfig
+// first and return a *ConnectorValidationError instead of creating a connector with
+// config errors (default false).
+func (cm *ConnectorManager) WithValidation(enabled bool) *ConnectorManager {
+	cm.validate = enabled
+	return cm
+}
+
+// Connector and task states reported by GetConnectorStatus. The constants are untyped
+// so they compare directly with api.ConnectorStatus.State and convert to ConnectorState.
+const (
+	ConnectorStateRunning      = "RUNNING"
+	ConnectorStatePaused       = "PAUSED"
+	ConnectorStateStopped      = "STOPPED"
+	ConnectorStateFailed       = "FAILED"
+	ConnectorStateRestarting   = "RESTARTING"
+	ConnectorStateUnassigned   = "UNASSIGNED"
+	ConnectorStateProvisioning = "PROVISIONING"
+)
+
 // ListConnectors lists all connectors in a Kafka Connect cluster.
 // Returns errors:
 //   - *api.Error with IsNotFound() if connect cluster does not exist
@@ -43,6 +66,89 @@ func (cm *ConnectorManager) ListConnectors(ctx context.Context, environmentID st
 	return connectors, nil
 }
 
+// ListConnectorsExpanded lists all connectors in a Kafka Connect cluster with their
+// type, config, task count, and status, sorted by name. It makes a single request
+// (?expand=status&expand=info) rather than one per connector, for dashboards of large
+// Connect clusters.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connect cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (cm *ConnectorManager) ListConnectorsExpanded(ctx context.Context, environmentID string, clusterID string) ([]api.ConnectorConfig, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors?expand=status&expand=info", environmentID, clusterID),
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connectors: %w", err)
+	}
+
+	var expanded map[string]expandedConnectorResource
+	if err := resp.DecodeJSON(&expanded); err != nil {
+		return nil, fmt.Errorf("failed to parse connector list response: %w", err)
+	}
+
+	connectors := make([]api.ConnectorConfig, 0, len(expanded))
+	for name, r := range expanded {
+		connectors = append(connectors, r.connector(name))
+	}
+	sort.Slice(connectors, func(i, j int) bool { return connectors[i].Name < connectors[j].Name })
+	return connectors, nil
+}
+
+// expandedConnectorResource is a connector of an expanded connector listing, in the
+// Kafka Connect status and info formats.
+type expandedConnectorResource struct {
+	Status struct {
+		Connector struct {
+			State string `json:"state"`
+			Trace string `json:"trace"`
+		} `json:"connector"`
+		Tasks []struct {
+			ID       int32  `json:"id"`
+			State    string `json:"state"`
+			WorkerID string `json:"worker_id"`
+			Trace    string `json:"trace"`
+			Msg      string `json:"msg"`
+		} `json:"tasks"`
+	} `json:"status"`
+	Info struct {
+		Config map[string]string `json:"config"`
+		Tasks  []struct {
+			Task int32 `json:"task"`
+		} `json:"tasks"`
+		Type string `json:"type"`
+	} `json:"info"`
+}
+
+func (r expandedConnectorResource) connector(name string) api.ConnectorConfig {
+	c := api.ConnectorConfig{
+		Name:   name,
+		Config: r.Info.Config,
+		Type:   strings.ToUpper(r.Info.Type),
+		Tasks:  int32(len(r.Info.Tasks)),
+		Status: api.ConnectorStatus{State: r.Status.Connector.State},
+	}
+	if r.Status.Connector.Trace != "" {
+		c.Status.Errors = append(c.Status.Errors, api.ConnectorError{Message: r.Status.Connector.Trace})
+	}
+	for _, t := range r.Status.Tasks {
+		msg := t.Trace
+		if msg == "" {
+			msg = t.Msg
+		}
+		c.Status.Tasks = append(c.Status.Tasks, api.TaskStatus{ID: t.ID, State: t.State, Worker: t.WorkerID, Error: msg})
+	}
+	withTaskTraces(c.Status.Tasks)
+	if len(r.Info.Tasks) == 0 {
+		// Info lists no tasks before the connector is first assigned
+		c.Tasks = int32(len(c.Status.Tasks))
+	}
+	return c
+}
+
 // GetConnector retrieves information about a specific connector.
 // Returns errors:
 //   - *api.Error with IsNotFound() if connector does not exist
@@ -71,15 +177,38 @@ func (cm *ConnectorManager) GetConnector(ctx context.Context, environmentID stri
 // CreateConnector creates a new Kafka Connect connector.
 // The config map must include "connector.class" and other connector-specific settings.
 // Returns errors:
+//   - *ConnectorValidationError if validation is enabled and the config has errors
+//   - ErrInvalidConnectorConfig (with errors.Is) if validation is enabled and "connector.class" is missing
 //   - *api.Error with IsBadRequest() if parameters are invalid
 //   - *api.Error with IsUnauthorized() for authentication failures
 //   - *api.Error with IsConflict() if connector name already exists
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (cm *ConnectorManager) CreateConnector(ctx context.Context, environmentID string, clusterID string, name string, config map[string]string) (*api.ConnectorConfig, error) {
+	return cm.CreateConnectorWithOffsets(ctx, environmentID, clusterID, name, config, nil)
+}
+
+// CreateConnectorWithOffsets creates a new connector that starts from the given offsets,
+// e.g. those captured from another Connect cluster with GetConnectorOffsets. With no
+// offsets it behaves like CreateConnector.
+// Returns errors:
+//   - *ConnectorValidationError if validation is enabled and the config has errors
+//   - ErrInvalidConnectorConfig (with errors.Is) if validation is enabled and "connector.class" is missing
+//   - *api.Error with IsBadRequest() if parameters or offsets are invalid
+//   - *api.Error with IsConflict() if connector name already exists
+func (cm *ConnectorManager) CreateConnectorWithOffsets(ctx context.Context, environmentID string, clusterID string, name string, config map[string]string, offsets []api.ConnectorOffset) (*api.ConnectorConfig, error) {
+	if cm.validate {
+		if err := cm.validateForCreate(ctx, environmentID, clusterID, name, config); err != nil {
+			return nil, err
+		}
+	}
+
 	body := map[string]interface{}{
 		"name":   name,
 		"config": config,
 	}
+	if len(offsets) > 0 {
+		body["offsets"] = offsets
+	}
 
 	req := client.Request{
 		Method: "POST",
@@ -100,6 +229,30 @@ func (cm *ConnectorManager) CreateConnector(ctx context.Context, environmentID s
 	return &connector, nil
 }
 
+// validateForCreate validates config, which must include "connector.class", before creation.
+func (cm *ConnectorManager) validateForCreate(ctx context.Context, environmentID string, clusterID string, name string, config map[string]string) error {
+	if config["connector.class"] == "" {
+		return fmt.Errorf("failed to create connector %s: %w: connector.class is required", name, ErrInvalidConnectorConfig)
+	}
+	rest := make(map[string]string, len(config))
+	for k, v := range config {
+		if k != "connector.class" {
+			rest[k] = v
+		}
+	}
+	if _, ok := rest["name"]; !ok {
+		rest["name"] = name
+	}
+	validation, err := cm.ValidateConnectorConfig(ctx, environmentID, clusterID, config["connector.class"], rest)
+	if err != nil {
+		return fmt.Errorf("failed to create connector %s: %w", name, err)
+	}
+	if !validation.IsValid() {
+		return &ConnectorValidationError{Connector: name, Validation: validation}
+	}
+	return nil
+}
+
 // UpdateConnector updates an existing connector's configuration.
 // The new config will replace the existing configuration entirely.
 // Returns errors:
@@ -166,6 +319,7 @@ func (cm *ConnectorManager) GetConnectorStatus(ctx context.Context, environmentI
 	if err := resp.DecodeJSON(&status); err != nil {
 		return nil, fmt.Errorf("failed to parse connector status response: %w", err)
 	}
+	withTaskTraces(status.Tasks)
 
 	return &status, nil
 }
@@ -190,7 +344,7 @@ func (cm *ConnectorManager) PauseConnector(ctx context.Context, environmentID st
 	return nil
 }
 
-// ResumeConnector resumes a paused connector.
+// ResumeConnector resumes a paused or stopped connector.
 // The connector will restart processing from where it left off.
 // Returns errors:
 //   - *api.Error with IsNotFound() if connector does not exist
@@ -210,26 +364,132 @@ func (cm *ConnectorManager) ResumeConnector(ctx context.Context, environmentID s
 	return nil
 }
 
-// RestartConnector restarts a connector and its tasks.
-// This can be useful to recover from transient failures.
+// StopConnector stops a connector and shuts down its tasks. Unlike PauseConnector, which
+// keeps tasks allocated and their configs generated, a stopped connector releases its
+// tasks, and its offsets can be altered. Resume it with ResumeConnector.
 // Returns errors:
 //   - *api.Error with IsNotFound() if connector does not exist
 //   - *api.Error with IsUnauthorized() for authentication failures
 //   - *api.Error with IsForbidden() if user lacks permissions
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
-func (cm *ConnectorManager) RestartConnector(ctx context.Context, environmentID string, clusterID string, connectorName string) error {
+func (cm *ConnectorManager) StopConnector(ctx context.Context, environmentID string, clusterID string, connectorName string) error {
 	req := client.Request{
-		Method: "POST",
-		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/restart", environmentID, clusterID, connectorName),
+		Method: "PUT",
+		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/stop", environmentID, clusterID, connectorName),
 	}
 
 	_, err := cm.client.Do(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to restart connector %s: %w", connectorName, err)
+		return fmt.Errorf("failed to stop connector %s: %w", connectorName, err)
 	}
 	return nil
 }
 
+// RestartConnector restarts a connector instance, without its tasks. Use
+// RestartConnectorWithOptions to also restart tasks.
+// This can be useful to recover from transient failures.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (cm *ConnectorManager) RestartConnector(ctx context.Context, environmentID string, clusterID string, connectorName string) error {
+	_, err := cm.RestartConnectorWithOptions(ctx, environmentID, clusterID, connectorName, RestartOptions{})
+	return err
+}
+
+// RestartOptions selects what RestartConnectorWithOptions restarts.
+type RestartOptions struct {
+	// IncludeTasks also restarts the connector's tasks
+	IncludeTasks bool
+	// OnlyFailed restricts the restart to the connector and tasks that are FAILED
+	OnlyFailed bool
+}
+
+// RestartResult reports what RestartConnectorWithOptions restarted.
+type RestartResult struct {
+	// ConnectorRestarted is true if the connector instance itself was restarted
+	ConnectorRestarted bool
+	// RestartedTasks lists the IDs of the tasks that were restarted
+	RestartedTasks []int32
+	// Status is the connector status when the restart was accepted, with restarted
+	// instances in ConnectorStateRestarting. It is nil when the API returned no status,
+	// which it does when only the connector instance was restarted.
+	Status *api.ConnectorStatus
+}
+
+// RestartConnectorWithOptions restarts a connector and, with opts.IncludeTasks, its tasks,
+// optionally only those that have failed. Restarts are asynchronous: poll
+// GetConnectorStatus to see the restarted instances come back up.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsConflict() if the Connect cluster is rebalancing
+//   - *api.Error with IsForbidden() if user lacks permissions
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (cm *ConnectorManager) RestartConnectorWithOptions(ctx context.Context, environmentID string, clusterID string, connectorName string, opts RestartOptions) (*RestartResult, error) {
+	path := fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/restart", environmentID, clusterID, connectorName)
+	q := url.Values{}
+	if opts.IncludeTasks {
+		q.Set("includeTasks", "true")
+	}
+	if opts.OnlyFailed {
+		q.Set("onlyFailed", "true")
+	}
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	req := client.Request{
+		Method: "POST",
+		Path:   path,
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restart connector %s: %w", connectorName, err)
+	}
+
+	// 204 No Content: only the connector instance was restarted
+	if len(resp.Body) == 0 {
+		return &RestartResult{ConnectorRestarted: true}, nil
+	}
+
+	// 202 Accepted: the Connect REST status of the connector and its tasks
+	var info struct {
+		Connector struct {
+			State  string `json:"state"`
+			Worker string `json:"worker_id"`
+			Trace  string `json:"trace"`
+		} `json:"connector"`
+		Tasks []struct {
+			ID     int32  `json:"id"`
+			State  string `json:"state"`
+			Worker string `json:"worker_id"`
+			Trace  string `json:"trace"`
+		} `json:"tasks"`
+	}
+	if err := resp.DecodeJSON(&info); err != nil {
+		return nil, fmt.Errorf("failed to parse restart response: %w", err)
+	}
+
+	result := &RestartResult{
+		ConnectorRestarted: info.Connector.State == ConnectorStateRestarting,
+		Status:             &api.ConnectorStatus{State: info.Connector.State},
+	}
+	for _, task := range info.Tasks {
+		result.Status.Tasks = append(result.Status.Tasks, api.TaskStatus{
+			ID:     task.ID,
+			State:  task.State,
+			Worker: task.Worker,
+			Error:  task.Trace,
+		})
+		if task.State == ConnectorStateRestarting {
+			result.RestartedTasks = append(result.RestartedTasks, task.ID)
+		}
+	}
+	return result, nil
+}
+
 // RestartTask restarts a specific task for a connector.
 // Returns errors:
 //   - *api.Error with IsNotFound() if connector or task does not exist
@@ -378,6 +638,164 @@ func (cm *ConnectorManager) GetTaskStatus(ctx context.Context, environmentID str
 	if err := resp.DecodeJSON(&status); err != nil {
 		return nil, fmt.Errorf("failed to parse task status response: %w", err)
 	}
+	if status.Error != "" {
+		status.Trace = ParseTaskTrace(status.Error)
+	}
 
 	return &status, nil
 }
+
+// GetConnectorOffsets retrieves the committed offsets of a connector.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (cm *ConnectorManager) GetConnectorOffsets(ctx context.Context, environmentID string, clusterID string, connectorName string) (*api.ConnectorOffsets, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/offsets", environmentID, clusterID, connectorName),
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connector offsets for %s: %w", connectorName, err)
+	}
+
+	var offsets api.ConnectorOffsets
+	if err := resp.DecodeJSON(&offsets); err != nil {
+		return nil, fmt.Errorf("failed to parse connector offsets response: %w", err)
+	}
+
+	return &offsets, nil
+}
+
+// GetConnectorTopics returns the topics a connector has read from or written to since it
+// was created or its active topics were last reset. Unlike the "topics" config of a sink,
+// this reflects the topics the connector actually used, including topics matched by a
+// regex or chosen dynamically by a source connector.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsForbidden() if topic tracking is disabled on the Connect cluster
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cm *ConnectorManager) GetConnectorTopics(ctx context.Context, environmentID string, clusterID string, connectorName string) ([]string, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/topics", environmentID, clusterID, connectorName),
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active topics of connector %s: %w", connectorName, err)
+	}
+
+	// The response is keyed by connector name: {"<name>": {"topics": [...]}}
+	var result map[string]struct {
+		Topics []string `json:"topics"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse connector topics response: %w", err)
+	}
+
+	return result[connectorName].Topics, nil
+}
+
+// ResetConnectorActiveTopics clears the set of active topics returned by
+// GetConnectorTopics, e.g. after changing which topics a connector uses, so that only
+// topics used from now on are reported.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsForbidden() if topic tracking reset is disabled on the Connect cluster
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cm *ConnectorManager) ResetConnectorActiveTopics(ctx context.Context, environmentID string, clusterID string, connectorName string) error {
+	req := client.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/topics/reset", environmentID, clusterID, connectorName),
+	}
+
+	if _, err := cm.client.Do(ctx, req); err != nil {
+		return fmt.Errorf("failed to reset active topics of connector %s: %w", connectorName, err)
+	}
+	return nil
+}
+
+// Phases of a connector offset request reported by GetConnectorOffsetRequestStatus.
+const (
+	OffsetRequestPending = "PENDING"
+	OffsetRequestApplied = "APPLIED"
+	OffsetRequestFailed  = "FAILED"
+)
+
+// AlterConnectorOffsets requests that a connector resume from the given offsets, e.g. to
+// replay data or skip a poison-pill record. Offsets of partitions not listed are kept;
+// use GetConnectorOffsets for the partition format the connector expects.
+// The change is applied asynchronously while the connector is briefly stopped; poll
+// GetConnectorOffsetRequestStatus until the phase is OffsetRequestApplied.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsBadRequest() if the offsets are invalid for the connector
+//   - *api.Error with IsConflict() if another offset request is still pending
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (cm *ConnectorManager) AlterConnectorOffsets(ctx context.Context, environmentID string, clusterID string, connectorName string, offsets []api.ConnectorOffset) (*api.ConnectorOffsetRequest, error) {
+	return cm.requestOffsets(ctx, environmentID, clusterID, connectorName, "alter", map[string]interface{}{
+		"type":    "PATCH",
+		"offsets": offsets,
+	})
+}
+
+// ResetConnectorOffsets requests that a connector's offsets be deleted, so that it starts
+// over as if newly created: source connectors from the beginning of their source, sink
+// connectors according to their consumer auto.offset.reset.
+// The reset is applied asynchronously, as with AlterConnectorOffsets.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsConflict() if another offset request is still pending
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (cm *ConnectorManager) ResetConnectorOffsets(ctx context.Context, environmentID string, clusterID string, connectorName string) (*api.ConnectorOffsetRequest, error) {
+	return cm.requestOffsets(ctx, environmentID, clusterID, connectorName, "reset", map[string]interface{}{
+		"type": "DELETE",
+	})
+}
+
+// GetConnectorOffsetRequestStatus retrieves the progress of the latest request made with
+// AlterConnectorOffsets or ResetConnectorOffsets, including the offsets it replaced.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connector does not exist or has no offset request
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cm *ConnectorManager) GetConnectorOffsetRequestStatus(ctx context.Context, environmentID string, clusterID string, connectorName string) (*api.ConnectorOffsetRequestStatus, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/offsets/request/status", environmentID, clusterID, connectorName),
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offset request status for connector %s: %w", connectorName, err)
+	}
+
+	var status api.ConnectorOffsetRequestStatus
+	if err := resp.DecodeJSON(&status); err != nil {
+		return nil, fmt.Errorf("failed to parse offset request status response: %w", err)
+	}
+
+	return &status, nil
+}
+
+func (cm *ConnectorManager) requestOffsets(ctx context.Context, environmentID string, clusterID string, connectorName string, action string, body map[string]interface{}) (*api.ConnectorOffsetRequest, error) {
+	req := client.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/offsets/request", environmentID, clusterID, connectorName),
+		Body:   body,
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s offsets of connector %s: %w", action, connectorName, err)
+	}
+
+	var request api.ConnectorOffsetRequest
+	if err := resp.DecodeJSON(&request); err != nil {
+		return nil, fmt.Errorf("failed to parse offset request response: %w", err)
+	}
+
+	return &request, nil
+}
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// ConnectorSelector selects the connectors of a Connect cluster for batch operations.
+// The zero value selects every connector.
+type ConnectorSelector struct {
+	// Prefix selects connectors whose name starts with it (optional)
+	Prefix string
+	// States selects connectors in one of these states, e.g. ConnectorStateFailed (optional)
+	States []string
+}
+
+func (s ConnectorSelector) matches(name string, state string) bool {
+	if !strings.HasPrefix(name, s.Prefix) {
+		return false
+	}
+	if len(s.States) == 0 {
+		return true
+	}
+	for _, st := range s.States {
+		if st == state {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectConnectors returns the names of the connectors matching sel, sorted, with a
+// single expanded listing. Use it to preview the connectors a batch operation affects.
+// Returns errors:
+//   - *api.Error with IsNotFound() if connect cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cm *ConnectorManager) SelectConnectors(ctx context.Context, environmentID string, clusterID string, sel ConnectorSelector) ([]string, error) {
+	connectors, err := cm.ListConnectorsExpanded(ctx, environmentID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, c := range connectors {
+		if sel.matches(c.Name, c.Status.State) {
+			names = append(names, c.Name)
+		}
+	}
+	return names, nil
+}
+
+// ConnectorResult is the outcome of one connector in PauseAll, ResumeAll, or RestartAll.
+type ConnectorResult struct {
+	Name string
+	// Err is nil if the operation succeeded; it wraps the *api.Error returned by the API, if any
+	Err error
+}
+
+// PauseAll pauses the connectors matching sel concurrently, bounded by opts.Concurrency,
+// and returns one result per connector in name order. Every connector is attempted; if
+// any failed, the error is a *client.BatchError indexed like the results.
+// Returns errors:
+//   - *api.Error if listing the cluster's connectors fails, with no results
+//   - *client.BatchError if any connector could not be paused
+func (cm *ConnectorManager) PauseAll(ctx context.Context, environmentID string, clusterID string, sel ConnectorSelector, opts client.BatchOptions) ([]ConnectorResult, error) {
+	return cm.batch(ctx, environmentID, clusterID, sel, opts, func(ctx context.Context, name string) error {
+		return cm.PauseConnector(ctx, environmentID, clusterID, name)
+	})
+}
+
+// ResumeAll resumes the connectors matching sel concurrently, bounded by
+// opts.Concurrency. Results and errors are reported as in PauseAll.
+// Returns errors:
+//   - *api.Error if listing the cluster's connectors fails, with no results
+//   - *client.BatchError if any connector could not be resumed
+func (cm *ConnectorManager) ResumeAll(ctx context.Context, environmentID string, clusterID string, sel ConnectorSelector, opts client.BatchOptions) ([]ConnectorResult, error) {
+	return cm.batch(ctx, environmentID, clusterID, sel, opts, func(ctx context.Context, name string) error {
+		return cm.ResumeConnector(ctx, environmentID, clusterID, name)
+	})
+}
+
+// RestartAll restarts the connectors matching sel with restartOpts concurrently,
+// bounded by opts.Concurrency. Results and errors are reported as in PauseAll.
+//
+// For example, to restart only the failed tasks of failed connectors:
+//
+//	results, err := cm.RestartAll(ctx, "env-123", "lkc-abc",
+//		resources.ConnectorSelector{States: []string{resources.ConnectorStateFailed}},
+//		resources.RestartOptions{IncludeTasks: true, OnlyFailed: true},
+//		client.BatchOptions{Concurrency: 5})
+//
+// Returns errors:
+//   - *api.Error if listing the cluster's connectors fails, with no results
+//   - *client.BatchError if any connector could not be restarted
+func (cm *ConnectorManager) RestartAll(ctx context.Context, environmentID string, clusterID string, sel ConnectorSelector, restartOpts RestartOptions, opts client.BatchOptions) ([]ConnectorResult, error) {
+	return cm.batch(ctx, environmentID, clusterID, sel, opts, func(ctx context.Context, name string) error {
+		_, err := cm.RestartConnectorWithOptions(ctx, environmentID, clusterID, name, restartOpts)
+		return err
+	})
+}
+
+// batch calls fn for every connector matching sel and gathers the per-connector outcomes.
+func (cm *ConnectorManager) batch(ctx context.Context, environmentID string, clusterID string, sel ConnectorSelector, opts client.BatchOptions, fn func(ctx context.Context, name string) error) ([]ConnectorResult, error) {
+	names, err := cm.SelectConnectors(ctx, environmentID, clusterID, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ConnectorResult, len(names))
+	fns := make([]func(ctx context.Context) error, len(names))
+	for i, name := range names {
+		i, name := i, name
+		results[i] = ConnectorResult{Name: name}
+		fns[i] = func(ctx context.Context) error {
+			return fn(ctx, name)
+		}
+	}
+
+	// Taking errors from the BatchError also covers connectors skipped by StopOnError
+	err = client.BatchFuncs(ctx, fns, opts)
+	var batchErr *client.BatchError
+	if errors.As(err, &batchErr) {
+		for i, itemErr := range batchErr.Errors {
+			results[i].Err = itemErr
+		}
+	}
+	return results, err
+}
@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+const expandedConnectorsJSON = `{
+	"orders-sink":    {"status": {"connector": {"state": "RUNNING"}}, "info": {"type": "sink"}},
+	"orders-source":  {"status": {"connector": {"state": "FAILED"}}, "info": {"type": "source"}},
+	"billing-sink":   {"status": {"connector": {"state": "FAILED"}}, "info": {"type": "sink"}},
+	"orders-archive": {"status": {"connector": {"state": "RUNNING"}}, "info": {"type": "sink"}}
+}`
+
+func TestConnectorManager_PauseAll(t *testing.T) {
+	const prefix = "/connect/v1/environments/env-1/clusters/lkc-1/connectors"
+	var mu sync.Mutex
+	paused := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET " + prefix:
+			_, _ = w.Write([]byte(expandedConnectorsJSON))
+		case "PUT " + prefix + "/orders-archive/pause":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"rebalance in progress"}`))
+		case "PUT " + prefix + "/orders-sink/pause", "PUT " + prefix + "/orders-source/pause":
+			mu.Lock()
+			paused[r.URL.Path] = true
+			mu.Unlock()
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cm := resources.NewConnectorManager(newTestClient(t, server.URL))
+	results, err := cm.PauseAll(context.Background(), "env-1", "lkc-1", resources.ConnectorSelector{Prefix: "orders-"}, client.BatchOptions{Concurrency: 2})
+	var batchErr *client.BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Failed()) != 1 || batchErr.Failed()[0] != 0 {
+		t.Fatalf("Expected batch error for item 0, got %v", err)
+	}
+	want := []string{"orders-archive", "orders-sink", "orders-source"}
+	if len(results) != len(want) || len(paused) != 2 {
+		t.Fatalf("Unexpected results: %#v (paused %v)", results, paused)
+	}
+	for i, r := range results {
+		if r.Name != want[i] || (r.Err != nil) != (i == 0) {
+			t.Errorf("results[%d] = %#v, want %s", i, r, want[i])
+		}
+	}
+	var apiErr *api.Error
+	if !errors.As(results[0].Err, &apiErr) || !apiErr.IsConflict() {
+		t.Errorf("Expected conflict error, got %v", results[0].Err)
+	}
+}
+
+func TestConnectorManager_RestartAll(t *testing.T) {
+	const prefix = "/connect/v1/environments/env-1/clusters/lkc-1/connectors"
+	var mu sync.Mutex
+	var restarted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" && r.URL.Path == prefix {
+			_, _ = w.Write([]byte(expandedConnectorsJSON))
+			return
+		}
+		if r.Method != "POST" || r.URL.Query().Get("onlyFailed") != "true" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		mu.Lock()
+		restarted = append(restarted, r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cm := resources.NewConnectorManager(newTestClient(t, server.URL))
+	results, err := cm.RestartAll(context.Background(), "env-1", "lkc-1",
+		resources.ConnectorSelector{States: []string{resources.ConnectorStateFailed}},
+		resources.RestartOptions{IncludeTasks: true, OnlyFailed: true}, client.BatchOptions{})
+	if err != nil {
+		t.Fatalf("RestartAll failed: %v", err)
+	}
+	if len(results) != 2 || results[0].Name != "billing-sink" || results[1].Name != "orders-source" || len(restarted) != 2 {
+		t.Errorf("Unexpected restart results: %#v (requests %v)", results, restarted)
+	}
+}
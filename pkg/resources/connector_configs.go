@@ -0,0 +1,290 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidConnectorConfig is wrapped by the Config methods of typed connector configs
+// for every missing or invalid field.
+var ErrInvalidConnectorConfig = errors.New("invalid connector config")
+
+// ConnectorConfigRenderer is implemented by typed connector configs such as S3SinkConfig.
+// Config validates the fields and renders the map accepted by CreateConnector,
+// UpdateConnector, and ConnectorSpec.Config.
+type ConnectorConfigRenderer interface {
+	Config() (map[string]string, error)
+}
+
+// Managed connector classes with typed configs.
+const (
+	ConnectorClassS3Sink           = "S3_SINK"
+	ConnectorClassDatagenSource    = "DatagenSource"
+	ConnectorClassBigQuerySink     = "BigQuerySink"
+	ConnectorClassPostgresSource   = "PostgresSource"
+	ConnectorClassMySQLSource      = "MySqlSource"
+	ConnectorClassSQLServerSource  = "MicrosoftSqlServerSource"
+	ConnectorClassOracleJDBCSource = "OracleDatabaseSource"
+)
+
+// Record formats for input.data.format and output.data.format.
+const (
+	DataFormatAvro       = "AVRO"
+	DataFormatJSONSchema = "JSON_SR"
+	DataFormatProtobuf   = "PROTOBUF"
+	DataFormatJSON       = "JSON"
+	DataFormatString     = "STRING"
+	DataFormatBytes      = "BYTES"
+	DataFormatParquet    = "PARQUET"
+	DataFormatSchemaless = "JSON_SCHEMALESS"
+)
+
+// ConnectorKafkaAuth is how a managed connector authenticates to its Kafka cluster:
+// with a service account, or with a Kafka API key and secret.
+type ConnectorKafkaAuth struct {
+	ServiceAccountID string
+	APIKey           string
+	APISecret        string
+}
+
+func (a ConnectorKafkaAuth) render(v *connectorConfigValues) {
+	if a.ServiceAccountID != "" {
+		v.set("kafka.auth.mode", "SERVICE_ACCOUNT")
+		v.set("kafka.service.account.id", a.ServiceAccountID)
+		return
+	}
+	v.set("kafka.auth.mode", "KAFKA_API_KEY")
+	v.required("kafka.api.key", a.APIKey)
+	v.required("kafka.api.secret", a.APISecret)
+}
+
+// S3SinkConfig configures the fully managed Amazon S3 Sink connector.
+type S3SinkConfig struct {
+	Kafka  ConnectorKafkaAuth
+	Topics []string
+	// InputDataFormat is the format of the records in Topics, e.g. DataFormatAvro
+	InputDataFormat string
+	// OutputDataFormat is the format of the S3 objects, e.g. DataFormatParquet
+	OutputDataFormat   string
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	BucketName         string
+	// TimeInterval partitions objects by "HOURLY" or "DAILY"
+	TimeInterval string
+	// FlushSize is the number of records per object (optional)
+	FlushSize int
+	// TopicsDir is the object key prefix (optional)
+	TopicsDir string
+	// TasksMax is the number of tasks (optional, defaults to 1)
+	TasksMax int
+	// Extra sets additional keys verbatim, overriding rendered ones (optional)
+	Extra map[string]string
+}
+
+// Config implements ConnectorConfigRenderer.
+func (c S3SinkConfig) Config() (map[string]string, error) {
+	v := newConnectorConfigValues(ConnectorClassS3Sink)
+	c.Kafka.render(v)
+	v.required("topics", strings.Join(c.Topics, ","))
+	v.required("input.data.format", c.InputDataFormat)
+	v.required("output.data.format", c.OutputDataFormat)
+	v.required("aws.access.key.id", c.AWSAccessKeyID)
+	v.required("aws.secret.access.key", c.AWSSecretAccessKey)
+	v.required("s3.bucket.name", c.BucketName)
+	v.oneOf("time.interval", c.TimeInterval, "HOURLY", "DAILY")
+	v.positive("flush.size", c.FlushSize)
+	v.optional("topics.dir", c.TopicsDir)
+	v.tasks(c.TasksMax)
+	return v.build(c.Extra)
+}
+
+// DatagenSourceConfig configures the fully managed Datagen Source connector, which
+// produces mock data for testing.
+type DatagenSourceConfig struct {
+	Kafka ConnectorKafkaAuth
+	Topic string
+	// OutputDataFormat is the format of the produced records, e.g. DataFormatJSON
+	OutputDataFormat string
+	// Quickstart selects a predefined schema, e.g. "ORDERS", "USERS", or "PAGEVIEWS"
+	Quickstart string
+	// MaxInterval is the maximum milliseconds between records (optional)
+	MaxInterval int
+	// TasksMax is the number of tasks (optional, defaults to 1)
+	TasksMax int
+	// Extra sets additional keys verbatim, overriding rendered ones (optional)
+	Extra map[string]string
+}
+
+// Config implements ConnectorConfigRenderer.
+func (c DatagenSourceConfig) Config() (map[string]string, error) {
+	v := newConnectorConfigValues(ConnectorClassDatagenSource)
+	c.Kafka.render(v)
+	v.required("kafka.topic", c.Topic)
+	v.required("output.data.format", c.OutputDataFormat)
+	v.required("quickstart", c.Quickstart)
+	v.positive("max.interval", c.MaxInterval)
+	v.tasks(c.TasksMax)
+	return v.build(c.Extra)
+}
+
+// JDBCSourceConfig configures the fully managed JDBC-based source connectors, such as
+// the PostgreSQL, MySQL, SQL Server, and Oracle sources.
+type JDBCSourceConfig struct {
+	// ConnectorClass is e.g. ConnectorClassPostgresSource or ConnectorClassMySQLSource
+	ConnectorClass string
+	Kafka          ConnectorKafkaAuth
+	Host           string
+	Port           int
+	User           string
+	Password       string
+	DatabaseName   string
+	// Tables limits the source to these tables (optional, defaults to all tables)
+	Tables []string
+	// Mode is "bulk", "incrementing", "timestamp", or "timestamp+incrementing"
+	Mode string
+	// IncrementingColumn is required by the incrementing modes
+	IncrementingColumn string
+	// TimestampColumn is required by the timestamp modes
+	TimestampColumn string
+	// TopicPrefix is prepended to table names to form topic names
+	TopicPrefix string
+	// OutputDataFormat is the format of the produced records, e.g. DataFormatAvro
+	OutputDataFormat string
+	// TasksMax is the number of tasks (optional, defaults to 1)
+	TasksMax int
+	// Extra sets additional keys verbatim, overriding rendered ones (optional)
+	Extra map[string]string
+}
+
+// Config implements ConnectorConfigRenderer.
+func (c JDBCSourceConfig) Config() (map[string]string, error) {
+	v := newConnectorConfigValues(c.ConnectorClass)
+	v.oneOf("connector.class", c.ConnectorClass,
+		ConnectorClassPostgresSource, ConnectorClassMySQLSource, ConnectorClassSQLServerSource, ConnectorClassOracleJDBCSource)
+	c.Kafka.render(v)
+	v.required("connection.host", c.Host)
+	if c.Port <= 0 || c.Port > 65535 {
+		v.invalid("connection.port", strconv.Itoa(c.Port), "must be between 1 and 65535")
+	} else {
+		v.set("connection.port", strconv.Itoa(c.Port))
+	}
+	v.required("connection.user", c.User)
+	v.required("connection.password", c.Password)
+	v.required("db.name", c.DatabaseName)
+	v.optional("table.whitelist", strings.Join(c.Tables, ","))
+	v.oneOf("mode", c.Mode, "bulk", "incrementing", "timestamp", "timestamp+incrementing")
+	if strings.Contains(c.Mode, "incrementing") {
+		v.required("incrementing.column.name", c.IncrementingColumn)
+	}
+	if strings.Contains(c.Mode, "timestamp") {
+		v.required("timestamp.column.name", c.TimestampColumn)
+	}
+	v.required("topic.prefix", c.TopicPrefix)
+	v.required("output.data.format", c.OutputDataFormat)
+	v.tasks(c.TasksMax)
+	return v.build(c.Extra)
+}
+
+// BigQuerySinkConfig configures the fully managed Google BigQuery Sink connector.
+type BigQuerySinkConfig struct {
+	Kafka  ConnectorKafkaAuth
+	Topics []string
+	// InputDataFormat is the format of the records in Topics, e.g. DataFormatAvro
+	InputDataFormat string
+	// Keyfile is the JSON key of the GCP service account that writes to BigQuery
+	Keyfile string
+	Project string
+	Dataset string
+	// AutoCreateTables creates missing tables from the record schema
+	AutoCreateTables bool
+	// SanitizeTopics replaces characters that are invalid in table names
+	SanitizeTopics bool
+	// TasksMax is the number of tasks (optional, defaults to 1)
+	TasksMax int
+	// Extra sets additional keys verbatim, overriding rendered ones (optional)
+	Extra map[string]string
+}
+
+// Config implements ConnectorConfigRenderer.
+func (c BigQuerySinkConfig) Config() (map[string]string, error) {
+	v := newConnectorConfigValues(ConnectorClassBigQuerySink)
+	c.Kafka.render(v)
+	v.required("topics", strings.Join(c.Topics, ","))
+	v.required("input.data.format", c.InputDataFormat)
+	v.required("keyfile", c.Keyfile)
+	v.required("project", c.Project)
+	v.required("datasets", c.Dataset)
+	v.set("auto.create.tables", strconv.FormatBool(c.AutoCreateTables))
+	v.set("sanitize.topics", strconv.FormatBool(c.SanitizeTopics))
+	v.tasks(c.TasksMax)
+	return v.build(c.Extra)
+}
+
+// connectorConfigValues accumulates rendered keys and validation errors.
+type connectorConfigValues struct {
+	config map[string]string
+	errs   []error
+}
+
+func newConnectorConfigValues(connectorClass string) *connectorConfigValues {
+	return &connectorConfigValues{config: map[string]string{"connector.class": connectorClass}}
+}
+
+func (v *connectorConfigValues) set(key string, value string) {
+	v.config[key] = value
+}
+
+func (v *connectorConfigValues) optional(key string, value string) {
+	if value != "" {
+		v.config[key] = value
+	}
+}
+
+func (v *connectorConfigValues) required(key string, value string) {
+	if value == "" {
+		v.errs = append(v.errs, fmt.Errorf("%w: %s is required", ErrInvalidConnectorConfig, key))
+		return
+	}
+	v.config[key] = value
+}
+
+func (v *connectorConfigValues) oneOf(key string, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			v.config[key] = value
+			return
+		}
+	}
+	v.invalid(key, value, "must be one of "+strings.Join(allowed, ", "))
+}
+
+func (v *connectorConfigValues) positive(key string, n int) {
+	if n < 0 {
+		v.invalid(key, strconv.Itoa(n), "must not be negative")
+	} else if n > 0 {
+		v.config[key] = strconv.Itoa(n)
+	}
+}
+
+func (v *connectorConfigValues) tasks(n int) {
+	if n <= 0 {
+		n = 1
+	}
+	v.config["tasks.max"] = strconv.Itoa(n)
+}
+
+func (v *connectorConfigValues) invalid(key string, value string, reason string) {
+	v.errs = append(v.errs, fmt.Errorf("%w: %s=%q %s", ErrInvalidConnectorConfig, key, value, reason))
+}
+
+func (v *connectorConfigValues) build(extra map[string]string) (map[string]string, error) {
+	if len(v.errs) > 0 {
+		return nil, errors.Join(v.errs...)
+	}
+	for k, val := range extra {
+		v.config[k] = val
+	}
+	return v.config, nil
+}
@@ -0,0 +1,72 @@
+package resources_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestS3SinkConfig_Config(t *testing.T) {
+	config, err := resources.S3SinkConfig{
+		Kafka:              resources.ConnectorKafkaAuth{ServiceAccountID: "sa-123"},
+		Topics:             []string{"orders", "payments"},
+		InputDataFormat:    resources.DataFormatAvro,
+		OutputDataFormat:   resources.DataFormatParquet,
+		AWSAccessKeyID:     "AKIA",
+		AWSSecretAccessKey: "secret",
+		BucketName:         "archive",
+		TimeInterval:       "HOURLY",
+		FlushSize:          1000,
+		Extra:              map[string]string{"rotate.schedule.interval.ms": "600000"},
+	}.Config()
+	if err != nil {
+		t.Fatalf("Config failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"connector.class":             "S3_SINK",
+		"kafka.auth.mode":             "SERVICE_ACCOUNT",
+		"kafka.service.account.id":    "sa-123",
+		"topics":                      "orders,payments",
+		"s3.bucket.name":              "archive",
+		"flush.size":                  "1000",
+		"tasks.max":                   "1",
+		"rotate.schedule.interval.ms": "600000",
+	}
+	for k, v := range expected {
+		if config[k] != v {
+			t.Errorf("Expected %s=%s, got %q", k, v, config[k])
+		}
+	}
+	if _, ok := config["topics.dir"]; ok {
+		t.Error("Expected unset topics.dir to be omitted")
+	}
+}
+
+func TestJDBCSourceConfig_Config_Invalid(t *testing.T) {
+	_, err := resources.JDBCSourceConfig{
+		ConnectorClass:   resources.ConnectorClassPostgresSource,
+		Kafka:            resources.ConnectorKafkaAuth{APIKey: "key", APISecret: "secret"},
+		Host:             "db.internal",
+		Port:             5432,
+		User:             "reader",
+		DatabaseName:     "shop",
+		Mode:             "timestamp+incrementing",
+		TimestampColumn:  "updated_at",
+		TopicPrefix:      "pg.",
+		OutputDataFormat: resources.DataFormatAvro,
+	}.Config()
+	if !errors.Is(err, resources.ErrInvalidConnectorConfig) {
+		t.Fatalf("Expected ErrInvalidConnectorConfig, got %v", err)
+	}
+	for _, key := range []string{"connection.password", "incrementing.column.name"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Expected error to mention %s, got %v", key, err)
+		}
+	}
+	if strings.Contains(err.Error(), "timestamp.column.name") {
+		t.Errorf("Expected timestamp.column.name to be accepted, got %v", err)
+	}
+}
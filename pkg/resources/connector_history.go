@@ -0,0 +1,219 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// ConnectorTransition records a connector moving from one state to another.
+type ConnectorTransition struct {
+	ClusterID     string
+	ConnectorName string
+	From          string // empty for the first observation of a connector
+	To            string
+	At            time.Time
+}
+
+// ConnectorHistoryStore persists connector state transitions.
+// Implementations must be safe for concurrent use.
+type ConnectorHistoryStore interface {
+	// Append stores a transition
+	Append(ctx context.Context, t ConnectorTransition) error
+	// Transitions returns every stored transition of a connector, oldest first
+	Transitions(ctx context.Context, clusterID string, connectorName string) ([]ConnectorTransition, error)
+}
+
+// MemoryConnectorHistoryStore is an in-memory ConnectorHistoryStore.
+// History does not survive process restarts; use a persistent store for long-term stats.
+type MemoryConnectorHistoryStore struct {
+	mu          sync.Mutex
+	transitions map[[2]string][]ConnectorTransition
+}
+
+// NewMemoryConnectorHistoryStore creates an empty in-memory history store.
+func NewMemoryConnectorHistoryStore() *MemoryConnectorHistoryStore {
+	return &MemoryConnectorHistoryStore{transitions: make(map[[2]string][]ConnectorTransition)}
+}
+
+// Append implements ConnectorHistoryStore.
+func (s *MemoryConnectorHistoryStore) Append(ctx context.Context, t ConnectorTransition) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := [2]string{t.ClusterID, t.ConnectorName}
+	s.transitions[key] = append(s.transitions[key], t)
+	return nil
+}
+
+// Transitions implements ConnectorHistoryStore.
+func (s *MemoryConnectorHistoryStore) Transitions(ctx context.Context, clusterID string, connectorName string) ([]ConnectorTransition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	transitions := append([]ConnectorTransition(nil), s.transitions[[2]string{clusterID, connectorName}]...)
+	sort.SliceStable(transitions, func(i, j int) bool { return transitions[i].At.Before(transitions[j].At) })
+	return transitions, nil
+}
+
+// ConnectorHistory turns observed connector states into transitions in a
+// ConnectorHistoryStore and computes reliability stats from them.
+//
+// Feed it from whatever watches connectors, either by calling Observe with states
+// already at hand or by calling ObserveStatus periodically:
+//
+//	history := resources.NewConnectorHistory(resources.NewMemoryConnectorHistoryStore())
+//	_ = history.ObserveStatus(ctx, connectors, "env-123", "lkc-abc", "orders-sink")
+//	stats, _ := history.Stats(ctx, "lkc-abc", "orders-sink", time.Now().Add(-24*time.Hour), time.Now())
+type ConnectorHistory struct {
+	store ConnectorHistoryStore
+	clock clock.Clock
+
+	mu   sync.Mutex
+	last map[[2]string]string
+}
+
+// NewConnectorHistory creates a history tracker writing to store.
+func NewConnectorHistory(store ConnectorHistoryStore) *ConnectorHistory {
+	return &ConnectorHistory{store: store, clock: clock.Real, last: make(map[[2]string]string)}
+}
+
+// WithClock sets the clock used to timestamp states recorded by ObserveStatus (default clock.Real).
+func (h *ConnectorHistory) WithClock(c clock.Clock) *ConnectorHistory {
+	h.clock = clock.OrReal(c)
+	return h
+}
+
+// Observe records the state of a connector at time at. A transition is stored
+// only when the state differs from the last one recorded for the connector.
+func (h *ConnectorHistory) Observe(ctx context.Context, clusterID string, connectorName string, state string, at time.Time) error {
+	key := [2]string{clusterID, connectorName}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	prev, known := h.last[key]
+	if !known {
+		transitions, err := h.store.Transitions(ctx, clusterID, connectorName)
+		if err != nil {
+			return fmt.Errorf("failed to load history of connector %s: %w", connectorName, err)
+		}
+		if n := len(transitions); n > 0 {
+			prev = transitions[n-1].To
+		}
+	}
+	if prev == state && prev != "" {
+		h.last[key] = state
+		return nil
+	}
+
+	t := ConnectorTransition{ClusterID: clusterID, ConnectorName: connectorName, From: prev, To: state, At: at}
+	if err := h.store.Append(ctx, t); err != nil {
+		return fmt.Errorf("failed to record transition of connector %s: %w", connectorName, err)
+	}
+	h.last[key] = state
+	return nil
+}
+
+// ObserveStatus fetches the current status of a connector and records it with Observe.
+func (h *ConnectorHistory) ObserveStatus(ctx context.Context, cm *ConnectorManager, environmentID string, clusterID string, connectorName string) error {
+	status, err := cm.GetConnectorStatus(ctx, environmentID, clusterID, connectorName)
+	if err != nil {
+		return err
+	}
+	return h.Observe(ctx, clusterID, connectorName, status.State, h.clock.Now())
+}
+
+// ConnectorStats summarizes the reliability of a connector over a time window.
+type ConnectorStats struct {
+	// Observed is the part of the window for which the connector state is known
+	Observed time.Duration
+	// Uptime is the fraction of Observed spent RUNNING, between 0 and 1
+	Uptime float64
+	// Flaps is the number of times the connector left the RUNNING state
+	Flaps int
+	// Failures is the number of times the connector entered the FAILED state
+	Failures int
+	// MTTR is the mean time from entering FAILED to returning to RUNNING,
+	// over failures that recovered within the window
+	MTTR time.Duration
+}
+
+// Stats computes reliability stats for a connector between from and to.
+func (h *ConnectorHistory) Stats(ctx context.Context, clusterID string, connectorName string, from, to time.Time) (*ConnectorStats, error) {
+	transitions, err := h.store.Transitions(ctx, clusterID, connectorName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load history of connector %s: %w", connectorName, err)
+	}
+	return computeConnectorStats(transitions, from, to), nil
+}
+
+func computeConnectorStats(transitions []ConnectorTransition, from, to time.Time) *ConnectorStats {
+	stats := &ConnectorStats{}
+
+	state, since := "", from
+	var running time.Duration
+	var failedAt time.Time
+	var recovered int
+	var repairTime time.Duration
+
+	// account adds the time spent in the current state up to end.
+	account := func(end time.Time) {
+		if state == "" || !end.After(since) {
+			return
+		}
+		d := end.Sub(since)
+		stats.Observed += d
+		if state == ConnectorStateRunning {
+			running += d
+		}
+	}
+
+	for _, t := range transitions {
+		if !t.At.After(from) {
+			switch t.To {
+			case ConnectorStateFailed:
+				if state != ConnectorStateFailed {
+					failedAt = t.At
+				}
+			case ConnectorStateRunning:
+				failedAt = time.Time{}
+			}
+			state = t.To
+			continue
+		}
+		if !t.At.Before(to) {
+			break
+		}
+
+		account(t.At)
+		if t.From == ConnectorStateRunning && t.To != ConnectorStateRunning {
+			stats.Flaps++
+		}
+		switch t.To {
+		case ConnectorStateFailed:
+			if state != ConnectorStateFailed {
+				stats.Failures++
+				failedAt = t.At
+			}
+		case ConnectorStateRunning:
+			if !failedAt.IsZero() {
+				recovered++
+				repairTime += t.At.Sub(failedAt)
+				failedAt = time.Time{}
+			}
+		}
+		state, since = t.To, t.At
+	}
+	account(to)
+
+	if stats.Observed > 0 {
+		stats.Uptime = float64(running) / float64(stats.Observed)
+	}
+	if recovered > 0 {
+		stats.MTTR = repairTime / time.Duration(recovered)
+	}
+	return stats
+}
@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestConnectorHistory_Stats(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryConnectorHistoryStore()
+	history := NewConnectorHistory(store)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	observations := []struct {
+		offset time.Duration
+		state  string
+	}{
+		{0, ConnectorStateRunning},
+		{10 * time.Minute, ConnectorStateRunning}, // unchanged, not recorded
+		{60 * time.Minute, ConnectorStateFailed},
+		{70 * time.Minute, ConnectorStateRunning},
+		{80 * time.Minute, ConnectorStatePaused},
+		{90 * time.Minute, ConnectorStateRunning},
+	}
+	for _, o := range observations {
+		if err := history.Observe(ctx, "lkc-1", "orders-sink", o.state, start.Add(o.offset)); err != nil {
+			t.Fatalf("Observe error: %v", err)
+		}
+	}
+
+	transitions, _ := store.Transitions(ctx, "lkc-1", "orders-sink")
+	if len(transitions) != 5 {
+		t.Fatalf("expected 5 transitions, got %d: %#v", len(transitions), transitions)
+	}
+
+	stats, err := history.Stats(ctx, "lkc-1", "orders-sink", start, start.Add(100*time.Minute))
+	if err != nil {
+		t.Fatalf("Stats error: %v", err)
+	}
+	if stats.Observed != 100*time.Minute {
+		t.Errorf("Observed = %v, want 100m", stats.Observed)
+	}
+	if stats.Uptime != 0.8 {
+		t.Errorf("Uptime = %v, want 0.8", stats.Uptime)
+	}
+	if stats.Flaps != 2 || stats.Failures != 1 {
+		t.Errorf("Flaps = %d, Failures = %d, want 2 and 1", stats.Flaps, stats.Failures)
+	}
+	if stats.MTTR != 10*time.Minute {
+		t.Errorf("MTTR = %v, want 10m", stats.MTTR)
+	}
+
+	// A new tracker resumes from the store instead of re-recording the current state
+	if err := NewConnectorHistory(store).Observe(ctx, "lkc-1", "orders-sink", ConnectorStateRunning, start.Add(2*time.Hour)); err != nil {
+		t.Fatalf("Observe error: %v", err)
+	}
+	if transitions, _ := store.Transitions(ctx, "lkc-1", "orders-sink"); len(transitions) != 5 {
+		t.Errorf("expected no new transition, got %d", len(transitions))
+	}
+}
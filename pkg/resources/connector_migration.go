@@ -0,0 +1,246 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// MigrationStep identifies the last completed step of a connector migration.
+type MigrationStep string
+
+// Migration steps, in the order they are performed.
+const (
+	MigrationStepPending         MigrationStep = ""
+	MigrationStepExported        MigrationStep = "EXPORTED"
+	MigrationStepSourcePaused    MigrationStep = "SOURCE_PAUSED"
+	MigrationStepOffsetsCaptured MigrationStep = "OFFSETS_CAPTURED"
+	MigrationStepTargetCreated   MigrationStep = "TARGET_CREATED"
+	MigrationStepCompleted       MigrationStep = "COMPLETED"
+)
+
+// MigrationCheckpoint records the progress of a connector migration so that an
+// interrupted migration can be resumed without repeating completed steps.
+type MigrationCheckpoint struct {
+	ConnectorName string
+	Step          MigrationStep
+	Config        map[string]string
+	Offsets       []api.ConnectorOffset
+}
+
+// MigrationCheckpointStore persists migration checkpoints, keyed by connector name.
+// Implementations must be safe for concurrent use.
+type MigrationCheckpointStore interface {
+	// Load returns the checkpoint for a connector, or nil if none has been saved
+	Load(ctx context.Context, connectorName string) (*MigrationCheckpoint, error)
+	// Save stores the checkpoint, replacing any previous one for the same connector
+	Save(ctx context.Context, checkpoint *MigrationCheckpoint) error
+}
+
+// MemoryCheckpointStore is an in-memory MigrationCheckpointStore.
+// Checkpoints do not survive process restarts; use a persistent store for resumability across restarts.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]MigrationCheckpoint
+}
+
+// NewMemoryCheckpointStore creates an empty in-memory checkpoint store.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]MigrationCheckpoint)}
+}
+
+// Load implements MigrationCheckpointStore.
+func (s *MemoryCheckpointStore) Load(ctx context.Context, connectorName string) (*MigrationCheckpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp, ok := s.checkpoints[connectorName]
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+// Save implements MigrationCheckpointStore.
+func (s *MemoryCheckpointStore) Save(ctx context.Context, checkpoint *MigrationCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[checkpoint.ConnectorName] = *checkpoint
+	return nil
+}
+
+// ConnectorMigrationRequest describes a connector to move between Connect clusters.
+type ConnectorMigrationRequest struct {
+	ConnectorName       string
+	SourceEnvironmentID string
+	SourceClusterID     string
+	TargetEnvironmentID string
+	TargetClusterID     string
+	// ConfigOverrides are merged into the exported config before the connector is created
+	// on the target. Confluent Cloud masks sensitive values on export, so secrets
+	// (passwords, keys) must be supplied here; Migrate fails with ErrMaskedConfigValue
+	// before touching the source if any masked value is not overridden.
+	ConfigOverrides map[string]string
+	// PollInterval controls how often the source status is polled while waiting
+	// for it to pause (optional, defaults to 5 seconds).
+	PollInterval time.Duration
+}
+
+// ConnectorMigrator moves connectors between Connect clusters with exactly-once
+// semantics: the source is paused before its offsets are captured, and the target
+// is created starting from those offsets before the source is deleted.
+type ConnectorMigrator struct {
+	source *ConnectorManager
+	target *ConnectorManager
+	store  MigrationCheckpointStore
+	clock  clock.Clock
+}
+
+// NewConnectorMigrator creates a migrator. source and target may be the same manager
+// when both Connect clusters are reachable with the same credentials.
+// If store is nil, an in-memory store is used.
+func NewConnectorMigrator(source *ConnectorManager, target *ConnectorManager, store MigrationCheckpointStore) *ConnectorMigrator {
+	if store == nil {
+		store = NewMemoryCheckpointStore()
+	}
+	return &ConnectorMigrator{source: source, target: target, store: store, clock: clock.Real}
+}
+
+// WithClock sets the clock used while polling connector status (default clock.Real).
+func (m *ConnectorMigrator) WithClock(c clock.Clock) *ConnectorMigrator {
+	m.clock = clock.OrReal(c)
+	return m
+}
+
+// Migrate performs (or resumes) a connector migration:
+//  1. Export the source connector configuration
+//  2. Pause the source connector and wait until it reports PAUSED
+//  3. Capture the source connector offsets
+//  4. Create the connector on the target cluster with the captured offsets
+//  5. Delete the source connector
+//
+// A checkpoint is saved after each step. If Migrate fails, calling it again with
+// the same request resumes from the last completed step.
+// Returns the final checkpoint, or the last saved checkpoint along with the error.
+func (m *ConnectorMigrator) Migrate(ctx context.Context, req ConnectorMigrationRequest) (*MigrationCheckpoint, error) {
+	cp, err := m.store.Load(ctx, req.ConnectorName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migration checkpoint for %s: %w", req.ConnectorName, err)
+	}
+	if cp == nil {
+		cp = &MigrationCheckpoint{ConnectorName: req.ConnectorName}
+	}
+
+	for cp.Step != MigrationStepCompleted {
+		if err := m.advance(ctx, req, cp); err != nil {
+			return cp, fmt.Errorf("connector migration of %s failed after step %q: %w", req.ConnectorName, cp.Step, err)
+		}
+		if err := m.store.Save(ctx, cp); err != nil {
+			return cp, fmt.Errorf("failed to save migration checkpoint for %s: %w", req.ConnectorName, err)
+		}
+	}
+
+	return cp, nil
+}
+
+// advance performs the step following cp.Step and updates cp on success.
+func (m *ConnectorMigrator) advance(ctx context.Context, req ConnectorMigrationRequest, cp *MigrationCheckpoint) error {
+	switch cp.Step {
+	case MigrationStepPending:
+		config, err := m.source.GetConnectorConfig(ctx, req.SourceEnvironmentID, req.SourceClusterID, req.ConnectorName)
+		if err != nil {
+			return err
+		}
+		var missing []string
+		for k, v := range config {
+			if _, ok := req.ConfigOverrides[k]; !ok && isMaskedValue(v) {
+				missing = append(missing, k)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			return fmt.Errorf("%w: %s", ErrMaskedConfigValue, strings.Join(missing, ", "))
+		}
+		for k, v := range req.ConfigOverrides {
+			config[k] = v
+		}
+		cp.Config = config
+		cp.Step = MigrationStepExported
+
+	case MigrationStepExported:
+		if err := m.source.PauseConnector(ctx, req.SourceEnvironmentID, req.SourceClusterID, req.ConnectorName); err != nil {
+			return err
+		}
+		if err := m.waitForSourceState(ctx, req, ConnectorStatePaused); err != nil {
+			return err
+		}
+		cp.Step = MigrationStepSourcePaused
+
+	case MigrationStepSourcePaused:
+		offsets, err := m.source.GetConnectorOffsets(ctx, req.SourceEnvironmentID, req.SourceClusterID, req.ConnectorName)
+		if err != nil {
+			return err
+		}
+		cp.Offsets = offsets.Offsets
+		cp.Step = MigrationStepOffsetsCaptured
+
+	case MigrationStepOffsetsCaptured:
+		_, err := m.target.CreateConnectorWithOffsets(ctx, req.TargetEnvironmentID, req.TargetClusterID, req.ConnectorName, cp.Config, cp.Offsets)
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.IsConflict() {
+			// A previous attempt may have created the connector before its response or
+			// checkpoint was lost; 409 is also returned while the cluster rebalances, so
+			// only treat the connector as created if it exists.
+			if _, getErr := m.target.GetConnector(ctx, req.TargetEnvironmentID, req.TargetClusterID, req.ConnectorName); getErr == nil {
+				err = nil
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create connector %s on target: %w", req.ConnectorName, err)
+		}
+		cp.Step = MigrationStepTargetCreated
+
+	case MigrationStepTargetCreated:
+		// A previous attempt may have deleted the source before its response or
+		// checkpoint was lost
+		if err := m.source.DeleteConnector(ctx, req.SourceEnvironmentID, req.SourceClusterID, req.ConnectorName); err != nil && !isNotFound(err) {
+			return err
+		}
+		cp.Step = MigrationStepCompleted
+
+	default:
+		return fmt.Errorf("unknown migration step %q", cp.Step)
+	}
+
+	return nil
+}
+
+// waitForSourceState polls the source connector until it reports the given state.
+func (m *ConnectorMigrator) waitForSourceState(ctx context.Context, req ConnectorMigrationRequest, state string) error {
+	interval := req.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	for {
+		status, err := m.source.GetConnectorStatus(ctx, req.SourceEnvironmentID, req.SourceClusterID, req.ConnectorName)
+		if err != nil {
+			return err
+		}
+		if status.State == state {
+			return nil
+		}
+
+		select {
+		case <-m.clock.After(interval):
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for connector %s to reach %s: %w", req.ConnectorName, state, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestConnectorMigrator_MigrateAndResume(t *testing.T) {
+	const sourcePrefix = "/connect/v1/environments/env-1/clusters/lcc-src/connectors/orders-sink"
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	failCreate := true
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := r.Method + " " + r.URL.Path
+		calls[key]++
+
+		w.Header().Set("Content-Type", "application/json")
+		switch key {
+		case "GET " + sourcePrefix + "/config":
+			_ = json.NewEncoder(w).Encode(map[string]string{"connector.class": "S3_SINK", "aws.secret.access.key": "****"})
+		case "PUT " + sourcePrefix + "/pause":
+			w.WriteHeader(http.StatusAccepted)
+		case "GET " + sourcePrefix + "/status":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"state": "PAUSED"})
+		case "GET " + sourcePrefix + "/offsets":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"name": "orders-sink",
+				"offsets": []map[string]interface{}{
+					{"partition": map[string]interface{}{"kafka_topic": "orders", "kafka_partition": 0}, "offset": map[string]interface{}{"kafka_offset": 42}},
+				},
+			})
+		case "POST /connect/v1/environments/env-2/clusters/lcc-dst/connectors":
+			if failCreate {
+				failCreate = false
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			var body struct {
+				Config  map[string]string `json:"config"`
+				Offsets []interface{}     `json:"offsets"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode create body: %v", err)
+			}
+			if body.Config["aws.secret.access.key"] != "real-secret" {
+				t.Errorf("Expected config override to be applied, got %v", body.Config)
+			}
+			if len(body.Offsets) != 1 {
+				t.Errorf("Expected 1 offset on create, got %d", len(body.Offsets))
+			}
+			w.WriteHeader(http.StatusCreated)
+		case "DELETE " + sourcePrefix:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s", key)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	mgr := resources.NewConnectorManager(newTestClient(t, server.URL))
+	store := resources.NewMemoryCheckpointStore()
+	migrator := resources.NewConnectorMigrator(mgr, mgr, store)
+
+	req := resources.ConnectorMigrationRequest{
+		ConnectorName:       "orders-sink",
+		SourceEnvironmentID: "env-1",
+		SourceClusterID:     "lcc-src",
+		TargetEnvironmentID: "env-2",
+		TargetClusterID:     "lcc-dst",
+		ConfigOverrides:     map[string]string{"aws.secret.access.key": "real-secret"},
+		PollInterval:        time.Millisecond,
+	}
+
+	cp, err := migrator.Migrate(context.Background(), req)
+	if err == nil {
+		t.Fatal("Expected first migration attempt to fail on create")
+	}
+	if cp.Step != resources.MigrationStepOffsetsCaptured {
+		t.Fatalf("Expected checkpoint at %s, got %s", resources.MigrationStepOffsetsCaptured, cp.Step)
+	}
+
+	cp, err = migrator.Migrate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Resumed migration failed: %v", err)
+	}
+	if cp.Step != resources.MigrationStepCompleted {
+		t.Errorf("Expected completed migration, got %s", cp.Step)
+	}
+	if n := calls["PUT "+sourcePrefix+"/pause"]; n != 1 {
+		t.Errorf("Expected source to be paused once across attempts, got %d", n)
+	}
+}
+
+func TestConnectorMigrator_ResumeAfterLostCreateResponse(t *testing.T) {
+	const (
+		sourcePrefix = "/connect/v1/environments/env-1/clusters/lcc-src/connectors/orders-sink"
+		targetPath   = "/connect/v1/environments/env-2/clusters/lcc-dst/connectors"
+	)
+
+	var mu sync.Mutex
+	created := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := r.Method + " " + r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+		switch key {
+		case "GET " + sourcePrefix + "/config":
+			_ = json.NewEncoder(w).Encode(map[string]string{"connector.class": "S3_SINK"})
+		case "PUT " + sourcePrefix + "/pause":
+			w.WriteHeader(http.StatusAccepted)
+		case "GET " + sourcePrefix + "/status":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"state": "PAUSED"})
+		case "GET " + sourcePrefix + "/offsets":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "orders-sink", "offsets": []interface{}{}})
+		case "POST " + targetPath:
+			if created {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error_code":409,"message":"Connector orders-sink already exists"}`))
+				return
+			}
+			// The connector is created but the response never reaches the client.
+			created = true
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "GET " + targetPath + "/orders-sink":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "orders-sink", "config": map[string]string{"connector.class": "S3_SINK"}})
+		case "DELETE " + sourcePrefix:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s", key)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	mgr := resources.NewConnectorManager(newTestClient(t, server.URL))
+	migrator := resources.NewConnectorMigrator(mgr, mgr, resources.NewMemoryCheckpointStore())
+
+	req := resources.ConnectorMigrationRequest{
+		ConnectorName:       "orders-sink",
+		SourceEnvironmentID: "env-1",
+		SourceClusterID:     "lcc-src",
+		TargetEnvironmentID: "env-2",
+		TargetClusterID:     "lcc-dst",
+		PollInterval:        time.Millisecond,
+	}
+
+	if _, err := migrator.Migrate(context.Background(), req); err == nil {
+		t.Fatal("Expected first migration attempt to fail on create")
+	}
+
+	cp, err := migrator.Migrate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Resumed migration failed: %v", err)
+	}
+	if cp.Step != resources.MigrationStepCompleted {
+		t.Errorf("Expected completed migration, got %s", cp.Step)
+	}
+}
+
+func TestConnectorMigrator_ResumeAfterLostDeleteResponse(t *testing.T) {
+	const sourcePrefix = "/connect/v1/environments/env-1/clusters/lcc-src/connectors/orders-sink"
+
+	var mu sync.Mutex
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := r.Method + " " + r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+		switch key {
+		case "GET " + sourcePrefix + "/config":
+			_ = json.NewEncoder(w).Encode(map[string]string{"connector.class": "S3_SINK"})
+		case "PUT " + sourcePrefix + "/pause":
+			w.WriteHeader(http.StatusAccepted)
+		case "GET " + sourcePrefix + "/status":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"state": "PAUSED"})
+		case "GET " + sourcePrefix + "/offsets":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "orders-sink", "offsets": []interface{}{}})
+		case "POST /connect/v1/environments/env-2/clusters/lcc-dst/connectors":
+			w.WriteHeader(http.StatusCreated)
+		case "DELETE " + sourcePrefix:
+			if deleted {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error_code":404,"message":"Connector orders-sink not found"}`))
+				return
+			}
+			// The source is deleted but the response never reaches the client.
+			deleted = true
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			t.Errorf("unexpected request %s", key)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	mgr := resources.NewConnectorManager(newTestClient(t, server.URL))
+	migrator := resources.NewConnectorMigrator(mgr, mgr, resources.NewMemoryCheckpointStore())
+
+	req := resources.ConnectorMigrationRequest{
+		ConnectorName:       "orders-sink",
+		SourceEnvironmentID: "env-1",
+		SourceClusterID:     "lcc-src",
+		TargetEnvironmentID: "env-2",
+		TargetClusterID:     "lcc-dst",
+		PollInterval:        time.Millisecond,
+	}
+
+	if _, err := migrator.Migrate(context.Background(), req); err == nil {
+		t.Fatal("Expected first migration attempt to fail on delete")
+	}
+
+	cp, err := migrator.Migrate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Resumed migration failed: %v", err)
+	}
+	if cp.Step != resources.MigrationStepCompleted {
+		t.Errorf("Expected completed migration, got %s", cp.Step)
+	}
+}
+
+func TestConnectorMigrator_RejectsMaskedConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/connect/v1/environments/env-1/clusters/lcc-src/connectors/orders-sink/config" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"connector.class": "S3_SINK", "aws.secret.access.key": "****", "aws.access.key.id": "****"})
+	}))
+	defer server.Close()
+
+	mgr := resources.NewConnectorManager(newTestClient(t, server.URL))
+	migrator := resources.NewConnectorMigrator(mgr, mgr, nil)
+
+	_, err := migrator.Migrate(context.Background(), resources.ConnectorMigrationRequest{
+		ConnectorName:       "orders-sink",
+		SourceEnvironmentID: "env-1",
+		SourceClusterID:     "lcc-src",
+		TargetEnvironmentID: "env-2",
+		TargetClusterID:     "lcc-dst",
+		ConfigOverrides:     map[string]string{"aws.secret.access.key": "real-secret"},
+	})
+	if !errors.Is(err, resources.ErrMaskedConfigValue) || !strings.Contains(err.Error(), "aws.access.key.id") || strings.Contains(err.Error(), "aws.secret.access.key") {
+		t.Errorf("Expected masked aws.access.key.id to be rejected, got %v", err)
+	}
+}
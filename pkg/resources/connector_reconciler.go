@@ -0,0 +1,338 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// ConnectorSpec is the desired state of a connector.
+type ConnectorSpec struct {
+	EnvironmentID string
+	ClusterID     string
+	Name          string
+	// Config is the desired connector configuration, including "connector.class". Values
+	// may contain ${secret:...} references if the reconciler has a SecretResolver.
+	Config map[string]string
+	// Paused is the desired run-state; false means the connector should be RUNNING
+	Paused bool
+	// HealthTimeout bounds how long Ensure waits for the connector to reach the desired
+	// run-state with all tasks healthy (optional, defaults to 5 minutes)
+	HealthTimeout time.Duration
+	// PollInterval controls how often status is polled while waiting (optional, defaults to 5 seconds)
+	PollInterval time.Duration
+}
+
+// Actions taken by ConnectorReconciler.Ensure.
+const (
+	ConnectorActionNone    = "none"
+	ConnectorActionCreated = "created"
+	ConnectorActionUpdated = "updated"
+)
+
+// Condition types reported by ConnectorReconciler.Ensure.
+const (
+	// ConnectorConditionConfigured is true when the connector exists with the desired config
+	ConnectorConditionConfigured = "Configured"
+	// ConnectorConditionRunState is true when the connector is RUNNING or PAUSED as desired
+	ConnectorConditionRunState = "RunState"
+	// ConnectorConditionHealthy is true when no task of a running connector has failed
+	ConnectorConditionHealthy = "Healthy"
+)
+
+// ConnectorCondition is one aspect of a connector's reconciled status,
+// modeled after Kubernetes status conditions.
+type ConnectorCondition struct {
+	Type    string
+	Status  bool
+	Reason  string
+	Message string
+}
+
+// ConnectorConfigChange is a config value changed by Ensure. Sensitive values are
+// redacted as by RedactConnectorConfig, and From is empty for added keys.
+type ConnectorConfigChange struct {
+	Key  string
+	From string
+	To   string
+}
+
+// ConnectorEnsureResult describes what Ensure did and where the connector ended up.
+type ConnectorEnsureResult struct {
+	// Action is ConnectorActionNone, ConnectorActionCreated, or ConnectorActionUpdated
+	Action string
+	// ChangedKeys lists the config keys whose values differed from the desired config, sorted
+	ChangedKeys []string
+	// Changes details ChangedKeys with sensitive values redacted, safe to log
+	Changes []ConnectorConfigChange
+	// Status is the last observed connector status
+	Status *api.ConnectorStatus
+	// Conditions are the Configured, RunState, and Healthy conditions
+	Conditions []ConnectorCondition
+}
+
+// Ready returns true when every condition is true.
+func (r *ConnectorEnsureResult) Ready() bool {
+	for _, c := range r.Conditions {
+		if !c.Status {
+			return false
+		}
+	}
+	return len(r.Conditions) > 0
+}
+
+// Condition returns the condition of the given type, or nil if it was not reported.
+func (r *ConnectorEnsureResult) Condition(conditionType string) *ConnectorCondition {
+	for i := range r.Conditions {
+		if r.Conditions[i].Type == conditionType {
+			return &r.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// ConnectorReconciler converges connectors to a declared ConnectorSpec.
+//
+// Example usage:
+//
+//	reconciler := resources.NewConnectorReconciler(connectorManager)
+//	result, err := reconciler.Ensure(ctx, resources.ConnectorSpec{
+//		EnvironmentID: "env-123",
+//		ClusterID:     "lkc-abc",
+//		Name:          "orders-sink",
+//		Config:        map[string]string{"connector.class": "S3_SINK", "topics": "orders"},
+//	})
+//	if err == nil && !result.Ready() {
+//		// requeue
+//	}
+type ConnectorReconciler struct {
+	connectors *ConnectorManager
+	clock      clock.Clock
+	secrets    SecretResolver
+}
+
+// NewConnectorReconciler creates a reconciler using the given connector manager.
+func NewConnectorReconciler(connectors *ConnectorManager) *ConnectorReconciler {
+	return &ConnectorReconciler{connectors: connectors, clock: clock.Real}
+}
+
+// WithClock sets the clock used while polling connector status (default clock.Real).
+func (r *ConnectorReconciler) WithClock(c clock.Clock) *ConnectorReconciler {
+	r.clock = clock.OrReal(c)
+	return r
+}
+
+// WithSecretResolver sets the resolver for ${secret:...} references in ConnectorSpec.Config.
+// References are resolved before diffing and sending the config, so specs can be stored
+// without secrets. Without a resolver, references are sent verbatim.
+func (r *ConnectorReconciler) WithSecretResolver(resolve SecretResolver) *ConnectorReconciler {
+	r.secrets = resolve
+	return r
+}
+
+// Ensure makes the connector match spec:
+//  1. Creates the connector if it does not exist
+//  2. Updates its config if the semantic diff against spec.Config is non-empty
+//  3. Pauses or resumes it to match spec.Paused
+//  4. Waits until it reaches the desired run-state with no failed tasks
+//
+// The diff only covers keys present in spec.Config, so defaults added by the service
+// do not cause updates, and values masked by the service (e.g., "****") are treated as
+// unchanged since their actual values cannot be read back.
+//
+// An error is returned only when an API call fails. A connector that does not become
+// healthy within spec.HealthTimeout is reported through false conditions instead.
+func (r *ConnectorReconciler) Ensure(ctx context.Context, spec ConnectorSpec) (*ConnectorEnsureResult, error) {
+	cm := r.connectors
+	result := &ConnectorEnsureResult{Action: ConnectorActionNone}
+
+	config := spec.Config
+	if r.secrets != nil {
+		var err error
+		if config, err = ResolveSecretRefs(ctx, spec.Config, r.secrets); err != nil {
+			return result, err
+		}
+	}
+
+	actual, err := cm.GetConnectorConfig(ctx, spec.EnvironmentID, spec.ClusterID, spec.Name)
+	var apiErr *api.Error
+	switch {
+	case err == nil:
+		result.ChangedKeys = diffConnectorConfig(config, actual)
+		if len(result.ChangedKeys) > 0 {
+			if _, err := cm.UpdateConnector(ctx, spec.EnvironmentID, spec.ClusterID, spec.Name, config); err != nil {
+				return result, err
+			}
+			result.Action = ConnectorActionUpdated
+		}
+	case errors.As(err, &apiErr) && apiErr.IsNotFound():
+		if _, err := cm.CreateConnector(ctx, spec.EnvironmentID, spec.ClusterID, spec.Name, config); err != nil {
+			return result, err
+		}
+		result.Action = ConnectorActionCreated
+		result.ChangedKeys = sortedKeys(config)
+	default:
+		return result, err
+	}
+	for _, k := range result.ChangedKeys {
+		result.Changes = append(result.Changes, ConnectorConfigChange{
+			Key:  k,
+			From: redactConfigValue(k, actual[k]),
+			To:   redactConfigValue(k, spec.Config[k]),
+		})
+	}
+	result.Conditions = append(result.Conditions, ConnectorCondition{
+		Type:    ConnectorConditionConfigured,
+		Status:  true,
+		Reason:  "Reconciled",
+		Message: fmt.Sprintf("connector %s: %s", spec.Name, result.Action),
+	})
+
+	desired := ConnectorStateRunning
+	if spec.Paused {
+		desired = ConnectorStatePaused
+	}
+	status, err := cm.GetConnectorStatus(ctx, spec.EnvironmentID, spec.ClusterID, spec.Name)
+	if err != nil {
+		return result, err
+	}
+	switch {
+	case spec.Paused && status.State != ConnectorStatePaused:
+		err = cm.PauseConnector(ctx, spec.EnvironmentID, spec.ClusterID, spec.Name)
+	case !spec.Paused && status.State == ConnectorStatePaused:
+		err = cm.ResumeConnector(ctx, spec.EnvironmentID, spec.ClusterID, spec.Name)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	status, err = r.waitForHealth(ctx, spec, desired, status)
+	result.Status = status
+	if err != nil {
+		return result, err
+	}
+	result.Conditions = append(result.Conditions, runStateCondition(status, desired), healthCondition(status))
+	return result, nil
+}
+
+// waitForHealth polls the connector status until it settles in the desired state,
+// fails, or spec.HealthTimeout elapses. It returns the last observed status.
+func (r *ConnectorReconciler) waitForHealth(ctx context.Context, spec ConnectorSpec, desired string, status *api.ConnectorStatus) (*api.ConnectorStatus, error) {
+	timeout := spec.HealthTimeout
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+	interval := spec.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := r.clock.After(timeout)
+
+	for {
+		if connectorSettled(status, desired) {
+			return status, nil
+		}
+		select {
+		case <-r.clock.After(interval):
+		case <-deadline:
+			return status, nil
+		case <-ctx.Done():
+			return status, fmt.Errorf("waiting for connector %s to reach %s: %w", spec.Name, desired, ctx.Err())
+		}
+
+		next, err := r.connectors.GetConnectorStatus(ctx, spec.EnvironmentID, spec.ClusterID, spec.Name)
+		if err != nil {
+			return status, err
+		}
+		status = next
+	}
+}
+
+// connectorSettled reports whether waiting for status can stop: the connector
+// failed, or it is in the desired state with every task in that state too.
+func connectorSettled(status *api.ConnectorStatus, desired string) bool {
+	if status.State == ConnectorStateFailed {
+		return true
+	}
+	if status.State != desired {
+		return false
+	}
+	for _, task := range status.Tasks {
+		if task.State == ConnectorStateFailed {
+			return true
+		}
+		if task.State != desired {
+			return false
+		}
+	}
+	return true
+}
+
+func runStateCondition(status *api.ConnectorStatus, desired string) ConnectorCondition {
+	if status.State == desired {
+		return ConnectorCondition{Type: ConnectorConditionRunState, Status: true, Reason: desired}
+	}
+	return ConnectorCondition{
+		Type:    ConnectorConditionRunState,
+		Reason:  status.State,
+		Message: fmt.Sprintf("connector is %s, want %s", status.State, desired),
+	}
+}
+
+func healthCondition(status *api.ConnectorStatus) ConnectorCondition {
+	var problems []string
+	if status.State == ConnectorStateFailed {
+		problems = append(problems, "connector failed")
+	}
+	for _, e := range status.Errors {
+		problems = append(problems, e.Message)
+	}
+	for _, task := range status.Tasks {
+		if task.State == ConnectorStateFailed {
+			problems = append(problems, fmt.Sprintf("task %d failed: %s", task.ID, task.Error))
+		}
+	}
+	if len(problems) == 0 {
+		return ConnectorCondition{Type: ConnectorConditionHealthy, Status: true, Reason: "NoFailures"}
+	}
+	return ConnectorCondition{
+		Type:    ConnectorConditionHealthy,
+		Reason:  "Failed",
+		Message: strings.Join(problems, "; "),
+	}
+}
+
+// diffConnectorConfig returns the sorted keys of desired whose values differ in actual.
+// Masked actual values are assumed to match.
+func diffConnectorConfig(desired, actual map[string]string) []string {
+	var changed []string
+	for k, want := range desired {
+		got, ok := actual[k]
+		if ok && (got == want || isMaskedValue(got)) {
+			continue
+		}
+		changed = append(changed, k)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// isMaskedValue reports whether a config value was masked by the service.
+func isMaskedValue(v string) bool {
+	return v != "" && strings.Trim(v, "*") == ""
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
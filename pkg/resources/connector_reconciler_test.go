@@ -0,0 +1,150 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+// fakeConnectService serves a single connector with an in-memory config and state.
+type fakeConnectService struct {
+	mu     sync.Mutex
+	config map[string]string
+	state  string
+	calls  map[string]int
+}
+
+func (f *fakeConnectService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/connect/v1/environments/env-1/clusters/lkc-1/connectors"
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := r.Method + " " + r.URL.Path
+	f.calls[key]++
+
+	w.Header().Set("Content-Type", "application/json")
+	switch key {
+	case "GET " + prefix + "/orders-sink/config":
+		if f.config == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": "not found"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(f.config)
+	case "POST " + prefix:
+		var body struct {
+			Config map[string]string `json:"config"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.config, f.state = body.Config, resources.ConnectorStateProvisioning
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "orders-sink", "config": body.Config})
+	case "PUT " + prefix + "/orders-sink/config":
+		_ = json.NewDecoder(r.Body).Decode(&f.config)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "orders-sink", "config": f.config})
+	case "PUT " + prefix + "/orders-sink/pause":
+		f.state = resources.ConnectorStatePaused
+		w.WriteHeader(http.StatusAccepted)
+	case "PUT " + prefix + "/orders-sink/resume":
+		f.state = resources.ConnectorStateRunning
+		w.WriteHeader(http.StatusAccepted)
+	case "GET " + prefix + "/orders-sink/status":
+		state := f.state
+		if state == resources.ConnectorStateProvisioning {
+			// Report provisioning once, then running
+			f.state = resources.ConnectorStateRunning
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"state": state,
+			"tasks": []map[string]interface{}{{"id": 0, "state": state}},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestConnectorReconciler_Ensure(t *testing.T) {
+	fake := &fakeConnectService{calls: map[string]int{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	reconciler := resources.NewConnectorReconciler(resources.NewConnectorManager(newTestClient(t, server.URL)))
+	ctx := context.Background()
+	spec := resources.ConnectorSpec{
+		EnvironmentID: "env-1",
+		ClusterID:     "lkc-1",
+		Name:          "orders-sink",
+		Config:        map[string]string{"connector.class": "S3_SINK", "topics": "orders", "aws.secret.access.key": "secret"},
+		PollInterval:  time.Millisecond,
+	}
+
+	// Create
+	result, err := reconciler.Ensure(ctx, spec)
+	if err != nil {
+		t.Fatalf("Ensure failed: %v", err)
+	}
+	if result.Action != resources.ConnectorActionCreated || !result.Ready() {
+		t.Fatalf("Expected created and ready, got %+v", result)
+	}
+
+	// No-op: service masks secrets and adds defaults
+	fake.mu.Lock()
+	fake.config = map[string]string{"connector.class": "S3_SINK", "topics": "orders", "aws.secret.access.key": "****", "tasks.max": "1"}
+	fake.mu.Unlock()
+	result, err = reconciler.Ensure(ctx, spec)
+	if err != nil {
+		t.Fatalf("Ensure failed: %v", err)
+	}
+	if result.Action != resources.ConnectorActionNone || len(result.ChangedKeys) != 0 {
+		t.Errorf("Expected no changes, got %s %v", result.Action, result.ChangedKeys)
+	}
+
+	// Update and pause
+	spec.Config["topics"] = "orders,returns"
+	spec.Paused = true
+	result, err = reconciler.Ensure(ctx, spec)
+	if err != nil {
+		t.Fatalf("Ensure failed: %v", err)
+	}
+	if result.Action != resources.ConnectorActionUpdated || len(result.ChangedKeys) != 1 || result.ChangedKeys[0] != "topics" {
+		t.Errorf("Expected topics update, got %s %v", result.Action, result.ChangedKeys)
+	}
+	if c := result.Condition(resources.ConnectorConditionRunState); c == nil || !c.Status || c.Reason != resources.ConnectorStatePaused {
+		t.Errorf("Expected paused run-state condition, got %+v", c)
+	}
+	if fake.calls["PUT /connect/v1/environments/env-1/clusters/lkc-1/connectors/orders-sink/pause"] != 1 {
+		t.Errorf("Expected one pause call, got %v", fake.calls)
+	}
+}
+
+func TestConnectorReconciler_EnsureReportsFailure(t *testing.T) {
+	fake := &fakeConnectService{
+		calls:  map[string]int{},
+		config: map[string]string{"connector.class": "S3_SINK"},
+		state:  resources.ConnectorStateFailed,
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	reconciler := resources.NewConnectorReconciler(resources.NewConnectorManager(newTestClient(t, server.URL)))
+	result, err := reconciler.Ensure(context.Background(), resources.ConnectorSpec{
+		EnvironmentID: "env-1",
+		ClusterID:     "lkc-1",
+		Name:          "orders-sink",
+		Config:        map[string]string{"connector.class": "S3_SINK"},
+	})
+	if err != nil {
+		t.Fatalf("Ensure failed: %v", err)
+	}
+	if result.Ready() {
+		t.Error("Expected failed connector not to be ready")
+	}
+	if c := result.Condition(resources.ConnectorConditionHealthy); c == nil || c.Status {
+		t.Errorf("Expected unhealthy condition, got %+v", c)
+	}
+}
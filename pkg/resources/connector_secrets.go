@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// ErrMaskedConfigValue is returned by RotateConnectorSecrets and ConnectorMigrator when
+// the connector has masked values that were not supplied, since they cannot be read
+// back and resent.
+var ErrMaskedConfigValue = errors.New("masked connector config value not supplied")
+
+// RedactedConfigValue replaces sensitive values in configs returned by
+// RedactConnectorConfig and in ConnectorEnsureResult.Changes.
+const RedactedConfigValue = "[REDACTED]"
+
+// secretRefPattern matches ${secret:<name>} references in config values.
+var secretRefPattern = regexp.MustCompile(`\$\{secret:([^}]+)\}`)
+
+// SecretRef returns a reference to the named secret, e.g. "${secret:orders-db/password}",
+// to use as a config value in place of the secret itself.
+func SecretRef(name string) string {
+	return "${secret:" + name + "}"
+}
+
+// IsSecretRef reports whether value contains a ${secret:...} reference.
+func IsSecretRef(value string) bool {
+	return secretRefPattern.MatchString(value)
+}
+
+// SecretResolver returns the value of the named secret, e.g. from Vault or AWS Secrets
+// Manager.
+type SecretResolver func(ctx context.Context, name string) (string, error)
+
+// ResolveSecretRefs returns a copy of config with every ${secret:...} reference replaced
+// by its value from resolve, so configs can be stored and reviewed without secrets.
+func ResolveSecretRefs(ctx context.Context, config map[string]string, resolve SecretResolver) (map[string]string, error) {
+	resolved := make(map[string]string, len(config))
+	for _, k := range sortedKeys(config) {
+		v := config[k]
+		var resolveErr error
+		resolved[k] = secretRefPattern.ReplaceAllStringFunc(v, func(ref string) string {
+			if resolveErr != nil {
+				return ref
+			}
+			name := secretRefPattern.FindStringSubmatch(ref)[1]
+			secret, err := resolve(ctx, name)
+			if err != nil {
+				resolveErr = fmt.Errorf("failed to resolve secret %s for %s: %w", name, k, err)
+			}
+			return secret
+		})
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+	}
+	return resolved, nil
+}
+
+// IsSensitiveConfigKey reports whether a connector config key holds a secret, e.g.
+// "connection.password", "kafka.api.secret", "kafka.api.key", or "keyfile".
+func IsSensitiveConfigKey(key string) bool {
+	return client.IsSensitiveKey(key)
+}
+
+// RedactConnectorConfig returns a copy of config safe to log or display: values of
+// sensitive keys are replaced with RedactedConfigValue, except for ${secret:...}
+// references and values already masked by the service.
+func RedactConnectorConfig(config map[string]string) map[string]string {
+	redacted := make(map[string]string, len(config))
+	for k, v := range config {
+		redacted[k] = redactConfigValue(k, v)
+	}
+	return redacted
+}
+
+func redactConfigValue(key string, value string) string {
+	if value == "" || !IsSensitiveConfigKey(key) || IsSecretRef(value) || isMaskedValue(value) {
+		return value
+	}
+	return RedactedConfigValue
+}
+
+// RotateConnectorSecrets sets new values for sensitive config keys of an existing
+// connector, e.g. after rotating a database password, keeping all other keys.
+//
+// The service masks sensitive values when the config is read, and those cannot be sent
+// back, so secrets must include every masked key of the connector, not only the rotated
+// ones. Values may be ${secret:...} references once resolved with ResolveSecretRefs.
+// Returns errors:
+//   - ErrMaskedConfigValue listing masked keys missing from secrets
+//   - *api.Error with IsNotFound() if the connector does not exist
+//   - *api.Error with IsBadRequest() if the new config is invalid
+func (cm *ConnectorManager) RotateConnectorSecrets(ctx context.Context, environmentID string, clusterID string, connectorName string, secrets map[string]string) (*api.ConnectorConfig, error) {
+	config, err := cm.GetConnectorConfig(ctx, environmentID, clusterID, connectorName)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for k, v := range config {
+		if _, ok := secrets[k]; !ok && isMaskedValue(v) {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("failed to rotate secrets of connector %s: %w: %s", connectorName, ErrMaskedConfigValue, strings.Join(missing, ", "))
+	}
+
+	for k, v := range secrets {
+		config[k] = v
+	}
+	return cm.UpdateConnector(ctx, environmentID, clusterID, connectorName, config)
+}
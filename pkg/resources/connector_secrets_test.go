@@ -0,0 +1,133 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func staticSecrets(secrets map[string]string) resources.SecretResolver {
+	return func(_ context.Context, name string) (string, error) {
+		v, ok := secrets[name]
+		if !ok {
+			return "", fmt.Errorf("secret %s not found", name)
+		}
+		return v, nil
+	}
+}
+
+func TestResolveSecretRefs(t *testing.T) {
+	config := map[string]string{
+		"connection.password": resources.SecretRef("orders-db/password"),
+		"connection.url":      "jdbc:postgresql://db/orders?user=" + resources.SecretRef("orders-db/user"),
+		"topics":              "orders",
+	}
+	resolved, err := resources.ResolveSecretRefs(context.Background(), config,
+		staticSecrets(map[string]string{"orders-db/password": "hunter2", "orders-db/user": "etl"}))
+	if err != nil {
+		t.Fatalf("ResolveSecretRefs failed: %v", err)
+	}
+	if resolved["connection.password"] != "hunter2" || resolved["connection.url"] != "jdbc:postgresql://db/orders?user=etl" || resolved["topics"] != "orders" {
+		t.Errorf("Unexpected resolved config: %v", resolved)
+	}
+	if config["connection.password"] != "${secret:orders-db/password}" {
+		t.Errorf("Expected input config unchanged, got %v", config)
+	}
+
+	if _, err := resources.ResolveSecretRefs(context.Background(), config, staticSecrets(nil)); err == nil {
+		t.Error("Expected error for unknown secret")
+	}
+}
+
+func TestRedactConnectorConfig(t *testing.T) {
+	redacted := resources.RedactConnectorConfig(map[string]string{
+		"connection.password":     "hunter2",
+		"kafka.api.key":           "ABC",
+		"kafka.api.secret":        "xyz",
+		"keyfile":                 `{"type":"service_account"}`,
+		"aws.secret.access.key":   resources.SecretRef("aws"),
+		"ssl.truststore.password": "****",
+		"key.converter":           "AvroConverter",
+		"topics":                  "orders",
+	})
+	want := map[string]string{
+		"connection.password":     resources.RedactedConfigValue,
+		"kafka.api.key":           resources.RedactedConfigValue,
+		"kafka.api.secret":        resources.RedactedConfigValue,
+		"keyfile":                 resources.RedactedConfigValue,
+		"aws.secret.access.key":   "${secret:aws}",
+		"ssl.truststore.password": "****",
+		"key.converter":           "AvroConverter",
+		"topics":                  "orders",
+	}
+	for k, v := range want {
+		if redacted[k] != v {
+			t.Errorf("%s: expected %q, got %q", k, v, redacted[k])
+		}
+	}
+}
+
+func TestConnectorManager_RotateConnectorSecrets(t *testing.T) {
+	var updated map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			_, _ = w.Write([]byte(`{"connector.class":"PostgresSource","connection.user":"etl","connection.password":"****","kafka.api.secret":"****"}`))
+		case "PUT":
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"name":"my-connector","config":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	cm := resources.NewConnectorManager(newTestClient(t, server.URL))
+	ctx := context.Background()
+
+	_, err := cm.RotateConnectorSecrets(ctx, "env-123", "lkc-123", "my-connector", map[string]string{"connection.password": "new"})
+	if !errors.Is(err, resources.ErrMaskedConfigValue) || updated != nil {
+		t.Fatalf("Expected ErrMaskedConfigValue without update, got %v", err)
+	}
+
+	_, err = cm.RotateConnectorSecrets(ctx, "env-123", "lkc-123", "my-connector", map[string]string{"connection.password": "new", "kafka.api.secret": "s"})
+	if err != nil {
+		t.Fatalf("RotateConnectorSecrets failed: %v", err)
+	}
+	if updated["connection.password"] != "new" || updated["kafka.api.secret"] != "s" || updated["connection.user"] != "etl" {
+		t.Errorf("Unexpected updated config: %v", updated)
+	}
+}
+
+func TestConnectorReconciler_EnsureResolvesSecrets(t *testing.T) {
+	fake := &fakeConnectService{calls: map[string]int{}, state: resources.ConnectorStateRunning,
+		config: map[string]string{"connector.class": "S3_SINK", "aws.secret.access.key": "old"}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	reconciler := resources.NewConnectorReconciler(resources.NewConnectorManager(newTestClient(t, server.URL))).
+		WithSecretResolver(staticSecrets(map[string]string{"aws": "new"}))
+	result, err := reconciler.Ensure(context.Background(), resources.ConnectorSpec{
+		EnvironmentID: "env-1",
+		ClusterID:     "lkc-1",
+		Name:          "orders-sink",
+		Config:        map[string]string{"connector.class": "S3_SINK", "aws.secret.access.key": resources.SecretRef("aws")},
+		PollInterval:  time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Ensure failed: %v", err)
+	}
+	if fake.config["aws.secret.access.key"] != "new" {
+		t.Errorf("Expected resolved secret to be sent, got %v", fake.config)
+	}
+	want := resources.ConnectorConfigChange{Key: "aws.secret.access.key", From: resources.RedactedConfigValue, To: "${secret:aws}"}
+	if result.Action != resources.ConnectorActionUpdated || len(result.Changes) != 1 || result.Changes[0] != want {
+		t.Errorf("Unexpected result: %s %+v", result.Action, result.Changes)
+	}
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// ConnectorState is the lifecycle state of a connector, one of the ConnectorState*
+// constants.
+type ConnectorState string
+
+// connectorTransitions lists the states each state can be moved to with
+// TransitionConnector. A state may always "transition" to itself as a no-op.
+var connectorTransitions = map[ConnectorState][]ConnectorState{
+	ConnectorStateRunning:    {ConnectorStatePaused, ConnectorStateStopped},
+	ConnectorStatePaused:     {ConnectorStateRunning, ConnectorStateStopped},
+	ConnectorStateStopped:    {ConnectorStateRunning, ConnectorStatePaused},
+	ConnectorStateFailed:     {ConnectorStateRunning, ConnectorStatePaused, ConnectorStateStopped},
+	ConnectorStateUnassigned: {ConnectorStatePaused, ConnectorStateStopped},
+	// Provisioning connectors reject lifecycle requests until they are assigned
+	ConnectorStateProvisioning: nil,
+}
+
+// CanTransitionTo reports whether a connector in state s can be moved to state to.
+func (s ConnectorState) CanTransitionTo(to ConnectorState) bool {
+	if s == to {
+		return true
+	}
+	for _, allowed := range connectorTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionConnector moves a connector to the target state (ConnectorStateRunning,
+// ConnectorStatePaused, or ConnectorStateStopped) with the matching lifecycle call:
+// resume, pause, or stop, or a restart of the failed instances to recover a failed
+// connector. It does nothing if the connector is already in the target state, and
+// returns the state it was in.
+// Returns errors:
+//   - *ConnectorTransitionError if the connector cannot move to the target state
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (cm *ConnectorManager) TransitionConnector(ctx context.Context, environmentID string, clusterID string, connectorName string, to ConnectorState) (ConnectorState, error) {
+	status, err := cm.GetConnectorStatus(ctx, environmentID, clusterID, connectorName)
+	if err != nil {
+		return "", err
+	}
+	from := ConnectorState(status.State)
+	if from == to {
+		return from, nil
+	}
+	if !from.CanTransitionTo(to) {
+		return from, &ConnectorTransitionError{Connector: connectorName, From: from, To: to}
+	}
+
+	switch {
+	case to == ConnectorStatePaused:
+		err = cm.PauseConnector(ctx, environmentID, clusterID, connectorName)
+	case to == ConnectorStateStopped:
+		err = cm.StopConnector(ctx, environmentID, clusterID, connectorName)
+	case from == ConnectorStateFailed:
+		_, err = cm.RestartConnectorWithOptions(ctx, environmentID, clusterID, connectorName, RestartOptions{IncludeTasks: true, OnlyFailed: true})
+	default:
+		err = cm.ResumeConnector(ctx, environmentID, clusterID, connectorName)
+	}
+	return from, err
+}
+
+// WaitOptions configures WaitForConnectorRunning.
+type WaitOptions struct {
+	// PollInterval controls how often status is polled (optional, defaults to 5 seconds)
+	PollInterval time.Duration
+	// Timeout bounds the wait (optional, defaults to 5 minutes)
+	Timeout time.Duration
+	// Clock is used to wait between polls (optional, defaults to clock.Real)
+	Clock clock.Clock
+}
+
+// WaitForConnectorRunning blocks until a connector and all of its tasks, of which there
+// must be at least one, report RUNNING, and returns the final status. It returns early
+// as soon as the connector or a task fails, so a deploy pipeline can fail fast with the
+// task's stack trace.
+// Returns errors:
+//   - *ConnectorHealthError wrapping ErrConnectorFailed if the connector or a task failed
+//   - *ConnectorHealthError wrapping context.DeadlineExceeded if opts.Timeout elapsed
+//   - *api.Error if reading the connector status fails, e.g. IsNotFound()
+func (cm *ConnectorManager) WaitForConnectorRunning(ctx context.Context, environmentID string, clusterID string, connectorName string, opts WaitOptions) (*api.ConnectorStatus, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 5 * time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Minute
+	}
+	clk := clock.OrReal(opts.Clock)
+	deadline := clk.After(opts.Timeout)
+
+	for {
+		status, err := cm.GetConnectorStatus(ctx, environmentID, clusterID, connectorName)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case status.State == ConnectorStateFailed || hasFailedTask(status):
+			return status, &ConnectorHealthError{Connector: connectorName, Status: status, Err: ErrConnectorFailed}
+		case connectorSettled(status, ConnectorStateRunning) && len(status.Tasks) > 0:
+			return status, nil
+		}
+
+		select {
+		case <-clk.After(opts.PollInterval):
+		case <-deadline:
+			return status, &ConnectorHealthError{Connector: connectorName, Status: status, Err: context.DeadlineExceeded}
+		case <-ctx.Done():
+			return status, &ConnectorHealthError{Connector: connectorName, Status: status, Err: ctx.Err()}
+		}
+	}
+}
+
+func hasFailedTask(status *api.ConnectorStatus) bool {
+	for _, task := range status.Tasks {
+		if task.State == ConnectorStateFailed {
+			return true
+		}
+	}
+	return false
+}
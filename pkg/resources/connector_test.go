@@ -0,0 +1,371 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+const connectorPath = "/connect/v1/environments/env-123/clusters/lkc-123/connectors/my-connector"
+
+func TestConnectorManager_AlterConnectorOffsets(t *testing.T) {
+	var body struct {
+		Type    string                `json:"type"`
+		Offsets []api.ConnectorOffset `json:"offsets"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != connectorPath+"/offsets/request" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":"lcc-1","name":"my-connector","type":"PATCH","requested_at":"2024-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+
+	offsets := []api.ConnectorOffset{{
+		Partition: map[string]interface{}{"kafka_topic": "orders", "kafka_partition": 0},
+		Offset:    map[string]interface{}{"kafka_offset": 42},
+	}}
+	request, err := mgr.AlterConnectorOffsets(context.Background(), "env-123", "lkc-123", "my-connector", offsets)
+	if err != nil {
+		t.Fatalf("AlterConnectorOffsets failed: %v", err)
+	}
+	if request.Type != "PATCH" {
+		t.Errorf("Unexpected request: %+v", request)
+	}
+	if body.Type != "PATCH" || len(body.Offsets) != 1 || body.Offsets[0].Offset["kafka_offset"] != float64(42) {
+		t.Errorf("Unexpected body: %+v", body)
+	}
+}
+
+func TestConnectorManager_ResetConnectorOffsets(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case connectorPath + "/offsets/request":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"name":"my-connector","type":"DELETE"}`))
+		case connectorPath + "/offsets/request/status":
+			_, _ = w.Write([]byte(`{"request":{"name":"my-connector","type":"DELETE"},"status":{"phase":"APPLIED"},
+				"previous_offsets":[{"partition":{"kafka_topic":"orders"},"offset":{"kafka_offset":7}}]}`))
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+
+	if _, err := mgr.ResetConnectorOffsets(context.Background(), "env-123", "lkc-123", "my-connector"); err != nil {
+		t.Fatalf("ResetConnectorOffsets failed: %v", err)
+	}
+	if body["type"] != "DELETE" || body["offsets"] != nil {
+		t.Errorf("Unexpected body: %v", body)
+	}
+
+	status, err := mgr.GetConnectorOffsetRequestStatus(context.Background(), "env-123", "lkc-123", "my-connector")
+	if err != nil {
+		t.Fatalf("GetConnectorOffsetRequestStatus failed: %v", err)
+	}
+	if status.Status.Phase != resources.OffsetRequestApplied || len(status.PreviousOffsets) != 1 {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+}
+
+func TestConnectorManager_TransitionConnector(t *testing.T) {
+	state := resources.ConnectorStateFailed
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == connectorPath+"/status" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"state":"` + state + `"}`))
+			return
+		}
+		calls = append(calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, connectorPath))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+	ctx := context.Background()
+
+	from, err := mgr.TransitionConnector(ctx, "env-123", "lkc-123", "my-connector", resources.ConnectorStateRunning)
+	if err != nil || from != resources.ConnectorStateFailed {
+		t.Fatalf("TransitionConnector from FAILED failed: %v (from %s)", err, from)
+	}
+
+	state = resources.ConnectorStateRunning
+	if _, err := mgr.TransitionConnector(ctx, "env-123", "lkc-123", "my-connector", resources.ConnectorStateStopped); err != nil {
+		t.Fatalf("TransitionConnector to STOPPED failed: %v", err)
+	}
+	if _, err := mgr.TransitionConnector(ctx, "env-123", "lkc-123", "my-connector", resources.ConnectorStateRunning); err != nil {
+		t.Fatalf("TransitionConnector to current state failed: %v", err)
+	}
+
+	expected := []string{"POST /restart", "PUT /stop"}
+	if len(calls) != len(expected) || calls[0] != expected[0] || calls[1] != expected[1] {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+
+	state = resources.ConnectorStateProvisioning
+	_, err = mgr.TransitionConnector(ctx, "env-123", "lkc-123", "my-connector", resources.ConnectorStatePaused)
+	if !resources.IsConnectorTransitionError(err) {
+		t.Errorf("Expected ConnectorTransitionError, got %v", err)
+	}
+}
+
+func TestConnectorManager_RestartConnectorWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != connectorPath+"/restart" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("includeTasks") != "true" || q.Get("onlyFailed") != "true" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"name":"my-connector","connector":{"state":"RUNNING","worker_id":"w1"},
+			"tasks":[{"id":0,"state":"RUNNING","worker_id":"w1"},{"id":1,"state":"RESTARTING","worker_id":"w2"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+
+	result, err := mgr.RestartConnectorWithOptions(context.Background(), "env-123", "lkc-123", "my-connector",
+		resources.RestartOptions{IncludeTasks: true, OnlyFailed: true})
+	if err != nil {
+		t.Fatalf("RestartConnectorWithOptions failed: %v", err)
+	}
+	if result.ConnectorRestarted || len(result.RestartedTasks) != 1 || result.RestartedTasks[0] != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if result.Status == nil || len(result.Status.Tasks) != 2 || result.Status.Tasks[1].Worker != "w2" {
+		t.Errorf("Unexpected status: %+v", result.Status)
+	}
+}
+
+func TestConnectorManager_WaitForConnectorRunning(t *testing.T) {
+	statuses := []string{
+		`{"state":"PROVISIONING","tasks":[]}`,
+		`{"state":"RUNNING","tasks":[{"id":0,"state":"RUNNING"},{"id":1,"state":"UNASSIGNED"}]}`,
+		`{"state":"RUNNING","tasks":[{"id":0,"state":"RUNNING"},{"id":1,"state":"RUNNING"}]}`,
+	}
+	var polls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(statuses[polls]))
+		polls++
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+
+	status, err := mgr.WaitForConnectorRunning(context.Background(), "env-123", "lkc-123", "my-connector",
+		resources.WaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("WaitForConnectorRunning failed: %v", err)
+	}
+	if polls != 3 || len(status.Tasks) != 2 {
+		t.Errorf("Expected to stop after 3 polls with 2 tasks, got %d polls and %+v", polls, status)
+	}
+}
+
+func TestConnectorManager_WaitForConnectorRunning_TaskFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"state":"RUNNING","tasks":[{"id":0,"state":"RUNNING"},
+			{"id":1,"state":"FAILED","error":"org.apache.kafka.connect.errors.ConnectException: bad credentials"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+
+	_, err := mgr.WaitForConnectorRunning(context.Background(), "env-123", "lkc-123", "my-connector", resources.WaitOptions{})
+	if !errors.Is(err, resources.ErrConnectorFailed) {
+		t.Fatalf("Expected ErrConnectorFailed, got %v", err)
+	}
+	var healthErr *resources.ConnectorHealthError
+	if !errors.As(err, &healthErr) || healthErr.Status == nil {
+		t.Fatalf("Expected ConnectorHealthError with status, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "task 1 failed: org.apache.kafka.connect.errors.ConnectException: bad credentials") {
+		t.Errorf("Expected task trace in error, got %v", err)
+	}
+}
+
+func TestConnectorManager_WaitForConnectorRunning_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"state":"PROVISIONING"}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+
+	_, err := mgr.WaitForConnectorRunning(context.Background(), "env-123", "lkc-123", "my-connector",
+		resources.WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestConnectorManager_GetConnectorTopics(t *testing.T) {
+	var reset bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == connectorPath+"/topics":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"my-connector":{"topics":["orders","payments"]}}`))
+		case r.Method == "PUT" && r.URL.Path == connectorPath+"/topics/reset":
+			reset = true
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConnectorManager(c)
+
+	topics, err := mgr.GetConnectorTopics(context.Background(), "env-123", "lkc-123", "my-connector")
+	if err != nil {
+		t.Fatalf("GetConnectorTopics failed: %v", err)
+	}
+	if len(topics) != 2 || topics[0] != "orders" {
+		t.Errorf("Unexpected topics: %v", topics)
+	}
+
+	if err := mgr.ResetConnectorActiveTopics(context.Background(), "env-123", "lkc-123", "my-connector"); err != nil {
+		t.Fatalf("ResetConnectorActiveTopics failed: %v", err)
+	}
+	if !reset {
+		t.Error("Expected reset request")
+	}
+}
+
+func TestConnectorManager_ListConnectorsExpanded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query()["expand"] == nil || strings.Join(r.URL.Query()["expand"], ",") != "status,info" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"sink-b": {
+				"status": {"connector": {"state": "RUNNING"}, "tasks": [
+					{"id": 0, "state": "RUNNING", "worker_id": "w1"},
+					{"id": 1, "state": "FAILED", "worker_id": "w2", "trace": "boom"}]},
+				"info": {"config": {"connector.class": "S3_SINK"}, "tasks": [{"task": 0}, {"task": 1}], "type": "sink"}
+			},
+			"source-a": {
+				"status": {"connector": {"state": "PROVISIONING"}, "tasks": []},
+				"info": {"config": {"connector.class": "DatagenSource"}, "tasks": [], "type": "source"}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	cm := resources.NewConnectorManager(newTestClient(t, server.URL))
+	connectors, err := cm.ListConnectorsExpanded(context.Background(), "env-123", "lkc-123")
+	if err != nil {
+		t.Fatalf("ListConnectorsExpanded failed: %v", err)
+	}
+	if len(connectors) != 2 || connectors[0].Name != "sink-b" || connectors[1].Name != "source-a" {
+		t.Fatalf("Unexpected connectors: %+v", connectors)
+	}
+	sink := connectors[0]
+	if sink.Type != "SINK" || sink.Tasks != 2 || sink.Status.State != resources.ConnectorStateRunning || sink.Config["connector.class"] != "S3_SINK" {
+		t.Errorf("Unexpected sink: %+v", sink)
+	}
+	if len(sink.Status.Tasks) != 2 || sink.Status.Tasks[1].Error != "boom" || sink.Status.Tasks[1].Worker != "w2" {
+		t.Errorf("Unexpected sink tasks: %+v", sink.Status.Tasks)
+	}
+	if connectors[1].Type != "SOURCE" || connectors[1].Tasks != 0 {
+		t.Errorf("Unexpected source: %+v", connectors[1])
+	}
+}
+
+const validationJSON = `{"name":"PostgresSource","error_count":2,"configs":[
+	{"definition":{"name":"connection.host","required":true},"value":{"name":"connection.host","value":"db","errors":[]}},
+	{"definition":{"name":"connection.password","required":true},"value":{"name":"connection.password","value":"","errors":["Missing required configuration \"connection.password\" which has no default value."]}},
+	{"definition":{"name":"mode","required":false},"value":{"name":"mode","value":"sometimes","errors":["Invalid value sometimes"]}}]}`
+
+func TestConnectorManager_CreateConnector_WithValidation(t *testing.T) {
+	var created bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /connect/v1/environments/env-123/clusters/lkc-123/connector-plugins/PostgresSource/config/validate":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["connector.class"] != "PostgresSource" || body["name"] != "my-connector" {
+				t.Errorf("Unexpected validation body: %v", body)
+			}
+			_, _ = w.Write([]byte(validationJSON))
+		case "POST /connect/v1/environments/env-123/clusters/lkc-123/connectors":
+			created = true
+			_, _ = w.Write([]byte(`{"name":"my-connector"}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cm := resources.NewConnectorManager(newTestClient(t, server.URL)).WithValidation(true)
+	_, err := cm.CreateConnector(context.Background(), "env-123", "lkc-123", "my-connector",
+		map[string]string{"connector.class": "PostgresSource", "connection.host": "db", "mode": "sometimes"})
+
+	var validationErr *resources.ConnectorValidationError
+	if !errors.As(err, &validationErr) || !errors.Is(err, resources.ErrInvalidConnectorConfig) {
+		t.Fatalf("Expected ConnectorValidationError, got %v", err)
+	}
+	if created {
+		t.Error("Expected connector not to be created")
+	}
+	if !strings.Contains(err.Error(), "mode: Invalid value sometimes") {
+		t.Errorf("Expected flattened errors in message, got %q", err.Error())
+	}
+	v := validationErr.Validation
+	if v.IsValid() || len(v.ErrorsByConfig()) != 2 || v.ErrorsByConfig()["mode"][0] != "Invalid value sometimes" {
+		t.Errorf("Unexpected validation helpers: valid=%v errors=%v", v.IsValid(), v.ErrorsByConfig())
+	}
+	if missing := v.MissingRequired(); len(missing) != 1 || missing[0] != "connection.password" {
+		t.Errorf("Expected connection.password missing, got %v", missing)
+	}
+}
+
+func TestConnectorManager_CreateConnector_WithValidationMissingClass(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	cm := resources.NewConnectorManager(newTestClient(t, server.URL)).WithValidation(true)
+	_, err := cm.CreateConnector(context.Background(), "env-123", "lkc-123", "my-connector",
+		map[string]string{"connection.host": "db"})
+	if !errors.Is(err, resources.ErrInvalidConnectorConfig) || !strings.Contains(err.Error(), "connector.class is required") {
+		t.Fatalf("Expected missing connector.class error, got %v", err)
+	}
+}
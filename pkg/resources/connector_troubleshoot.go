@@ -0,0 +1,156 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/creiche/confluent-go/pkg/api"
+)
+
+// ConnectorConfigDLQTopic is the config key of a sink connector's dead letter queue topic.
+const ConnectorConfigDLQTopic = "errors.deadletterqueue.topic.name"
+
+// ConnectorConfigErrorsTolerance is the config key that controls whether failed records
+// are skipped ("all") or fail the task ("none").
+const ConnectorConfigErrorsTolerance = "errors.tolerance"
+
+// ErrNoDLQ is returned when a connector has no dead letter queue topic configured.
+var ErrNoDLQ = errors.New("connector has no dead letter queue topic")
+
+// javaClassPattern matches a fully qualified Java class name.
+var javaClassPattern = regexp.MustCompile(`^[A-Za-z_$][\w$]*(\.[A-Za-z_$][\w$]*)+$`)
+
+// ParseTaskTrace parses a task's Java stack trace into its exception chain.
+// It returns nil if trace does not start with an exception, e.g. for plain messages.
+func ParseTaskTrace(trace string) *api.TaskTrace {
+	lines := strings.Split(strings.ReplaceAll(trace, "\r\n", "\n"), "\n")
+	exception, message, ok := parseExceptionLine(lines[0])
+	if !ok {
+		return nil
+	}
+
+	t := &api.TaskTrace{Exception: exception, Message: message}
+	frames := &t.Frames
+	inFrames := false
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "at "):
+			*frames = append(*frames, strings.TrimPrefix(trimmed, "at "))
+			inFrames = true
+		case strings.HasPrefix(trimmed, "Caused by: "):
+			exception, message, _ := parseExceptionLine(strings.TrimPrefix(trimmed, "Caused by: "))
+			t.Causes = append(t.Causes, api.TaskTraceCause{Exception: exception, Message: message})
+			frames = &t.Causes[len(t.Causes)-1].Frames
+			inFrames = false
+		case trimmed == "" || strings.HasPrefix(trimmed, "..."):
+		case !inFrames:
+			// Continuation of a multi-line exception message
+			if len(t.Causes) == 0 {
+				t.Message += "\n" + line
+			} else {
+				t.Causes[len(t.Causes)-1].Message += "\n" + line
+			}
+		}
+	}
+	return t
+}
+
+// parseExceptionLine splits "java.lang.Exception: message" into class and message.
+func parseExceptionLine(line string) (exception string, message string, ok bool) {
+	line = strings.TrimSpace(line)
+	exception, message, _ = strings.Cut(line, ": ")
+	return exception, message, javaClassPattern.MatchString(exception)
+}
+
+// withTaskTraces sets the Trace of every task with an error.
+func withTaskTraces(tasks []api.TaskStatus) {
+	for i := range tasks {
+		if tasks[i].Error != "" {
+			tasks[i].Trace = ParseTaskTrace(tasks[i].Error)
+		}
+	}
+}
+
+// GetConnectorDLQTopic returns the dead letter queue topic of a sink connector, from its
+// ConnectorConfigDLQTopic config.
+// Returns errors:
+//   - ErrNoDLQ if the connector has no dead letter queue topic configured
+//   - *api.Error with IsNotFound() if connector does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cm *ConnectorManager) GetConnectorDLQTopic(ctx context.Context, environmentID string, clusterID string, connectorName string) (string, error) {
+	config, err := cm.GetConnectorConfig(ctx, environmentID, clusterID, connectorName)
+	if err != nil {
+		return "", err
+	}
+	topic := config[ConnectorConfigDLQTopic]
+	if topic == "" {
+		return "", fmt.Errorf("failed to get dead letter queue of connector %s: %w", connectorName, ErrNoDLQ)
+	}
+	return topic, nil
+}
+
+// DLQSample is a sample of the records a connector sent to its dead letter queue.
+type DLQSample struct {
+	Connector string
+	Topic     string
+	// Tolerance is the connector's errors.tolerance config; records only reach the dead
+	// letter queue when it is "all"
+	Tolerance string
+	// Records are the latest records of each partition, ordered by partition then offset
+	Records []ConsumedRecord
+}
+
+// DLQInspector samples connector dead letter queues for troubleshooting.
+//
+// Example usage:
+//
+//	inspector := resources.NewDLQInspector(connectorManager, consumeManager)
+//	sample, err := inspector.SampleDLQ(ctx, "env-123", "lkc-abc", "orders-sink", 5)
+type DLQInspector struct {
+	connectors *ConnectorManager
+	consumer   *ConsumeManager
+}
+
+// NewDLQInspector creates an inspector that reads connector configs with connectors and
+// dead letter queue records with consumer, which must point at a REST Proxy.
+func NewDLQInspector(connectors *ConnectorManager, consumer *ConsumeManager) *DLQInspector {
+	return &DLQInspector{connectors: connectors, consumer: consumer}
+}
+
+// SampleDLQ reads the latest perPartition records (default 10) of every partition of a
+// connector's dead letter queue topic, without committing offsets.
+// Returns errors:
+//   - ErrNoDLQ if the connector has no dead letter queue topic configured
+//   - *api.Error with IsNotFound() if the connector or topic does not exist
+//   - *api.Error with IsForbidden() if user lacks read permission on the topic
+func (d *DLQInspector) SampleDLQ(ctx context.Context, environmentID string, clusterID string, connectorName string, perPartition int) (*DLQSample, error) {
+	config, err := d.connectors.GetConnectorConfig(ctx, environmentID, clusterID, connectorName)
+	if err != nil {
+		return nil, err
+	}
+	sample := &DLQSample{
+		Connector: connectorName,
+		Topic:     config[ConnectorConfigDLQTopic],
+		Tolerance: config[ConnectorConfigErrorsTolerance],
+	}
+	if sample.Topic == "" {
+		return nil, fmt.Errorf("failed to sample dead letter queue of connector %s: %w", connectorName, ErrNoDLQ)
+	}
+
+	partitions, err := d.consumer.listPartitions(ctx, sample.Topic)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range partitions {
+		records, err := d.consumer.PeekRecords(ctx, PeekOptions{Topic: sample.Topic, Partition: p, Count: perPartition})
+		if err != nil {
+			return nil, fmt.Errorf("failed to sample dead letter queue %s: %w", sample.Topic, err)
+		}
+		sample.Records = append(sample.Records, records...)
+	}
+	return sample, nil
+}
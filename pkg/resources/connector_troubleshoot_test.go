@@ -0,0 +1,109 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+const taskTrace = "org.apache.kafka.connect.errors.ConnectException: Tolerance exceeded in error handler\n" +
+	"\tat org.apache.kafka.connect.runtime.errors.RetryWithToleranceOperator.execAndHandleError(RetryWithToleranceOperator.java:206)\n" +
+	"\tat org.apache.kafka.connect.runtime.WorkerSinkTask.convertAndTransformRecord(WorkerSinkTask.java:495)\n" +
+	"Caused by: org.apache.kafka.connect.errors.DataException: Failed to deserialize data\n" +
+	"\tat io.confluent.connect.avro.AvroConverter.toConnectData(AvroConverter.java:148)\n" +
+	"\t... 13 more\n" +
+	"Caused by: org.apache.kafka.common.errors.SerializationException: Unknown magic byte!\n"
+
+func TestParseTaskTrace(t *testing.T) {
+	trace := resources.ParseTaskTrace(taskTrace)
+	if trace == nil {
+		t.Fatal("Expected trace to be parsed")
+	}
+	if trace.Exception != "org.apache.kafka.connect.errors.ConnectException" || trace.Message != "Tolerance exceeded in error handler" || len(trace.Frames) != 2 {
+		t.Errorf("Unexpected trace: %+v", trace)
+	}
+	if len(trace.Causes) != 2 || len(trace.Causes[0].Frames) != 1 || trace.Causes[0].Message != "Failed to deserialize data" {
+		t.Errorf("Unexpected causes: %+v", trace.Causes)
+	}
+	exception, message := trace.RootCause()
+	if exception != "org.apache.kafka.common.errors.SerializationException" || message != "Unknown magic byte!" {
+		t.Errorf("Unexpected root cause: %s: %s", exception, message)
+	}
+
+	if resources.ParseTaskTrace("Connector is being provisioned: please wait") != nil {
+		t.Error("Expected nil trace for a plain message")
+	}
+}
+
+func TestConnectorManager_GetConnectorStatus_ParsesTraces(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"state": "RUNNING",
+			"tasks": []map[string]interface{}{{"id": 0, "state": "RUNNING"}, {"id": 1, "state": "FAILED", "error": taskTrace}},
+		})
+	}))
+	defer server.Close()
+
+	cm := resources.NewConnectorManager(newTestClient(t, server.URL))
+	status, err := cm.GetConnectorStatus(context.Background(), "env-123", "lkc-123", "my-connector")
+	if err != nil {
+		t.Fatalf("GetConnectorStatus failed: %v", err)
+	}
+	if status.Tasks[0].Trace != nil || status.Tasks[1].Trace == nil || len(status.Tasks[1].Trace.Causes) != 2 {
+		t.Errorf("Unexpected task traces: %+v", status.Tasks)
+	}
+}
+
+func TestDLQInspector_SampleDLQ(t *testing.T) {
+	connect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case connectorPath + "/config":
+			_, _ = w.Write([]byte(`{"connector.class":"S3_SINK","errors.tolerance":"all","errors.deadletterqueue.topic.name":"dlq-orders"}`))
+		case "/connect/v1/environments/env-123/clusters/lkc-123/connectors/no-dlq/config":
+			_, _ = w.Write([]byte(`{"connector.class":"S3_SINK"}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer connect.Close()
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/topics/dlq-orders/partitions":
+			_, _ = w.Write([]byte(`[{"partition":0}]`))
+		case r.URL.Path == "/topics/dlq-orders/partitions/0/offsets":
+			_, _ = w.Write([]byte(`{"beginning_offset":0,"end_offset":2}`))
+		case r.Method == "POST" && strings.Count(r.URL.Path, "/") == 2:
+			_, _ = w.Write([]byte(`{"instance_id":"c1"}`))
+		case strings.HasSuffix(r.URL.Path, "/records"):
+			_, _ = w.Write([]byte(`[{"topic":"dlq-orders","partition":0,"offset":0,"value":"YQ=="},{"topic":"dlq-orders","partition":0,"offset":1,"value":"Yg=="}]`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer proxy.Close()
+
+	inspector := resources.NewDLQInspector(
+		resources.NewConnectorManager(newTestClient(t, connect.URL)),
+		resources.NewConsumeManager(newTestClient(t, proxy.URL)),
+	)
+	sample, err := inspector.SampleDLQ(context.Background(), "env-123", "lkc-123", "my-connector", 2)
+	if err != nil {
+		t.Fatalf("SampleDLQ failed: %v", err)
+	}
+	if sample.Topic != "dlq-orders" || sample.Tolerance != "all" || len(sample.Records) != 2 || sample.Records[1].Offset != 1 {
+		t.Errorf("Unexpected sample: %+v", sample)
+	}
+
+	if _, err := inspector.SampleDLQ(context.Background(), "env-123", "lkc-123", "no-dlq", 2); !errors.Is(err, resources.ErrNoDLQ) {
+		t.Errorf("Expected ErrNoDLQ, got %v", err)
+	}
+}
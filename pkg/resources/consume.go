@@ -0,0 +1,318 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Embedded formats for records read with PeekRecords.
+const (
+	ConsumeFormatBinary = "binary"
+	ConsumeFormatJSON   = "json"
+)
+
+// restProxyContentType is the media type of REST Proxy v2 requests.
+const restProxyContentType = "application/vnd.kafka.v2+json"
+
+// ConsumedRecord is a record read through the REST Proxy. With ConsumeFormatBinary,
+// Key and Value hold base64-encoded JSON strings; with ConsumeFormatJSON they hold
+// the JSON documents. Key is null for records without a key.
+type ConsumedRecord struct {
+	Topic     string          `json:"topic"`
+	Partition int32           `json:"partition"`
+	Offset    int64           `json:"offset"`
+	Key       json.RawMessage `json:"key"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// PeekOptions selects the records read by PeekRecords.
+type PeekOptions struct {
+	Topic     string
+	Partition int32
+	// Count is the number of most recent records to read (optional, defaults to 10)
+	Count int
+	// Format is ConsumeFormatBinary or ConsumeFormatJSON (optional, defaults to binary)
+	Format string
+	// Group is the consumer group of the temporary consumer (optional). Offsets are
+	// never committed, so the group is not advanced.
+	Group string
+	// MaxEmptyPolls stops reading after this many consecutive empty fetches
+	// (optional, defaults to 3)
+	MaxEmptyPolls int
+}
+
+// ConsumeManager reads records through the Confluent REST Proxy v2 consumer API,
+// for debugging and validation workflows. The client must point at a REST Proxy,
+// e.g. Confluent Platform's kafka-rest; Confluent Cloud's Kafka REST v3 API has no
+// consume endpoint.
+type ConsumeManager struct {
+	client client.Doer
+}
+
+// NewConsumeManager creates a new consume manager.
+func NewConsumeManager(c client.Doer) *ConsumeManager {
+	return &ConsumeManager{client: c}
+}
+
+// PeekRecords reads the latest opts.Count records of a partition, oldest first, without
+// committing offsets. It creates a temporary consumer instance, assigns it the partition,
+// seeks to Count records before the end offset, fetches, and deletes the instance.
+// Fewer records are returned when the partition holds fewer, or when fetches come back
+// empty MaxEmptyPolls times in a row.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the topic or partition does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks read permission on the topic
+func (cm *ConsumeManager) PeekRecords(ctx context.Context, opts PeekOptions) ([]ConsumedRecord, error) {
+	if opts.Count <= 0 {
+		opts.Count = 10
+	}
+	if opts.Format == "" {
+		opts.Format = ConsumeFormatBinary
+	}
+	if opts.Group == "" {
+		opts.Group = fmt.Sprintf("confluent-go-peek-%d", time.Now().UnixNano())
+	}
+	if opts.MaxEmptyPolls <= 0 {
+		opts.MaxEmptyPolls = 3
+	}
+
+	begin, end, err := cm.partitionOffsets(ctx, opts.Topic, opts.Partition)
+	if err != nil {
+		return nil, err
+	}
+	start := end - int64(opts.Count)
+	if start < begin {
+		start = begin
+	}
+	if start >= end {
+		return nil, nil
+	}
+
+	var records []ConsumedRecord
+	err = cm.withConsumer(ctx, opts.Group, opts.Format, func(instance string) error {
+		partitions := []map[string]interface{}{{"topic": opts.Topic, "partition": opts.Partition}}
+		if err := cm.post(ctx, instance+"/assignments", map[string]interface{}{"partitions": partitions}); err != nil {
+			return fmt.Errorf("failed to assign partition %d of %s: %w", opts.Partition, opts.Topic, err)
+		}
+		partitions[0]["offset"] = start
+		if err := cm.post(ctx, instance+"/positions", map[string]interface{}{"offsets": partitions}); err != nil {
+			return fmt.Errorf("failed to seek to offset %d: %w", start, err)
+		}
+
+		accept := fmt.Sprintf("application/vnd.kafka.%s.v2+json", opts.Format)
+		for empty := 0; empty < opts.MaxEmptyPolls; {
+			req := client.Request{
+				Method:  "GET",
+				Path:    instance + "/records",
+				Headers: map[string]string{"Accept": accept},
+			}
+			resp, err := cm.client.Do(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to fetch records: %w", err)
+			}
+			var batch []ConsumedRecord
+			if err := resp.DecodeJSON(&batch); err != nil {
+				return fmt.Errorf("failed to parse records response: %w", err)
+			}
+			if len(batch) == 0 {
+				empty++
+				continue
+			}
+			empty = 0
+			for _, r := range batch {
+				if r.Offset < end {
+					records = append(records, r)
+				}
+			}
+			if batch[len(batch)-1].Offset >= end-1 {
+				break
+			}
+		}
+		return nil
+	})
+
+	return records, err
+}
+
+// Offset reset targets for ResetConsumerGroupOffsets.
+const (
+	OffsetResetEarliest = "earliest"
+	OffsetResetLatest   = "latest"
+)
+
+// PartitionOffset is a committed offset of a consumer group on a topic partition.
+type PartitionOffset struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+}
+
+// AlterConsumerGroupOffsets commits the given offsets for a consumer group, e.g. to
+// skip a poison record or replay from a known offset. The group must have no active
+// members; Kafka rejects commits from outside a live group's generation.
+// This requires a REST Proxy and so only works on Confluent Platform: Kafka REST v3,
+// the only Kafka REST API on Confluent Cloud, has no endpoint to change committed
+// offsets. On Confluent Cloud, use the Kafka admin client instead.
+// Resetting to a timestamp is not offered: neither REST API exposes Kafka's
+// offsets-for-times lookup, so resolve the offset out of band and commit it here.
+// Returns errors:
+//   - *api.Error with IsNotFound() if a topic or partition does not exist
+//   - *api.Error with IsConflict() or IsBadRequest() if the group has active members
+//   - *api.Error with IsForbidden() if user lacks permission on the group or topics
+func (cm *ConsumeManager) AlterConsumerGroupOffsets(ctx context.Context, groupID string, offsets []PartitionOffset) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+	partitions := make([]map[string]interface{}, len(offsets))
+	for i, o := range offsets {
+		partitions[i] = map[string]interface{}{"topic": o.Topic, "partition": o.Partition}
+	}
+
+	return cm.withConsumer(ctx, groupID, ConsumeFormatBinary, func(instance string) error {
+		if err := cm.post(ctx, instance+"/assignments", map[string]interface{}{"partitions": partitions}); err != nil {
+			return fmt.Errorf("failed to assign partitions to group %s: %w", groupID, err)
+		}
+		if err := cm.post(ctx, instance+"/offsets", map[string]interface{}{"offsets": offsets}); err != nil {
+			return fmt.Errorf("failed to commit offsets for group %s: %w", groupID, err)
+		}
+		return nil
+	})
+}
+
+// ResetConsumerGroupOffsets moves a consumer group to the earliest or latest offset
+// (OffsetResetEarliest or OffsetResetLatest) of the given partitions of a topic, or of
+// every partition when partitions is empty, and returns the offsets committed.
+// The same restrictions as AlterConsumerGroupOffsets apply.
+func (cm *ConsumeManager) ResetConsumerGroupOffsets(ctx context.Context, groupID string, topic string, partitions []int32, to string) ([]PartitionOffset, error) {
+	if to != OffsetResetEarliest && to != OffsetResetLatest {
+		return nil, fmt.Errorf("invalid offset reset target %q: must be %s or %s", to, OffsetResetEarliest, OffsetResetLatest)
+	}
+	if len(partitions) == 0 {
+		var err error
+		if partitions, err = cm.listPartitions(ctx, topic); err != nil {
+			return nil, err
+		}
+	}
+
+	offsets := make([]PartitionOffset, len(partitions))
+	for i, p := range partitions {
+		begin, end, err := cm.partitionOffsets(ctx, topic, p)
+		if err != nil {
+			return nil, err
+		}
+		offsets[i] = PartitionOffset{Topic: topic, Partition: p, Offset: begin}
+		if to == OffsetResetLatest {
+			offsets[i].Offset = end
+		}
+	}
+
+	if err := cm.AlterConsumerGroupOffsets(ctx, groupID, offsets); err != nil {
+		return nil, err
+	}
+	return offsets, nil
+}
+
+// listPartitions returns the partition IDs of a topic.
+func (cm *ConsumeManager) listPartitions(ctx context.Context, topic string) ([]int32, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/topics/%s/partitions", url.PathEscape(topic)),
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of %s: %w", topic, err)
+	}
+
+	var result []struct {
+		Partition int32 `json:"partition"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse partition list response: %w", err)
+	}
+
+	partitions := make([]int32, len(result))
+	for i, p := range result {
+		partitions[i] = p.Partition
+	}
+	return partitions, nil
+}
+
+// withConsumer creates a temporary consumer instance in group, calls fn with its
+// path, and deletes the instance afterwards.
+func (cm *ConsumeManager) withConsumer(ctx context.Context, group string, format string, fn func(instance string) error) (err error) {
+	instance, err := cm.createConsumer(ctx, group, format)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Delete the instance even if ctx was cancelled, so it does not linger on the proxy
+		req := client.Request{Method: "DELETE", Path: instance, ContentType: restProxyContentType}
+		if _, delErr := cm.client.Do(context.WithoutCancel(ctx), req); delErr != nil && err == nil {
+			err = fmt.Errorf("failed to delete consumer instance: %w", delErr)
+		}
+	}()
+	return fn(instance)
+}
+
+// partitionOffsets returns the beginning and end offsets of a partition.
+func (cm *ConsumeManager) partitionOffsets(ctx context.Context, topic string, partition int32) (int64, int64, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/topics/%s/partitions/%d/offsets", url.PathEscape(topic), partition),
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get offsets of %s partition %d: %w", topic, partition, err)
+	}
+
+	var offsets struct {
+		BeginningOffset int64 `json:"beginning_offset"`
+		EndOffset       int64 `json:"end_offset"`
+	}
+	if err := resp.DecodeJSON(&offsets); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse partition offsets response: %w", err)
+	}
+	return offsets.BeginningOffset, offsets.EndOffset, nil
+}
+
+// createConsumer creates a consumer instance and returns its path.
+func (cm *ConsumeManager) createConsumer(ctx context.Context, group string, format string) (string, error) {
+	body := map[string]interface{}{
+		"format":             format,
+		"auto.offset.reset":  "earliest",
+		"auto.commit.enable": "false",
+	}
+	req := client.Request{
+		Method:      "POST",
+		Path:        fmt.Sprintf("/consumers/%s", url.PathEscape(group)),
+		Body:        body,
+		ContentType: restProxyContentType,
+	}
+
+	resp, err := cm.client.Do(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to create consumer in group %s: %w", group, err)
+	}
+
+	var instance struct {
+		InstanceID string `json:"instance_id"`
+	}
+	if err := resp.DecodeJSON(&instance); err != nil {
+		return "", fmt.Errorf("failed to parse create consumer response: %w", err)
+	}
+	return fmt.Sprintf("/consumers/%s/instances/%s", url.PathEscape(group), url.PathEscape(instance.InstanceID)), nil
+}
+
+func (cm *ConsumeManager) post(ctx context.Context, path string, body interface{}) error {
+	req := client.Request{Method: "POST", Path: path, Body: body, ContentType: restProxyContentType}
+	_, err := cm.client.Do(ctx, req)
+	return err
+}
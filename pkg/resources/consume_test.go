@@ -0,0 +1,121 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestConsumeManager_PeekRecords(t *testing.T) {
+	var seekOffset float64
+	var deleted bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /topics/orders/partitions/0/offsets":
+			_ = json.NewEncoder(w).Encode(map[string]int64{"beginning_offset": 0, "end_offset": 10})
+		case "POST /consumers/debug":
+			if r.Header.Get("Content-Type") != "application/vnd.kafka.v2+json" {
+				t.Errorf("Unexpected content type %s", r.Header.Get("Content-Type"))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"instance_id": "c1"})
+		case "POST /consumers/debug/instances/c1/assignments":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /consumers/debug/instances/c1/positions":
+			var body struct {
+				Offsets []map[string]interface{} `json:"offsets"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			seekOffset = body.Offsets[0]["offset"].(float64)
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /consumers/debug/instances/c1/records":
+			if r.Header.Get("Accept") != "application/vnd.kafka.json.v2+json" {
+				t.Errorf("Unexpected accept %s", r.Header.Get("Accept"))
+			}
+			_, _ = w.Write([]byte(`[{"topic":"orders","partition":0,"offset":7,"key":null,"value":{"id":7}},
+				{"topic":"orders","partition":0,"offset":8,"key":null,"value":{"id":8}},
+				{"topic":"orders","partition":0,"offset":9,"key":"k","value":{"id":9}}]`))
+		case "DELETE /consumers/debug/instances/c1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConsumeManager(c)
+
+	records, err := mgr.PeekRecords(context.Background(), resources.PeekOptions{
+		Topic:  "orders",
+		Count:  3,
+		Format: resources.ConsumeFormatJSON,
+		Group:  "debug",
+	})
+	if err != nil {
+		t.Fatalf("PeekRecords failed: %v", err)
+	}
+	if seekOffset != 7 {
+		t.Errorf("Expected seek to offset 7, got %v", seekOffset)
+	}
+	if len(records) != 3 || records[2].Offset != 9 || string(records[2].Value) != `{"id":9}` {
+		t.Errorf("Unexpected records: %#v", records)
+	}
+	if !deleted {
+		t.Error("Expected consumer instance to be deleted")
+	}
+}
+
+func TestConsumeManager_ResetConsumerGroupOffsets(t *testing.T) {
+	var committed []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /topics/orders/partitions":
+			_, _ = w.Write([]byte(`[{"partition":0},{"partition":1}]`))
+		case "GET /topics/orders/partitions/0/offsets":
+			_ = json.NewEncoder(w).Encode(map[string]int64{"beginning_offset": 5, "end_offset": 50})
+		case "GET /topics/orders/partitions/1/offsets":
+			_ = json.NewEncoder(w).Encode(map[string]int64{"beginning_offset": 0, "end_offset": 70})
+		case "POST /consumers/billing":
+			_ = json.NewEncoder(w).Encode(map[string]string{"instance_id": "reset"})
+		case "POST /consumers/billing/instances/reset/assignments", "DELETE /consumers/billing/instances/reset":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /consumers/billing/instances/reset/offsets":
+			var body struct {
+				Offsets []map[string]interface{} `json:"offsets"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			committed = body.Offsets
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConsumeManager(c)
+
+	offsets, err := mgr.ResetConsumerGroupOffsets(context.Background(), "billing", "orders", nil, resources.OffsetResetEarliest)
+	if err != nil {
+		t.Fatalf("ResetConsumerGroupOffsets failed: %v", err)
+	}
+	if len(offsets) != 2 || offsets[0].Offset != 5 || offsets[1].Offset != 0 {
+		t.Errorf("Unexpected offsets: %#v", offsets)
+	}
+	if len(committed) != 2 || committed[0]["offset"] != float64(5) || committed[1]["partition"] != float64(1) {
+		t.Errorf("Unexpected committed offsets: %v", committed)
+	}
+
+	if _, err := mgr.ResetConsumerGroupOffsets(context.Background(), "billing", "orders", nil, "yesterday"); err == nil {
+		t.Error("Expected error for invalid reset target")
+	}
+}
@@ -0,0 +1,184 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Consumer group states.
+const (
+	ConsumerGroupStateStable              = "STABLE"
+	ConsumerGroupStatePreparingRebalance  = "PREPARING_REBALANCE"
+	ConsumerGroupStateCompletingRebalance = "COMPLETING_REBALANCE"
+	ConsumerGroupStateEmpty               = "EMPTY"
+	ConsumerGroupStateDead                = "DEAD"
+	ConsumerGroupStateUnknown             = "UNKNOWN"
+)
+
+// ConsumerGroupManager handles consumer group observability via the Kafka REST v3 API.
+// Kafka REST v3 cannot change committed offsets; see ConsumeManager.AlterConsumerGroupOffsets
+// for Confluent Platform.
+type ConsumerGroupManager struct {
+	client client.Doer
+}
+
+// NewConsumerGroupManager creates a new consumer group manager.
+func NewConsumerGroupManager(c client.Doer) *ConsumerGroupManager {
+	return &ConsumerGroupManager{client: c}
+}
+
+// ListConsumerGroups lists all consumer groups in a cluster.
+// Returns errors:
+//   - *api.Error with IsNotFound() if cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (cgm *ConsumerGroupManager) ListConsumerGroups(ctx context.Context, clusterID string) ([]api.ConsumerGroup, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   consumerGroupsPath(clusterID),
+	}
+
+	resp, err := cgm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumer groups: %w", err)
+	}
+
+	var result struct {
+		Data []api.ConsumerGroup `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse consumer group list response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// GetConsumerGroup retrieves a consumer group.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the consumer group does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (cgm *ConsumerGroupManager) GetConsumerGroup(ctx context.Context, clusterID string, groupID string) (*api.ConsumerGroup, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   consumerGroupPath(clusterID, groupID),
+	}
+
+	resp, err := cgm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe consumer group %s: %w", groupID, err)
+	}
+
+	var group api.ConsumerGroup
+	if err := resp.DecodeJSON(&group); err != nil {
+		return nil, fmt.Errorf("failed to parse consumer group response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// ListConsumers lists the members of a consumer group.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the consumer group does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cgm *ConsumerGroupManager) ListConsumers(ctx context.Context, clusterID string, groupID string) ([]api.Consumer, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   consumerGroupPath(clusterID, groupID) + "/consumers",
+	}
+
+	resp, err := cgm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumers of group %s: %w", groupID, err)
+	}
+
+	var result struct {
+		Data []api.Consumer `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse consumer list response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// ListConsumerLags lists the lag of a consumer group on every partition it has
+// committed offsets for. Confluent Cloud only reports lag for dedicated and
+// enterprise clusters.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the consumer group does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cgm *ConsumerGroupManager) ListConsumerLags(ctx context.Context, clusterID string, groupID string) ([]api.ConsumerLag, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   consumerGroupPath(clusterID, groupID) + "/lags",
+	}
+
+	resp, err := cgm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lags of consumer group %s: %w", groupID, err)
+	}
+
+	var result struct {
+		Data []api.ConsumerLag `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse consumer lag response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// GetConsumerGroupLagSummary returns the maximum and total lag of a consumer group.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the consumer group does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (cgm *ConsumerGroupManager) GetConsumerGroupLagSummary(ctx context.Context, clusterID string, groupID string) (*api.ConsumerGroupLagSummary, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   consumerGroupPath(clusterID, groupID) + "/lag-summary",
+	}
+
+	resp, err := cgm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lag summary of consumer group %s: %w", groupID, err)
+	}
+
+	var summary api.ConsumerGroupLagSummary
+	if err := resp.DecodeJSON(&summary); err != nil {
+		return nil, fmt.Errorf("failed to parse consumer group lag summary response: %w", err)
+	}
+
+	return &summary, nil
+}
+
+// DeleteConsumerGroup deletes a consumer group and its committed offsets.
+// The group must have no active members.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the consumer group does not exist
+//   - *api.Error with IsBadRequest() or IsConflict() if the group is not empty
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (cgm *ConsumerGroupManager) DeleteConsumerGroup(ctx context.Context, clusterID string, groupID string) error {
+	req := client.Request{
+		Method: "DELETE",
+		Path:   consumerGroupPath(clusterID, groupID),
+	}
+
+	_, err := cgm.client.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to delete consumer group %s: %w", groupID, err)
+	}
+	return nil
+}
+
+func consumerGroupsPath(clusterID string) string {
+	return fmt.Sprintf("/kafka/v3/clusters/%s/consumer-groups", clusterID)
+}
+
+func consumerGroupPath(clusterID string, groupID string) string {
+	return consumerGroupsPath(clusterID) + "/" + url.PathEscape(groupID)
+}
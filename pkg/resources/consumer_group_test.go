@@ -0,0 +1,91 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestConsumerGroupManager_ListConsumerGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kafka/v3/clusters/lkc-123/consumer-groups" {
+			t.Errorf("Expected path /kafka/v3/clusters/lkc-123/consumer-groups, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"consumer_group_id": "billing", "state": "STABLE", "partition_assignor": "range"},
+				{"consumer_group_id": "audit", "state": "EMPTY", "is_simple": true},
+			},
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConsumerGroupManager(c)
+
+	groups, err := mgr.ListConsumerGroups(context.Background(), "lkc-123")
+	if err != nil {
+		t.Fatalf("ListConsumerGroups failed: %v", err)
+	}
+	if len(groups) != 2 || groups[0].State != resources.ConsumerGroupStateStable || !groups[1].IsSimple {
+		t.Errorf("Unexpected groups: %#v", groups)
+	}
+}
+
+func TestConsumerGroupManager_Lags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body interface{}
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-123/consumer-groups/billing/lags":
+			body = map[string]interface{}{"data": []map[string]interface{}{
+				{"consumer_group_id": "billing", "topic_name": "orders", "partition_id": 0, "current_offset": 90, "log_end_offset": 100, "lag": 10, "consumer_id": "c-1"},
+			}}
+		case "/kafka/v3/clusters/lkc-123/consumer-groups/billing/lag-summary":
+			body = map[string]interface{}{"consumer_group_id": "billing", "max_lag": 10, "total_lag": 12, "max_lag_topic_name": "orders"}
+		case "/kafka/v3/clusters/lkc-123/consumer-groups/billing/consumers":
+			body = map[string]interface{}{"data": []map[string]interface{}{{"consumer_id": "c-1", "client_id": "billing-svc"}}}
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConsumerGroupManager(c)
+	ctx := context.Background()
+
+	lags, err := mgr.ListConsumerLags(ctx, "lkc-123", "billing")
+	if err != nil {
+		t.Fatalf("ListConsumerLags failed: %v", err)
+	}
+	if len(lags) != 1 || lags[0].Lag != 10 || lags[0].LogEndOffset != 100 {
+		t.Errorf("Unexpected lags: %#v", lags)
+	}
+
+	summary, err := mgr.GetConsumerGroupLagSummary(ctx, "lkc-123", "billing")
+	if err != nil {
+		t.Fatalf("GetConsumerGroupLagSummary failed: %v", err)
+	}
+	if summary.TotalLag != 12 || summary.MaxLagTopicName != "orders" {
+		t.Errorf("Unexpected summary: %#v", summary)
+	}
+
+	consumers, err := mgr.ListConsumers(ctx, "lkc-123", "billing")
+	if err != nil {
+		t.Fatalf("ListConsumers failed: %v", err)
+	}
+	if len(consumers) != 1 || consumers[0].ClientID != "billing-svc" {
+		t.Errorf("Unexpected consumers: %#v", consumers)
+	}
+}
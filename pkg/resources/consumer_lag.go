@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client/metrics"
+)
+
+// GroupLag is the lag of one consumer group: its summary and per-partition detail.
+type GroupLag struct {
+	Summary    api.ConsumerGroupLagSummary
+	Partitions []api.ConsumerLag
+}
+
+// CollectConsumerLag gathers the lag summary and per-partition lag of the given consumer
+// groups, or of every group in the cluster when groupIDs is empty, with at most
+// concurrency groups read in flight. Groups whose lag cannot be read, e.g. because they
+// were deleted mid-collection, are reported as warnings rather than failing the whole
+// collection. The result can be exported with WriteLagMetrics.
+// Returns errors:
+//   - *api.Error if listing the cluster's consumer groups fails
+func (cgm *ConsumerGroupManager) CollectConsumerLag(ctx context.Context, clusterID string, groupIDs []string, concurrency int) (*Result[GroupLag], error) {
+	groupIDs = append([]string(nil), groupIDs...)
+	if len(groupIDs) == 0 {
+		groups, err := cgm.ListConsumerGroups(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			groupIDs = append(groupIDs, g.ConsumerGroupID)
+		}
+	}
+	sort.Strings(groupIDs)
+
+	return fanOut(ctx, groupIDs, concurrency, func(ctx context.Context, groupID string) ([]GroupLag, error) {
+		summary, err := cgm.GetConsumerGroupLagSummary(ctx, clusterID, groupID)
+		if err != nil {
+			return nil, err
+		}
+		lags, err := cgm.ListConsumerLags(ctx, clusterID, groupID)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(lags, func(i, j int) bool {
+			if lags[i].TopicName != lags[j].TopicName {
+				return lags[i].TopicName < lags[j].TopicName
+			}
+			return lags[i].PartitionID < lags[j].PartitionID
+		})
+		summary.ConsumerGroupID = groupID
+		return []GroupLag{{Summary: *summary, Partitions: lags}}, nil
+	}), nil
+}
+
+// WriteLagMetrics writes consumer lag in the Prometheus text exposition format, as
+// confluent_consumer_group_lag per topic partition and confluent_consumer_group_lag_total
+// and confluent_consumer_group_lag_max per group, labelled with the cluster ID.
+func WriteLagMetrics(w io.Writer, clusterID string, lags []GroupLag) error {
+	var b strings.Builder
+	cluster := metrics.EscapeLabelValue(clusterID)
+
+	b.WriteString("# HELP confluent_consumer_group_lag Consumer group lag in records per topic partition.\n")
+	b.WriteString("# TYPE confluent_consumer_group_lag gauge\n")
+	for _, g := range lags {
+		for _, p := range g.Partitions {
+			fmt.Fprintf(&b, "confluent_consumer_group_lag{cluster=\"%s\",group=\"%s\",topic=\"%s\",partition=\"%d\"} %d\n",
+				cluster, metrics.EscapeLabelValue(g.Summary.ConsumerGroupID), metrics.EscapeLabelValue(p.TopicName), p.PartitionID, p.Lag)
+		}
+	}
+
+	b.WriteString("# HELP confluent_consumer_group_lag_total Consumer group lag in records summed over all partitions.\n")
+	b.WriteString("# TYPE confluent_consumer_group_lag_total gauge\n")
+	for _, g := range lags {
+		fmt.Fprintf(&b, "confluent_consumer_group_lag_total{cluster=\"%s\",group=\"%s\"} %d\n", cluster, metrics.EscapeLabelValue(g.Summary.ConsumerGroupID), g.Summary.TotalLag)
+	}
+
+	b.WriteString("# HELP confluent_consumer_group_lag_max Largest consumer group lag in records of any partition.\n")
+	b.WriteString("# TYPE confluent_consumer_group_lag_max gauge\n")
+	for _, g := range lags {
+		fmt.Fprintf(&b, "confluent_consumer_group_lag_max{cluster=\"%s\",group=\"%s\"} %d\n", cluster, metrics.EscapeLabelValue(g.Summary.ConsumerGroupID), g.Summary.MaxLag)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
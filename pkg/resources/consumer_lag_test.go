@@ -0,0 +1,92 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestConsumerGroupManager_CollectConsumerLag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-123/consumer-groups":
+			_, _ = w.Write([]byte(`{"data":[{"consumer_group_id":"billing"},{"consumer_group_id":"gone"}]}`))
+		case "/kafka/v3/clusters/lkc-123/consumer-groups/billing/lag-summary":
+			_, _ = w.Write([]byte(`{"max_lag":7,"total_lag":9}`))
+		case "/kafka/v3/clusters/lkc-123/consumer-groups/billing/lags":
+			_, _ = w.Write([]byte(`{"data":[{"topic_name":"orders","partition_id":1,"lag":7},{"topic_name":"orders","partition_id":0,"lag":2}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40403,"message":"Consumer group not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewConsumerGroupManager(c)
+
+	result, err := mgr.CollectConsumerLag(context.Background(), "lkc-123", nil, 2)
+	if err != nil {
+		t.Fatalf("CollectConsumerLag failed: %v", err)
+	}
+	if len(result.Items) != 1 || len(result.Warnings) != 1 || result.Warnings[0].Resource != "gone" {
+		t.Fatalf("Unexpected result: %#v", result)
+	}
+	if result.Items[0].Summary.ConsumerGroupID != "billing" || result.Items[0].Partitions[0].PartitionID != 0 {
+		t.Errorf("Unexpected group lag: %#v", result.Items[0])
+	}
+
+	var b strings.Builder
+	if err := resources.WriteLagMetrics(&b, "lkc-123", result.Items); err != nil {
+		t.Fatalf("WriteLagMetrics failed: %v", err)
+	}
+	for _, want := range []string{
+		`confluent_consumer_group_lag{cluster="lkc-123",group="billing",topic="orders",partition="1"} 7`,
+		`confluent_consumer_group_lag_total{cluster="lkc-123",group="billing"} 9`,
+		`confluent_consumer_group_lag_max{cluster="lkc-123",group="billing"} 7`,
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("Expected metrics to contain %s, got:\n%s", want, b.String())
+		}
+	}
+}
+
+func TestConsumerGroupManager_CollectConsumerLagKeepsInputOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/lag-summary") {
+			_, _ = w.Write([]byte(`{"max_lag":0,"total_lag":0}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	mgr := resources.NewConsumerGroupManager(newTestClient(t, server.URL))
+	groupIDs := []string{"shipping", "billing"}
+	if _, err := mgr.CollectConsumerLag(context.Background(), "lkc-123", groupIDs, 1); err != nil {
+		t.Fatalf("CollectConsumerLag failed: %v", err)
+	}
+	if groupIDs[0] != "shipping" || groupIDs[1] != "billing" {
+		t.Errorf("Expected caller's group IDs to be left unsorted, got %v", groupIDs)
+	}
+}
+
+func TestWriteLagMetrics_EscapesLabels(t *testing.T) {
+	lags := []resources.GroupLag{{Summary: api.ConsumerGroupLagSummary{ConsumerGroupID: "a\"b\\c\nd é", TotalLag: 1}}}
+
+	var b strings.Builder
+	if err := resources.WriteLagMetrics(&b, "lkc-123", lags); err != nil {
+		t.Fatalf("WriteLagMetrics failed: %v", err)
+	}
+	want := `confluent_consumer_group_lag_total{cluster="lkc-123",group="a\"b\\c\nd é"} 1`
+	if !strings.Contains(b.String(), want) {
+		t.Errorf("Expected metrics to contain %s, got:\n%s", want, b.String())
+	}
+}
@@ -0,0 +1,264 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Archive formats of custom connector plugins.
+const (
+	PluginFormatZIP = "ZIP"
+	PluginFormatJAR = "JAR"
+)
+
+// PresignedUpload is a short-lived URL to upload a custom connector plugin archive to.
+type PresignedUpload struct {
+	ContentFormat string `json:"content_format"`
+	Cloud         string `json:"cloud"`
+	UploadID      string `json:"upload_id"`
+	UploadURL     string `json:"upload_url"`
+	// UploadFormData are form fields that must accompany the uploaded file
+	UploadFormData map[string]string `json:"upload_form_data"`
+}
+
+// CustomConnectorPluginManager handles Confluent Cloud custom connector plugins via the
+// connect/v1 REST API.
+type CustomConnectorPluginManager struct {
+	client     client.Doer
+	httpClient *http.Client
+}
+
+// NewCustomConnectorPluginManager creates a new custom connector plugin manager.
+func NewCustomConnectorPluginManager(c client.Doer) *CustomConnectorPluginManager {
+	return &CustomConnectorPluginManager{client: c, httpClient: http.DefaultClient}
+}
+
+// WithHTTPClient sets the HTTP client used by UploadPluginArchive (default
+// http.DefaultClient). Uploads go to a cloud storage URL rather than the Confluent API,
+// so they do not use the Doer's credentials, retries, or middlewares.
+func (pm *CustomConnectorPluginManager) WithHTTPClient(hc *http.Client) *CustomConnectorPluginManager {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	pm.httpClient = hc
+	return pm
+}
+
+// RequestUploadURL requests a presigned URL to upload a plugin archive in contentFormat
+// (PluginFormatZIP or PluginFormatJAR) for plugins running in cloud, e.g. "AWS".
+// Returns errors:
+//   - *api.Error with IsBadRequest() if the format or cloud is not supported
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (pm *CustomConnectorPluginManager) RequestUploadURL(ctx context.Context, contentFormat string, cloud string) (*PresignedUpload, error) {
+	req := client.Request{
+		Method: "POST",
+		Path:   "/connect/v1/presigned-upload-url",
+		Body: map[string]interface{}{
+			"content_format": contentFormat,
+			"cloud":          cloud,
+		},
+	}
+
+	resp, err := pm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request plugin upload URL: %w", err)
+	}
+
+	var upload PresignedUpload
+	if err := resp.DecodeJSON(&upload); err != nil {
+		return nil, fmt.Errorf("failed to parse presigned upload URL response: %w", err)
+	}
+
+	return &upload, nil
+}
+
+// UploadPluginArchive uploads a plugin archive to a URL from RequestUploadURL.
+// Returns errors:
+//   - *api.Error with the storage service's status if the upload is rejected, e.g.
+//     IsForbidden() once the URL has expired
+func (pm *CustomConnectorPluginManager) UploadPluginArchive(ctx context.Context, upload *PresignedUpload, fileName string, content io.Reader) error {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	keys := make([]string, 0, len(upload.UploadFormData))
+	for k := range upload.UploadFormData {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := w.WriteField(k, upload.UploadFormData[k]); err != nil {
+			return fmt.Errorf("failed to encode plugin upload: %w", err)
+		}
+	}
+	// The file must be the last field of a presigned form upload
+	part, err := w.CreateFormFile("file", fileName)
+	if err != nil {
+		return fmt.Errorf("failed to encode plugin upload: %w", err)
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return fmt.Errorf("failed to read plugin archive %s: %w", fileName, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to encode plugin upload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, upload.UploadURL, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create plugin upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := pm.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload plugin archive %s: %w", fileName, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("failed to upload plugin archive %s: %w", fileName, &api.Error{Code: resp.StatusCode, Message: string(msg)})
+	}
+	return nil
+}
+
+// CreatePlugin registers an uploaded archive, identified by the UploadID of its
+// PresignedUpload, as a custom connector plugin.
+// Returns errors:
+//   - *api.Error with IsBadRequest() if the plugin is invalid or the upload was not found
+//   - *api.Error with IsConflict() if a plugin with the same name exists
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (pm *CustomConnectorPluginManager) CreatePlugin(ctx context.Context, plugin api.CustomConnectorPlugin, uploadID string) (*api.CustomConnectorPlugin, error) {
+	body := map[string]interface{}{
+		"display_name":    plugin.DisplayName,
+		"connector_class": plugin.ConnectorClass,
+		"connector_type":  plugin.ConnectorType,
+		"upload_source": map[string]string{
+			"location":  "PRESIGNED_URL_LOCATION",
+			"upload_id": uploadID,
+		},
+	}
+	if plugin.Description != "" {
+		body["description"] = plugin.Description
+	}
+	if plugin.DocumentationLink != "" {
+		body["documentation_link"] = plugin.DocumentationLink
+	}
+	if len(plugin.SensitiveConfigProperties) > 0 {
+		body["sensitive_config_properties"] = plugin.SensitiveConfigProperties
+	}
+	if plugin.Cloud != "" {
+		body["cloud"] = plugin.Cloud
+	}
+
+	req := client.Request{
+		Method: "POST",
+		Path:   "/connect/v1/custom-connector-plugins",
+		Body:   body,
+	}
+
+	resp, err := pm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom connector plugin %s: %w", plugin.DisplayName, err)
+	}
+
+	var created api.CustomConnectorPlugin
+	if err := resp.DecodeJSON(&created); err != nil {
+		return nil, fmt.Errorf("failed to parse create custom connector plugin response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// PublishPlugin uploads a plugin archive and registers it in one call: RequestUploadURL,
+// UploadPluginArchive, then CreatePlugin. plugin.Cloud defaults to "AWS".
+func (pm *CustomConnectorPluginManager) PublishPlugin(ctx context.Context, plugin api.CustomConnectorPlugin, contentFormat string, fileName string, content io.Reader) (*api.CustomConnectorPlugin, error) {
+	if plugin.Cloud == "" {
+		plugin.Cloud = "AWS"
+	}
+	upload, err := pm.RequestUploadURL(ctx, contentFormat, plugin.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	if err := pm.UploadPluginArchive(ctx, upload, fileName, content); err != nil {
+		return nil, err
+	}
+	return pm.CreatePlugin(ctx, plugin, upload.UploadID)
+}
+
+// ListPlugins lists the custom connector plugins of the organization, optionally
+// filtered by cloud.
+// Returns errors:
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (pm *CustomConnectorPluginManager) ListPlugins(ctx context.Context, cloud string) ([]api.CustomConnectorPlugin, error) {
+	path := "/connect/v1/custom-connector-plugins"
+	if cloud != "" {
+		path += "?" + url.Values{"cloud": {cloud}}.Encode()
+	}
+	req := client.Request{
+		Method: "GET",
+		Path:   path,
+	}
+
+	resp, err := pm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom connector plugins: %w", err)
+	}
+
+	var result struct {
+		Data []api.CustomConnectorPlugin `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse custom connector plugin list response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// GetPlugin retrieves a custom connector plugin.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the plugin does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+func (pm *CustomConnectorPluginManager) GetPlugin(ctx context.Context, pluginID string) (*api.CustomConnectorPlugin, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   "/connect/v1/custom-connector-plugins/" + url.PathEscape(pluginID),
+	}
+
+	resp, err := pm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe custom connector plugin %s: %w", pluginID, err)
+	}
+
+	var plugin api.CustomConnectorPlugin
+	if err := resp.DecodeJSON(&plugin); err != nil {
+		return nil, fmt.Errorf("failed to parse custom connector plugin response: %w", err)
+	}
+
+	return &plugin, nil
+}
+
+// DeletePlugin deletes a custom connector plugin. Connectors using it must be deleted first.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the plugin does not exist
+//   - *api.Error with IsConflict() or IsBadRequest() if connectors still use the plugin
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (pm *CustomConnectorPluginManager) DeletePlugin(ctx context.Context, pluginID string) error {
+	req := client.Request{
+		Method: "DELETE",
+		Path:   "/connect/v1/custom-connector-plugins/" + url.PathEscape(pluginID),
+	}
+
+	if _, err := pm.client.Do(ctx, req); err != nil {
+		return fmt.Errorf("failed to delete custom connector plugin %s: %w", pluginID, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+const pluginJSON = `{"id":"ccp-1","display_name":"my-sink","connector_class":"com.example.MySink","connector_type":"SINK",
+	"sensitive_config_properties":["password"],"cloud":"AWS"}`
+
+func TestCustomConnectorPluginManager_PublishPlugin(t *testing.T) {
+	var storage *httptest.Server
+	var createBody map[string]interface{}
+	var uploaded, field string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/connect/v1/presigned-upload-url":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["content_format"] != "ZIP" || body["cloud"] != "AWS" {
+				t.Errorf("Unexpected upload URL request: %v", body)
+			}
+			_, _ = w.Write([]byte(`{"upload_id":"up-1","upload_url":"` + storage.URL + `/bucket","upload_form_data":{"key":"k/up-1.zip"}}`))
+		case "/connect/v1/custom-connector-plugins":
+			_ = json.NewDecoder(r.Body).Decode(&createBody)
+			_, _ = w.Write([]byte(pluginJSON))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	storage = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("Expected no credentials on the storage upload")
+		}
+		field = r.FormValue("key")
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Expected file part: %v", err)
+		}
+		b, _ := io.ReadAll(f)
+		uploaded = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer storage.Close()
+
+	mgr := resources.NewCustomConnectorPluginManager(newTestClient(t, server.URL))
+	plugin, err := mgr.PublishPlugin(context.Background(), api.CustomConnectorPlugin{
+		DisplayName:               "my-sink",
+		ConnectorClass:            "com.example.MySink",
+		ConnectorType:             "SINK",
+		SensitiveConfigProperties: []string{"password"},
+	}, resources.PluginFormatZIP, "my-sink.zip", strings.NewReader("archive"))
+	if err != nil {
+		t.Fatalf("PublishPlugin failed: %v", err)
+	}
+	if plugin.ID != "ccp-1" {
+		t.Errorf("Unexpected plugin: %#v", plugin)
+	}
+	if uploaded != "archive" || field != "k/up-1.zip" {
+		t.Errorf("Unexpected upload: file=%q key=%q", uploaded, field)
+	}
+	source, _ := createBody["upload_source"].(map[string]interface{})
+	if source["upload_id"] != "up-1" || source["location"] != "PRESIGNED_URL_LOCATION" || createBody["cloud"] != "AWS" {
+		t.Errorf("Unexpected create body: %v", createBody)
+	}
+}
+
+func TestCustomConnectorPluginManager_UploadPluginArchive_Rejected(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("Request has expired"))
+	}))
+	defer storage.Close()
+
+	mgr := resources.NewCustomConnectorPluginManager(newTestClient(t, "http://unused"))
+	err := mgr.UploadPluginArchive(context.Background(), &resources.PresignedUpload{UploadURL: storage.URL}, "p.zip", strings.NewReader("x"))
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) || !apiErr.IsForbidden() {
+		t.Fatalf("Expected forbidden error, got %v", err)
+	}
+}
+
+func TestCustomConnectorPluginManager_ListPlugins(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/connect/v1/custom-connector-plugins" || r.URL.Query().Get("cloud") != "AWS" {
+			t.Errorf("Unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[` + pluginJSON + `]}`))
+	}))
+	defer server.Close()
+
+	mgr := resources.NewCustomConnectorPluginManager(newTestClient(t, server.URL))
+	plugins, err := mgr.ListPlugins(context.Background(), "AWS")
+	if err != nil {
+		t.Fatalf("ListPlugins failed: %v", err)
+	}
+	if len(plugins) != 1 || plugins[0].ConnectorType != "SINK" || plugins[0].SensitiveConfigProperties[0] != "password" {
+		t.Errorf("Unexpected plugins: %#v", plugins)
+	}
+}
+
+func TestCustomConnectorPluginManager_DeletePlugin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/connect/v1/custom-connector-plugins/ccp-1" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mgr := resources.NewCustomConnectorPluginManager(newTestClient(t, server.URL))
+	if err := mgr.DeletePlugin(context.Background(), "ccp-1"); err != nil {
+		t.Fatalf("DeletePlugin failed: %v", err)
+	}
+}
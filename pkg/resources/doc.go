@@ -0,0 +1,8 @@
+// Package resources provides managers for Confluent Cloud and Platform resources, such as
+// environments, Kafka clusters, topics, ACLs, service accounts, and connectors. Each manager
+// wraps a client.Doer and returns *api.Error values for API failures.
+//
+// Managed Connect clusters have no create or delete API of their own: in Confluent Cloud
+// each Kafka cluster's Connect cluster shares its ID and lifecycle. ConnectClusterManager
+// only lists and describes them; provision and delete them with ClusterManager.
+package resources
@@ -10,11 +10,11 @@ import (
 
 // EnvironmentManager handles environment-related operations via REST API.
 type EnvironmentManager struct {
-	client *client.Client
+	client client.Doer
 }
 
 // NewEnvironmentManager creates a new environment manager.
-func NewEnvironmentManager(c *client.Client) *EnvironmentManager {
+func NewEnvironmentManager(c client.Doer) *EnvironmentManager {
 	return &EnvironmentManager{client: c}
 }
 
@@ -25,24 +25,11 @@ func NewEnvironmentManager(c *client.Client) *EnvironmentManager {
 //   - *api.Error with IsForbidden() if user lacks permissions
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (em *EnvironmentManager) ListEnvironments(ctx context.Context) ([]api.Environment, error) {
-	req := client.Request{
-		Method: "GET",
-		Path:   "/org/v2/environments",
-	}
-
-	resp, err := em.client.Do(ctx, req)
+	environments, err := client.GetList[api.Environment](ctx, em.client, "/org/v2/environments")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list environments: %w", err)
 	}
-
-	var result struct {
-		Data []api.Environment `json:"data"`
-	}
-	if err := resp.DecodeJSON(&result); err != nil {
-		return nil, fmt.Errorf("failed to parse environment list response: %w", err)
-	}
-
-	return result.Data, nil
+	return environments, nil
 }
 
 // GetEnvironment retrieves information about a specific environment.
@@ -52,22 +39,11 @@ func (em *EnvironmentManager) ListEnvironments(ctx context.Context) ([]api.Envir
 //   - *api.Error with IsForbidden() if user lacks permissions
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (em *EnvironmentManager) GetEnvironment(ctx context.Context, environmentID string) (*api.Environment, error) {
-	req := client.Request{
-		Method: "GET",
-		Path:   fmt.Sprintf("/org/v2/environments/%s", environmentID),
-	}
-
-	resp, err := em.client.Do(ctx, req)
+	environment, err := client.GetJSON[api.Environment](ctx, em.client, fmt.Sprintf("/org/v2/environments/%s", environmentID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to describe environment %s: %w", environmentID, err)
 	}
-
-	var environment api.Environment
-	if err := resp.DecodeJSON(&environment); err != nil {
-		return nil, fmt.Errorf("failed to parse environment description: %w", err)
-	}
-
-	return &environment, nil
+	return environment, nil
 }
 
 // CreateEnvironment creates a new environment with the specified name and display name.
@@ -86,23 +62,11 @@ func (em *EnvironmentManager) CreateEnvironment(ctx context.Context, name string
 		body["name"] = name
 	}
 
-	req := client.Request{
-		Method: "POST",
-		Path:   "/org/v2/environments",
-		Body:   body,
-	}
-
-	resp, err := em.client.Do(ctx, req)
+	environment, err := client.PostJSON[map[string]interface{}, api.Environment](ctx, em.client, "/org/v2/environments", body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create environment: %w", err)
 	}
-
-	var environment api.Environment
-	if err := resp.DecodeJSON(&environment); err != nil {
-		return nil, fmt.Errorf("failed to parse create environment response: %w", err)
-	}
-
-	return &environment, nil
+	return environment, nil
 }
 
 // DeleteEnvironment deletes an environment.
@@ -114,13 +78,7 @@ func (em *EnvironmentManager) CreateEnvironment(ctx context.Context, name string
 //   - *api.Error with IsConflict() if environment contains resources
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (em *EnvironmentManager) DeleteEnvironment(ctx context.Context, environmentID string) error {
-	req := client.Request{
-		Method: "DELETE",
-		Path:   fmt.Sprintf("/org/v2/environments/%s", environmentID),
-	}
-
-	_, err := em.client.Do(ctx, req)
-	if err != nil {
+	if err := client.Delete(ctx, em.client, fmt.Sprintf("/org/v2/environments/%s", environmentID)); err != nil {
 		return fmt.Errorf("failed to delete environment %s: %w", environmentID, err)
 	}
 	return nil
@@ -138,21 +96,9 @@ func (em *EnvironmentManager) UpdateEnvironment(ctx context.Context, environment
 		"display_name": displayName,
 	}
 
-	req := client.Request{
-		Method: "PATCH",
-		Path:   fmt.Sprintf("/org/v2/environments/%s", environmentID),
-		Body:   body,
-	}
-
-	resp, err := em.client.Do(ctx, req)
+	environment, err := client.PatchJSON[map[string]interface{}, api.Environment](ctx, em.client, fmt.Sprintf("/org/v2/environments/%s", environmentID), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update environment %s: %w", environmentID, err)
 	}
-
-	var environment api.Environment
-	if err := resp.DecodeJSON(&environment); err != nil {
-		return nil, fmt.Errorf("failed to parse update response: %w", err)
-	}
-
-	return &environment, nil
+	return environment, nil
 }
@@ -0,0 +1,199 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/creiche/confluent-go/pkg/api"
+)
+
+// CapabilityError indicates an operation was rejected because the organization
+// is not entitled to the feature it requires (e.g., Freight clusters).
+// The underlying *api.Error is available via errors.As.
+type CapabilityError struct {
+	// Feature is the feature the operation required (e.g., FeatureFreight)
+	Feature string
+	// Err is the underlying error returned by the API
+	Err error
+}
+
+// Error implements the error interface.
+func (e *CapabilityError) Error() string {
+	return fmt.Sprintf("organization is not entitled to %s: %v", e.Feature, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *CapabilityError) Unwrap() error {
+	return e.Err
+}
+
+// IsCapabilityError returns true if err is or wraps a *CapabilityError.
+func IsCapabilityError(err error) bool {
+	var capErr *CapabilityError
+	return errors.As(err, &capErr)
+}
+
+// PartitionDecreaseError is returned by UpdatePartitionCount when the requested partition
+// count is lower than the current one. Kafka cannot remove partitions from a topic.
+type PartitionDecreaseError struct {
+	Topic     string
+	Current   int32
+	Requested int32
+}
+
+// Error implements the error interface.
+func (e *PartitionDecreaseError) Error() string {
+	return fmt.Sprintf("cannot decrease partitions of topic %s from %d to %d", e.Topic, e.Current, e.Requested)
+}
+
+// IsPartitionDecreaseError returns true if err is or wraps a *PartitionDecreaseError.
+func IsPartitionDecreaseError(err error) bool {
+	var decErr *PartitionDecreaseError
+	return errors.As(err, &decErr)
+}
+
+// ConnectorTransitionError is returned by TransitionConnector when a connector cannot
+// move from its current state to the requested one, e.g. while it is provisioning.
+type ConnectorTransitionError struct {
+	Connector string
+	From      ConnectorState
+	To        ConnectorState
+}
+
+// Error implements the error interface.
+func (e *ConnectorTransitionError) Error() string {
+	return fmt.Sprintf("cannot transition connector %s from %s to %s", e.Connector, e.From, e.To)
+}
+
+// IsConnectorTransitionError returns true if err is or wraps a *ConnectorTransitionError.
+func IsConnectorTransitionError(err error) bool {
+	var transErr *ConnectorTransitionError
+	return errors.As(err, &transErr)
+}
+
+// ErrConnectorFailed is wrapped by WaitForConnectorRunning when the connector or one
+// of its tasks reports FAILED.
+var ErrConnectorFailed = errors.New("connector failed")
+
+// ConnectorHealthError is returned by WaitForConnectorRunning when a connector does not
+// become healthy. Err is ErrConnectorFailed, context.DeadlineExceeded on timeout, or
+// the context's error; Status holds the last observed status, including task traces.
+type ConnectorHealthError struct {
+	Connector string
+	Status    *api.ConnectorStatus
+	Err       error
+}
+
+// Error implements the error interface.
+func (e *ConnectorHealthError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "connector %s not running: %v", e.Connector, e.Err)
+	if e.Status == nil {
+		return b.String()
+	}
+	running := 0
+	for _, task := range e.Status.Tasks {
+		if task.State == ConnectorStateRunning {
+			running++
+		}
+	}
+	fmt.Fprintf(&b, " (state %s, %d/%d tasks running)", e.Status.State, running, len(e.Status.Tasks))
+	for _, ce := range e.Status.Errors {
+		fmt.Fprintf(&b, "; %s", ce.Message)
+	}
+	for _, task := range e.Status.Tasks {
+		if task.State == ConnectorStateFailed {
+			fmt.Fprintf(&b, "; task %d failed: %s", task.ID, task.Error)
+		}
+	}
+	return b.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *ConnectorHealthError) Unwrap() error {
+	return e.Err
+}
+
+// ConnectorValidationError is returned by CreateConnector when validation is enabled with
+// WithValidation and the config has errors. It wraps ErrInvalidConnectorConfig.
+type ConnectorValidationError struct {
+	Connector  string
+	Validation *api.ConnectorValidation
+}
+
+// Error implements the error interface.
+func (e *ConnectorValidationError) Error() string {
+	errs := e.Validation.ErrorsByConfig()
+	names := make([]string, 0, len(errs))
+	for name := range errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	fmt.Fprintf(&b, "connector %s: %v", e.Connector, ErrInvalidConnectorConfig)
+	for i, name := range names {
+		sep := "; "
+		if i == 0 {
+			sep = ": "
+		}
+		fmt.Fprintf(&b, "%s%s: %s", sep, name, strings.Join(errs[name], ", "))
+	}
+	return b.String()
+}
+
+// Unwrap returns ErrInvalidConnectorConfig.
+func (e *ConnectorValidationError) Unwrap() error {
+	return ErrInvalidConnectorConfig
+}
+
+// ErrTopicNotFound is wrapped by TopicManager errors for topics that do not exist, so
+// callers can tell a missing topic apart from auth or network failures, or from an
+// unknown cluster, with errors.Is. The underlying *api.Error remains available via errors.As.
+var ErrTopicNotFound = errors.New("topic not found")
+
+// kafkaErrorUnknownTopic is the Kafka REST error code for a topic that does not exist.
+// An unknown cluster is reported as a plain 404.
+const kafkaErrorUnknownTopic = 40403
+
+// isNotFound reports whether err wraps a 404 *api.Error.
+func isNotFound(err error) bool {
+	var apiErr *api.Error
+	return errors.As(err, &apiErr) && apiErr.IsNotFound()
+}
+
+// topicError wraps err with ErrTopicNotFound if the API reported an unknown topic.
+func topicError(err error) error {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+		return err
+	}
+	code, _ := apiErr.Details["error_code"].(float64)
+	if int(code) != kafkaErrorUnknownTopic && !apiErr.HasCode(strconv.Itoa(kafkaErrorUnknownTopic)) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ErrTopicNotFound, err)
+}
+
+// kafkaRESTError converts an error code embedded in a Kafka REST v3 response body, such
+// as a per-record produce result, into an *api.Error. It returns nil for a zero code.
+func kafkaRESTError(code int, message string) error {
+	if code == 0 {
+		return nil
+	}
+	// Kafka REST error codes are either HTTP statuses or an HTTP status
+	// followed by two digits, e.g. 40403
+	status := code
+	for status >= 1000 {
+		status /= 10
+	}
+	return &api.Error{Code: status, ErrorCode: strconv.Itoa(code), Message: message}
+}
+
+// Errors returned by Resolver when a name cannot be mapped to a single resource ID.
+var (
+	ErrNameNotFound  = errors.New("no resource with that name")
+	ErrAmbiguousName = errors.New("name matches more than one resource")
+)
@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Well-known feature names that can be checked with OrganizationManager.IsFeatureEnabled.
+const (
+	FeatureFlink     = "flink"
+	FeatureFreight   = "freight"
+	FeatureTableflow = "tableflow"
+)
+
+// EntitlementStatusActive is the status of an entitlement that is currently in effect.
+const EntitlementStatusActive = "ACTIVE"
+
+// OrganizationManager handles organization metadata and entitlement lookups via REST API.
+type OrganizationManager struct {
+	client client.Doer
+}
+
+// NewOrganizationManager creates a new organization manager.
+func NewOrganizationManager(c client.Doer) *OrganizationManager {
+	return &OrganizationManager{client: c}
+}
+
+// ListOrganizations lists the organizations the authenticated principal belongs to.
+// Returns errors:
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (om *OrganizationManager) ListOrganizations(ctx context.Context) ([]api.Organization, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   "/org/v2/organizations",
+	}
+
+	resp, err := om.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	var result struct {
+		Data []api.Organization `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse organization list response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// GetOrganization retrieves metadata about a specific organization.
+// Returns errors:
+//   - *api.Error with IsNotFound() if organization does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (om *OrganizationManager) GetOrganization(ctx context.Context, organizationID string) (*api.Organization, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/org/v2/organizations/%s", organizationID),
+	}
+
+	resp, err := om.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe organization %s: %w", organizationID, err)
+	}
+
+	var organization api.Organization
+	if err := resp.DecodeJSON(&organization); err != nil {
+		return nil, fmt.Errorf("failed to parse organization description: %w", err)
+	}
+
+	return &organization, nil
+}
+
+// ListEntitlements lists the partner/marketplace entitlements visible to the authenticated principal.
+// Returns errors:
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (om *OrganizationManager) ListEntitlements(ctx context.Context) ([]api.Entitlement, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   "/partner/v2/entitlements",
+	}
+
+	resp, err := om.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entitlements: %w", err)
+	}
+
+	var result struct {
+		Data []api.Entitlement `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse entitlement list response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// IsFeatureEnabled reports whether any active entitlement enables the given feature
+// (e.g., FeatureFlink). A feature matches when it appears in an entitlement's feature
+// flags or its product ID, compared case-insensitively.
+// Use this before calling APIs that would otherwise fail with 403 for unentitled orgs.
+func (om *OrganizationManager) IsFeatureEnabled(ctx context.Context, feature string) (bool, error) {
+	entitlements, err := om.ListEntitlements(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, e := range entitlements {
+		if e.Status != "" && !strings.EqualFold(e.Status, EntitlementStatusActive) {
+			continue
+		}
+		if strings.EqualFold(e.ProductID, feature) {
+			return true, nil
+		}
+		for _, f := range e.Features {
+			if strings.EqualFold(f, feature) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,74 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestOrganizationManager_GetOrganization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/org/v2/organizations/org-123" {
+			t.Errorf("Expected path /org/v2/organizations/org-123, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":           "org-123",
+			"display_name": "Acme",
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewOrganizationManager(c)
+
+	org, err := mgr.GetOrganization(context.Background(), "org-123")
+	if err != nil {
+		t.Fatalf("GetOrganization failed: %v", err)
+	}
+	if org.DisplayName != "Acme" {
+		t.Errorf("Expected display name Acme, got %s", org.DisplayName)
+	}
+}
+
+func TestOrganizationManager_IsFeatureEnabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/partner/v2/entitlements" {
+			t.Errorf("Expected path /partner/v2/entitlements, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "ent-1", "product_id": "kafka", "status": "ACTIVE", "features": []string{"Flink"}},
+				{"id": "ent-2", "product_id": "tableflow", "status": "CANCELLED"},
+			},
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewOrganizationManager(c)
+
+	tests := map[string]bool{
+		resources.FeatureFlink:     true,
+		resources.FeatureTableflow: false,
+		resources.FeatureFreight:   false,
+	}
+	for feature, want := range tests {
+		got, err := mgr.IsFeatureEnabled(context.Background(), feature)
+		if err != nil {
+			t.Fatalf("IsFeatureEnabled(%s) failed: %v", feature, err)
+		}
+		if got != want {
+			t.Errorf("IsFeatureEnabled(%s) = %v, want %v", feature, got, want)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// QuotaPrincipalDefault applies a quota to every principal without a quota of its own.
+const QuotaPrincipalDefault = "<default>"
+
+// QuotaManager handles client quotas on dedicated clusters via the kafka-quotas/v1 REST API.
+type QuotaManager struct {
+	client client.Doer
+}
+
+// NewQuotaManager creates a new quota manager.
+func NewQuotaManager(c client.Doer) *QuotaManager {
+	return &QuotaManager{client: c}
+}
+
+// quotaIDRef is the {"id": ...} reference used for related resources.
+type quotaIDRef struct {
+	ID string `json:"id"`
+}
+
+// quotaThroughput is the kafka-quotas/v1 wire format of a throughput limit.
+type quotaThroughput struct {
+	IngressByteRate string `json:"ingress_byte_rate,omitempty"`
+	EgressByteRate  string `json:"egress_byte_rate,omitempty"`
+}
+
+// clientQuotaResource is the kafka-quotas/v1 wire format of a client quota.
+type clientQuotaResource struct {
+	ID   string `json:"id"`
+	Spec struct {
+		DisplayName string          `json:"display_name"`
+		Description string          `json:"description"`
+		Throughput  quotaThroughput `json:"throughput"`
+		Cluster     quotaIDRef      `json:"cluster"`
+		Principals  []quotaIDRef    `json:"principals"`
+		Environment quotaIDRef      `json:"environment"`
+	} `json:"spec"`
+}
+
+func (r clientQuotaResource) quota() api.ClientQuota {
+	principals := make([]string, len(r.Spec.Principals))
+	for i, p := range r.Spec.Principals {
+		principals[i] = p.ID
+	}
+	return api.ClientQuota{
+		ID:              r.ID,
+		DisplayName:     r.Spec.DisplayName,
+		Description:     r.Spec.Description,
+		EnvironmentID:   r.Spec.Environment.ID,
+		ClusterID:       r.Spec.Cluster.ID,
+		Principals:      principals,
+		IngressByteRate: r.Spec.Throughput.IngressByteRate,
+		EgressByteRate:  r.Spec.Throughput.EgressByteRate,
+	}
+}
+
+func quotaPrincipalRefs(principals []string) []quotaIDRef {
+	refs := make([]quotaIDRef, len(principals))
+	for i, p := range principals {
+		refs[i] = quotaIDRef{ID: p}
+	}
+	return refs
+}
+
+// ListQuotas lists the client quotas of a cluster.
+// Returns errors:
+//   - *api.Error with IsNotFound() for invalid environment or cluster ID
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (qm *QuotaManager) ListQuotas(ctx context.Context, environmentID string, clusterID string) ([]api.ClientQuota, error) {
+	q := url.Values{"environment": {environmentID}, "spec.cluster": {clusterID}}
+	req := client.Request{
+		Method: "GET",
+		Path:   "/kafka-quotas/v1/client-quotas?" + q.Encode(),
+	}
+
+	resp, err := qm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client quotas: %w", err)
+	}
+
+	var result struct {
+		Data []clientQuotaResource `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse client quota list response: %w", err)
+	}
+
+	quotas := make([]api.ClientQuota, len(result.Data))
+	for i, r := range result.Data {
+		quotas[i] = r.quota()
+	}
+	return quotas, nil
+}
+
+// GetQuota retrieves a client quota.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the quota does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (qm *QuotaManager) GetQuota(ctx context.Context, quotaID string) (*api.ClientQuota, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   quotaPath(quotaID),
+	}
+
+	resp, err := qm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe client quota %s: %w", quotaID, err)
+	}
+
+	var r clientQuotaResource
+	if err := resp.DecodeJSON(&r); err != nil {
+		return nil, fmt.Errorf("failed to parse client quota response: %w", err)
+	}
+	quota := r.quota()
+	return &quota, nil
+}
+
+// CreateQuota creates a client quota limiting the throughput of quota.Principals on
+// quota.ClusterID. Client quotas are only supported on dedicated clusters.
+// Returns errors:
+//   - *api.Error with IsBadRequest() if the cluster is not dedicated or a rate is invalid
+//   - *api.Error with IsConflict() if a principal already has a quota on the cluster
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (qm *QuotaManager) CreateQuota(ctx context.Context, quota api.ClientQuota) (*api.ClientQuota, error) {
+	spec := map[string]interface{}{
+		"display_name": quota.DisplayName,
+		"throughput": quotaThroughput{
+			IngressByteRate: quota.IngressByteRate,
+			EgressByteRate:  quota.EgressByteRate,
+		},
+		"cluster":     quotaIDRef{ID: quota.ClusterID},
+		"environment": quotaIDRef{ID: quota.EnvironmentID},
+		"principals":  quotaPrincipalRefs(quota.Principals),
+	}
+	if quota.Description != "" {
+		spec["description"] = quota.Description
+	}
+
+	req := client.Request{
+		Method: "POST",
+		Path:   "/kafka-quotas/v1/client-quotas",
+		Body:   map[string]interface{}{"spec": spec},
+	}
+
+	resp, err := qm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client quota %s: %w", quota.DisplayName, err)
+	}
+
+	var r clientQuotaResource
+	if err := resp.DecodeJSON(&r); err != nil {
+		return nil, fmt.Errorf("failed to parse create client quota response: %w", err)
+	}
+	created := r.quota()
+	return &created, nil
+}
+
+// UpdateQuota updates the display name, description, principals, or byte rates of a
+// client quota. Empty fields of update are left unchanged; the cluster and environment
+// of a quota cannot be changed.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the quota does not exist
+//   - *api.Error with IsBadRequest() if a rate is invalid
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (qm *QuotaManager) UpdateQuota(ctx context.Context, quotaID string, update api.ClientQuota) (*api.ClientQuota, error) {
+	spec := map[string]interface{}{}
+	if update.DisplayName != "" {
+		spec["display_name"] = update.DisplayName
+	}
+	if update.Description != "" {
+		spec["description"] = update.Description
+	}
+	if update.IngressByteRate != "" || update.EgressByteRate != "" {
+		spec["throughput"] = quotaThroughput{
+			IngressByteRate: update.IngressByteRate,
+			EgressByteRate:  update.EgressByteRate,
+		}
+	}
+	if len(update.Principals) > 0 {
+		spec["principals"] = quotaPrincipalRefs(update.Principals)
+	}
+
+	req := client.Request{
+		Method: "PATCH",
+		Path:   quotaPath(quotaID),
+		Body:   map[string]interface{}{"spec": spec},
+	}
+
+	resp, err := qm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update client quota %s: %w", quotaID, err)
+	}
+
+	var r clientQuotaResource
+	if err := resp.DecodeJSON(&r); err != nil {
+		return nil, fmt.Errorf("failed to parse update client quota response: %w", err)
+	}
+	updated := r.quota()
+	return &updated, nil
+}
+
+// DeleteQuota deletes a client quota, removing the limit from its principals.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the quota does not exist
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (qm *QuotaManager) DeleteQuota(ctx context.Context, quotaID string) error {
+	req := client.Request{
+		Method: "DELETE",
+		Path:   quotaPath(quotaID),
+	}
+
+	if _, err := qm.client.Do(ctx, req); err != nil {
+		return fmt.Errorf("failed to delete client quota %s: %w", quotaID, err)
+	}
+	return nil
+}
+
+func quotaPath(quotaID string) string {
+	return "/kafka-quotas/v1/client-quotas/" + url.PathEscape(quotaID)
+}
@@ -0,0 +1,109 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+const quotaJSON = `{"id":"cq-1","spec":{"display_name":"etl","throughput":{"ingress_byte_rate":"1048576","egress_byte_rate":"2097152"},
+	"cluster":{"id":"lkc-123"},"environment":{"id":"env-1"},"principals":[{"id":"sa-1"},{"id":"sa-2"}]}}`
+
+func TestQuotaManager_ListQuotas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/kafka-quotas/v1/client-quotas" || q.Get("environment") != "env-1" || q.Get("spec.cluster") != "lkc-123" {
+			t.Errorf("Unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[` + quotaJSON + `]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewQuotaManager(c)
+
+	quotas, err := mgr.ListQuotas(context.Background(), "env-1", "lkc-123")
+	if err != nil {
+		t.Fatalf("ListQuotas failed: %v", err)
+	}
+	if len(quotas) != 1 {
+		t.Fatalf("Expected 1 quota, got %d", len(quotas))
+	}
+	q := quotas[0]
+	if q.ID != "cq-1" || q.ClusterID != "lkc-123" || q.IngressByteRate != "1048576" || len(q.Principals) != 2 || q.Principals[1] != "sa-2" {
+		t.Errorf("Unexpected quota: %#v", q)
+	}
+}
+
+func TestQuotaManager_CreateQuota(t *testing.T) {
+	var body struct {
+		Spec struct {
+			Throughput map[string]string   `json:"throughput"`
+			Principals []map[string]string `json:"principals"`
+			Cluster    map[string]string   `json:"cluster"`
+		} `json:"spec"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(quotaJSON))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewQuotaManager(c)
+
+	quota, err := mgr.CreateQuota(context.Background(), api.ClientQuota{
+		DisplayName:     "etl",
+		EnvironmentID:   "env-1",
+		ClusterID:       "lkc-123",
+		Principals:      []string{"sa-1", "sa-2"},
+		IngressByteRate: "1048576",
+	})
+	if err != nil {
+		t.Fatalf("CreateQuota failed: %v", err)
+	}
+	if quota.ID != "cq-1" {
+		t.Errorf("Unexpected quota: %#v", quota)
+	}
+	if body.Spec.Cluster["id"] != "lkc-123" || len(body.Spec.Principals) != 2 || body.Spec.Throughput["ingress_byte_rate"] != "1048576" {
+		t.Errorf("Unexpected body: %+v", body)
+	}
+	if _, ok := body.Spec.Throughput["egress_byte_rate"]; ok {
+		t.Errorf("Expected unset egress rate to be omitted, got %v", body.Spec.Throughput)
+	}
+}
+
+func TestQuotaManager_UpdateQuota(t *testing.T) {
+	var body map[string]map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/kafka-quotas/v1/client-quotas/cq-1" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(quotaJSON))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewQuotaManager(c)
+
+	if _, err := mgr.UpdateQuota(context.Background(), "cq-1", api.ClientQuota{EgressByteRate: "2097152"}); err != nil {
+		t.Fatalf("UpdateQuota failed: %v", err)
+	}
+	spec := body["spec"]
+	if _, ok := spec["throughput"]; !ok || len(spec) != 1 {
+		t.Errorf("Expected only throughput to be sent, got %v", spec)
+	}
+}
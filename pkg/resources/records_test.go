@@ -0,0 +1,145 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/resources"
+	"github.com/creiche/confluent-go/pkg/retry"
+)
+
+func TestRecordsManager_Produce(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kafka/v3/clusters/lkc-123/topics/orders/records" {
+			t.Errorf("Expected path /kafka/v3/clusters/lkc-123/topics/orders/records, got %s", r.URL.Path)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		key := body["key"].(map[string]interface{})
+		value := body["value"].(map[string]interface{})
+		headers := body["headers"].([]interface{})
+		if key["type"] != "STRING" || key["data"] != "order-1" || value["schema_id"] != float64(100042) ||
+			body["partition_id"] != float64(2) || headers[0].(map[string]interface{})["value"] != "dHJhY2U=" {
+			t.Errorf("Unexpected body: %v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"error_code":   200,
+			"cluster_id":   "lkc-123",
+			"topic_name":   "orders",
+			"partition_id": 2,
+			"offset":       17,
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewRecordsManager(c)
+
+	partition := int32(2)
+	result, err := mgr.Produce(context.Background(), "lkc-123", "orders", resources.ProduceRecord{
+		PartitionID: &partition,
+		Headers:     []resources.RecordHeader{{Name: "trace", Value: []byte("trace")}},
+		Key:         resources.StringData("order-1"),
+		Value:       resources.SchemaData(100042, map[string]interface{}{"id": 1}),
+	})
+	if err != nil {
+		t.Fatalf("Produce failed: %v", err)
+	}
+	if result.PartitionID != 2 || result.Offset != 17 {
+		t.Errorf("Unexpected result: %#v", result)
+	}
+}
+
+func TestRecordsManager_ProduceBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dec := json.NewDecoder(r.Body)
+		enc := json.NewEncoder(w)
+		w.Header().Set("Content-Type", "application/json")
+		for i := 0; dec.More(); i++ {
+			var record map[string]interface{}
+			if err := dec.Decode(&record); err != nil {
+				t.Fatalf("failed to decode record: %v", err)
+			}
+			if i == 1 {
+				_ = enc.Encode(map[string]interface{}{"error_code": 40403, "message": "Schema not found"})
+				continue
+			}
+			_ = enc.Encode(map[string]interface{}{"error_code": 200, "offset": i})
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewRecordsManager(c)
+
+	results, err := mgr.ProduceBatch(context.Background(), "lkc-123", "orders", []resources.ProduceRecord{
+		{Value: resources.JSONData(map[string]int{"n": 0})},
+		{Value: resources.SchemaData(7, map[string]int{"n": 1})},
+		{Value: resources.BinaryData([]byte{0x01})},
+	})
+	if err != nil {
+		t.Fatalf("ProduceBatch failed: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	if results[0].Err() != nil || results[2].Offset != 2 {
+		t.Errorf("Unexpected results: %#v", results)
+	}
+	var apiErr *api.Error
+	if !errors.As(results[1].Err(), &apiErr) || !apiErr.IsNotFound() {
+		t.Errorf("Expected not found error for record 1, got %v", results[1].Err())
+	}
+}
+
+func TestRecordsManager_ProduceBatchNotRetried(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL).With(client.WithRetry(retry.DefaultStrategy().WithInitialBackoff(time.Millisecond)))
+	mgr := resources.NewRecordsManager(c)
+
+	_, err := mgr.ProduceBatch(context.Background(), "lkc-123", "orders", []resources.ProduceRecord{{}, {}})
+	if err == nil {
+		t.Fatal("Expected ProduceBatch to fail")
+	}
+	if calls != 1 {
+		t.Errorf("Expected the batch to be sent once, got %d requests", calls)
+	}
+}
+
+func TestRecordsManager_ProduceNotRetried(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL).With(client.WithRetry(retry.DefaultStrategy().WithInitialBackoff(time.Millisecond)))
+	mgr := resources.NewRecordsManager(c)
+
+	if _, err := mgr.Produce(context.Background(), "lkc-123", "orders", resources.ProduceRecord{}); err == nil {
+		t.Fatal("Expected Produce to fail")
+	}
+	if calls != 1 {
+		t.Errorf("Expected the record to be sent once, got %d requests", calls)
+	}
+}
@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// Resolution is the result of resolving a resource name to its ID.
+type Resolution struct {
+	// ID is the resource ID (e.g., env-abc123, lkc-xyz789)
+	ID string
+	// Generation starts at 1 and increases each time re-resolution finds a different ID
+	// for the same name, i.e. the resource was deleted and recreated.
+	Generation uint64
+	// ResolvedAt is when the ID was last looked up from the API
+	ResolvedAt time.Time
+}
+
+// Resolver memoizes environment and cluster name-to-ID lookups.
+// It is safe for concurrent use; concurrent lookups of the same name share one API call.
+//
+// Cached IDs go stale when a resource is recreated with the same name. Callers
+// that run the operation through WithEnvironment or WithCluster recover
+// automatically: when the operation fails with a 404, the name is re-resolved and,
+// if it now maps to a different ID, the operation is retried once with the new ID.
+//
+// Example usage:
+//
+//	resolver := resources.NewResolver(envManager, clusterManager, 0)
+//	err := resolver.WithCluster(ctx, envID, "orders", func(clusterID string) error {
+//		_, err := topicManager.ListTopics(ctx, clusterID)
+//		return err
+//	})
+type Resolver struct {
+	environments *EnvironmentManager
+	clusters     *ClusterManager
+	maxAge       time.Duration
+	clock        clock.Clock
+
+	mu       sync.Mutex
+	entries  map[resolverKey]Resolution
+	inflight map[resolverKey]*resolverCall
+}
+
+type resolverKey struct {
+	kind          string
+	environmentID string
+	name          string
+}
+
+type resolverCall struct {
+	done chan struct{}
+	id   string
+	err  error
+}
+
+// NewResolver creates a resolver. maxAge bounds how long a cached ID is trusted
+// before it is looked up again; zero caches IDs until they are found to be stale.
+func NewResolver(environments *EnvironmentManager, clusters *ClusterManager, maxAge time.Duration) *Resolver {
+	return &Resolver{
+		environments: environments,
+		clusters:     clusters,
+		maxAge:       maxAge,
+		clock:        clock.Real,
+		entries:      make(map[resolverKey]Resolution),
+		inflight:     make(map[resolverKey]*resolverCall),
+	}
+}
+
+// WithClock sets the clock used to expire cached IDs (default clock.Real).
+func (r *Resolver) WithClock(c clock.Clock) *Resolver {
+	r.clock = clock.OrReal(c)
+	return r
+}
+
+// ResolveEnvironment returns the ID of the environment whose name or display name is name.
+// Returns ErrNameNotFound if no environment matches and ErrAmbiguousName if several do.
+func (r *Resolver) ResolveEnvironment(ctx context.Context, name string) (Resolution, error) {
+	return r.resolve(ctx, resolverKey{kind: "environment", name: name}, false)
+}
+
+// ResolveCluster returns the ID of the cluster named name in an environment.
+// Returns ErrNameNotFound if no cluster matches and ErrAmbiguousName if several do.
+func (r *Resolver) ResolveCluster(ctx context.Context, environmentID string, name string) (Resolution, error) {
+	return r.resolve(ctx, resolverKey{kind: "cluster", environmentID: environmentID, name: name}, false)
+}
+
+// InvalidateEnvironment drops the cached ID for an environment name.
+func (r *Resolver) InvalidateEnvironment(name string) {
+	r.invalidate(resolverKey{kind: "environment", name: name})
+}
+
+// InvalidateCluster drops the cached ID for a cluster name.
+func (r *Resolver) InvalidateCluster(environmentID string, name string) {
+	r.invalidate(resolverKey{kind: "cluster", environmentID: environmentID, name: name})
+}
+
+// WithEnvironment resolves an environment name and calls fn with its ID, re-resolving
+// and retrying once if fn fails with a 404 because the cached ID is stale.
+func (r *Resolver) WithEnvironment(ctx context.Context, name string, fn func(environmentID string) error) error {
+	return r.with(ctx, resolverKey{kind: "environment", name: name}, fn)
+}
+
+// WithCluster resolves a cluster name and calls fn with its ID, re-resolving
+// and retrying once if fn fails with a 404 because the cached ID is stale.
+func (r *Resolver) WithCluster(ctx context.Context, environmentID string, name string, fn func(clusterID string) error) error {
+	return r.with(ctx, resolverKey{kind: "cluster", environmentID: environmentID, name: name}, fn)
+}
+
+func (r *Resolver) with(ctx context.Context, key resolverKey, fn func(id string) error) error {
+	res, err := r.resolve(ctx, key, false)
+	if err != nil {
+		return err
+	}
+	err = fn(res.ID)
+	var apiErr *api.Error
+	if err == nil || !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+		return err
+	}
+
+	fresh, resolveErr := r.resolve(ctx, key, true)
+	if resolveErr != nil || fresh.ID == res.ID {
+		// The resource is gone or the 404 was about something else; report the original error
+		return err
+	}
+	return fn(fresh.ID)
+}
+
+func (r *Resolver) invalidate(key resolverKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.entries[key]; ok {
+		// Keep the entry's generation but force the next lookup to hit the API
+		e.ResolvedAt = time.Time{}
+		r.entries[key] = e
+	}
+}
+
+// resolve returns the cached resolution for key, looking it up when missing,
+// expired, invalidated, or when force is set.
+func (r *Resolver) resolve(ctx context.Context, key resolverKey, force bool) (Resolution, error) {
+	r.mu.Lock()
+	if e, ok := r.entries[key]; ok && !force && r.fresh(e) {
+		r.mu.Unlock()
+		return e, nil
+	}
+	call, ok := r.inflight[key]
+	if !ok {
+		call = &resolverCall{done: make(chan struct{})}
+		r.inflight[key] = call
+		r.mu.Unlock()
+
+		call.id, call.err = r.lookup(ctx, key)
+
+		r.mu.Lock()
+		delete(r.inflight, key)
+		if call.err == nil {
+			e := r.entries[key]
+			if e.ID != call.id {
+				e.Generation++
+			}
+			e.ID = call.id
+			e.ResolvedAt = r.clock.Now()
+			r.entries[key] = e
+		}
+		close(call.done)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return Resolution{}, ctx.Err()
+	}
+	if call.err != nil {
+		return Resolution{}, call.err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.entries[key], nil
+}
+
+func (r *Resolver) fresh(e Resolution) bool {
+	if e.ResolvedAt.IsZero() {
+		return false
+	}
+	return r.maxAge <= 0 || clock.Since(r.clock, e.ResolvedAt) < r.maxAge
+}
+
+func (r *Resolver) lookup(ctx context.Context, key resolverKey) (string, error) {
+	var ids []string
+	switch key.kind {
+	case "environment":
+		envs, err := r.environments.ListEnvironments(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, env := range envs {
+			if env.Name == key.name || env.DisplayName == key.name {
+				ids = append(ids, env.ID)
+			}
+		}
+	case "cluster":
+		clusters, err := r.clusters.ListClusters(ctx, key.environmentID)
+		if err != nil {
+			return "", err
+		}
+		for _, cluster := range clusters {
+			if cluster.Name == key.name {
+				ids = append(ids, cluster.ID)
+			}
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%s %q: %w", key.kind, key.name, ErrNameNotFound)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%s %q matches %v: %w", key.kind, key.name, ids, ErrAmbiguousName)
+	}
+}
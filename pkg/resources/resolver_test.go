@@ -0,0 +1,70 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestResolver_WithClusterRecoversFromRecreatedCluster(t *testing.T) {
+	var clusterID atomic.Value
+	clusterID.Store("lkc-old")
+	var lists atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/cmk/v2/clusters" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		lists.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]string{{"id": clusterID.Load().(string), "name": "orders"}},
+		})
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	resolver := resources.NewResolver(resources.NewEnvironmentManager(c), resources.NewClusterManager(c), 0)
+	ctx := context.Background()
+
+	first, err := resolver.ResolveCluster(ctx, "env-1", "orders")
+	if err != nil || first.ID != "lkc-old" || first.Generation != 1 {
+		t.Fatalf("unexpected resolution %#v (err %v)", first, err)
+	}
+	if _, err := resolver.ResolveCluster(ctx, "env-1", "orders"); err != nil || lists.Load() != 1 {
+		t.Fatalf("expected cached resolution, got %d lookups (err %v)", lists.Load(), err)
+	}
+
+	// The cluster is recreated under the same name; operations on the old ID 404
+	clusterID.Store("lkc-new")
+	var used []string
+	err = resolver.WithCluster(ctx, "env-1", "orders", func(id string) error {
+		used = append(used, id)
+		if id == "lkc-old" {
+			return &api.Error{Code: http.StatusNotFound, Message: "cluster not found"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithCluster error: %v", err)
+	}
+	if len(used) != 2 || used[1] != "lkc-new" {
+		t.Fatalf("expected retry with new ID, got %v", used)
+	}
+
+	res, _ := resolver.ResolveCluster(ctx, "env-1", "orders")
+	if res.ID != "lkc-new" || res.Generation != 2 {
+		t.Errorf("unexpected resolution after recreation: %#v", res)
+	}
+
+	if _, err := resolver.ResolveCluster(ctx, "env-1", "missing"); !errors.Is(err, resources.ErrNameNotFound) {
+		t.Errorf("expected ErrNameNotFound, got %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package resources_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -101,6 +102,77 @@ func TestClusterManager_DeleteCluster(t *testing.T) {
 	}
 }
 
+func TestClusterSpec_Validate(t *testing.T) {
+	base := resources.ClusterSpec{
+		EnvironmentID: "env-123",
+		DisplayName:   "freight",
+		Type:          resources.ClusterTypeFreight,
+		Cloud:         "AWS",
+		Region:        "us-east-1",
+	}
+
+	if err := base.Validate(); err != nil {
+		t.Errorf("Expected valid Freight spec, got %v", err)
+	}
+
+	invalid := map[string]func(s *resources.ClusterSpec){
+		"freight on GCP":      func(s *resources.ClusterSpec) { s.Cloud = "GCP" },
+		"freight low avail":   func(s *resources.ClusterSpec) { s.Availability = resources.AvailabilityLow },
+		"freight with CKU":    func(s *resources.ClusterSpec) { s.CKU = 2 },
+		"unknown type":        func(s *resources.ClusterSpec) { s.Type = "MEGA" },
+		"dedicated no CKU":    func(s *resources.ClusterSpec) { s.Type = resources.ClusterTypeDedicated },
+		"missing environment": func(s *resources.ClusterSpec) { s.EnvironmentID = "" },
+		"standard high avail": func(s *resources.ClusterSpec) {
+			s.Type = resources.ClusterTypeStandard
+			s.Availability = resources.AvailabilityHigh
+		},
+		"multi-zone 1 CKU": func(s *resources.ClusterSpec) {
+			s.Type = resources.ClusterTypeDedicated
+			s.CKU = 1
+			s.Availability = resources.AvailabilityMultiZone
+		},
+	}
+	for name, mutate := range invalid {
+		spec := base
+		mutate(&spec)
+		if err := spec.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestClusterManager_CreateClusterFromSpec_NotEntitled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if kind := body["spec"]["config"].(map[string]interface{})["kind"]; kind != "Freight" {
+			t.Errorf("Expected kind Freight, got %v", kind)
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewClusterManager(c)
+
+	_, err := mgr.CreateClusterFromSpec(context.Background(), resources.ClusterSpec{
+		EnvironmentID: "env-123",
+		DisplayName:   "freight",
+		Type:          resources.ClusterTypeFreight,
+		Cloud:         "AWS",
+		Region:        "us-east-1",
+	})
+	if !resources.IsCapabilityError(err) {
+		t.Fatalf("Expected capability error, got %v", err)
+	}
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) || !apiErr.IsForbidden() {
+		t.Errorf("Expected wrapped 403 api.Error, got %v", err)
+	}
+}
+
 // Topic Manager Tests
 
 func TestTopicManager_ListTopics(t *testing.T) {
@@ -247,6 +319,46 @@ func TestServiceAccountManager_DeleteServiceAccount(t *testing.T) {
 	}
 }
 
+func TestServiceAccountManager_CreateAPIKeyForResource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Spec struct {
+				Resource struct {
+					ID          string `json:"id"`
+					Environment string `json:"environment"`
+				} `json:"resource"`
+			} `json:"spec"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Spec.Resource.ID != "lsrc-abc" || body.Spec.Resource.Environment != "env-123" {
+			t.Errorf("Unexpected resource: %#v", body.Spec.Resource)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "KEY123",
+			"secret": "s3cr3t",
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewServiceAccountManager(c)
+
+	key, err := mgr.CreateAPIKeyForResource(context.Background(), "sa-123", "schema registry", "lsrc-abc", "env-123")
+	if err != nil {
+		t.Fatalf("CreateAPIKeyForResource failed: %v", err)
+	}
+	if key.ID != "KEY123" || key.Secret != "s3cr3t" {
+		t.Errorf("Unexpected key: %#v", key)
+	}
+}
+
 // ACL Manager Tests
 
 func TestACLManager_ListACLs(t *testing.T) {
@@ -412,6 +524,26 @@ func TestEnvironmentManager_DeleteEnvironment(t *testing.T) {
 	}
 }
 
+func TestEnvironmentManager_WithFakeDoer(t *testing.T) {
+	var got client.Request
+	fake := client.DoerFunc(func(ctx context.Context, req client.Request) (*client.Response, error) {
+		got = req
+		return &client.Response{StatusCode: http.StatusOK, Body: []byte(`{"id":"env-123","name":"my-environment"}`)}, nil
+	})
+	mgr := resources.NewEnvironmentManager(fake)
+
+	env, err := mgr.GetEnvironment(context.Background(), "env-123")
+	if err != nil {
+		t.Fatalf("GetEnvironment failed: %v", err)
+	}
+	if got.Method != "GET" || got.Path != "/org/v2/environments/env-123" {
+		t.Errorf("Unexpected request %s %s", got.Method, got.Path)
+	}
+	if env.Name != "my-environment" {
+		t.Errorf("Expected name my-environment, got %s", env.Name)
+	}
+}
+
 // Connector Manager Tests
 
 func TestConnectorManager_ListConnectors(t *testing.T) {
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Warning is a non-fatal, per-item failure in an aggregate operation.
+type Warning struct {
+	// Resource identifies the item that failed (e.g., an environment or cluster ID)
+	Resource string
+	// Err is the error returned for that item
+	Err error
+}
+
+// Error implements the error interface.
+func (w Warning) Error() string {
+	return fmt.Sprintf("%s: %v", w.Resource, w.Err)
+}
+
+// Unwrap returns the underlying error.
+func (w Warning) Unwrap() error {
+	return w.Err
+}
+
+// Result carries the data gathered by an aggregate operation together with warnings
+// for the items that could not be read, so one unreachable cluster out of fifty
+// does not fail the whole operation.
+type Result[T any] struct {
+	Items    []T
+	Warnings []Warning
+}
+
+// HasWarnings returns true if any item failed.
+func (r *Result[T]) HasWarnings() bool {
+	return len(r.Warnings) > 0
+}
+
+// Err joins all warnings into a single error, or returns nil if there are none.
+// Use it to treat partial results as a failure.
+func (r *Result[T]) Err() error {
+	if len(r.Warnings) == 0 {
+		return nil
+	}
+	errs := make([]error, len(r.Warnings))
+	for i, w := range r.Warnings {
+		errs[i] = w
+	}
+	return errors.Join(errs...)
+}
+
+// fanOut calls fn for every key with at most concurrency calls in flight and
+// gathers the results. Failed keys are recorded as warnings, in key order.
+func fanOut[T any](ctx context.Context, keys []string, concurrency int, fn func(ctx context.Context, key string) ([]T, error)) *Result[T] {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	items := make([][]T, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			items[i], errs[i] = fn(ctx, key)
+		}(i, key)
+	}
+	wg.Wait()
+
+	result := &Result[T]{}
+	for i, key := range keys {
+		if errs[i] != nil {
+			result.Warnings = append(result.Warnings, Warning{Resource: key, Err: errs[i]})
+			continue
+		}
+		result.Items = append(result.Items, items[i]...)
+	}
+	return result
+}
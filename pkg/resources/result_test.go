@@ -0,0 +1,46 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestClusterManager_ListAllClustersReturnsPartialResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/org/v2/environments":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []map[string]string{{"id": "env-1"}, {"id": "env-2"}, {"id": "env-3"}},
+			})
+		case r.URL.Query().Get("environment") == "env-2":
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": "forbidden"})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []map[string]string{{"id": "lkc-" + r.URL.Query().Get("environment")}},
+			})
+		}
+	}))
+	defer server.Close()
+
+	mgr := resources.NewClusterManager(newTestClient(t, server.URL))
+	result, err := mgr.ListAllClusters(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("ListAllClusters failed: %v", err)
+	}
+	if len(result.Items) != 2 {
+		t.Errorf("Expected 2 clusters, got %d", len(result.Items))
+	}
+	if !result.HasWarnings() || len(result.Warnings) != 1 || result.Warnings[0].Resource != "env-2" {
+		t.Fatalf("Expected one warning for env-2, got %+v", result.Warnings)
+	}
+	if result.Err() == nil {
+		t.Error("Expected Err() to report the warning")
+	}
+}
@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Schema Registry (Stream Governance) packages.
+const (
+	SchemaRegistryPackageEssentials = "ESSENTIALS"
+	SchemaRegistryPackageAdvanced   = "ADVANCED"
+)
+
+// ErrNoSchemaRegistryCluster is returned by GetEndpoint when an environment has no
+// Schema Registry cluster.
+var ErrNoSchemaRegistryCluster = errors.New("environment has no schema registry cluster")
+
+// SchemaRegistryClusterManager handles Schema Registry cluster lifecycle via the srcm/v2 REST API.
+type SchemaRegistryClusterManager struct {
+	client client.Doer
+}
+
+// NewSchemaRegistryClusterManager creates a new Schema Registry cluster manager.
+func NewSchemaRegistryClusterManager(c client.Doer) *SchemaRegistryClusterManager {
+	return &SchemaRegistryClusterManager{client: c}
+}
+
+// srClusterResource is the srcm/v2 wire format of a cluster.
+type srClusterResource struct {
+	ID   string `json:"id"`
+	Spec struct {
+		DisplayName         string `json:"display_name"`
+		Package             string `json:"package"`
+		Cloud               string `json:"cloud"`
+		Region              string `json:"region"`
+		HTTPEndpoint        string `json:"http_endpoint"`
+		PrivateHTTPEndpoint string `json:"private_http_endpoint"`
+		CatalogHTTPEndpoint string `json:"catalog_http_endpoint"`
+		Environment         struct {
+			ID string `json:"id"`
+		} `json:"environment"`
+	} `json:"spec"`
+	Status struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+func (r srClusterResource) cluster() api.SchemaRegistryCluster {
+	return api.SchemaRegistryCluster{
+		ID:                  r.ID,
+		DisplayName:         r.Spec.DisplayName,
+		EnvironmentID:       r.Spec.Environment.ID,
+		Package:             r.Spec.Package,
+		Cloud:               r.Spec.Cloud,
+		Region:              r.Spec.Region,
+		HTTPEndpoint:        r.Spec.HTTPEndpoint,
+		PrivateHTTPEndpoint: r.Spec.PrivateHTTPEndpoint,
+		CatalogHTTPEndpoint: r.Spec.CatalogHTTPEndpoint,
+		Phase:               r.Status.Phase,
+	}
+}
+
+// ListClusters lists the Schema Registry clusters in an environment.
+// Returns errors:
+//   - *api.Error with IsNotFound() for invalid environment ID
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (sm *SchemaRegistryClusterManager) ListClusters(ctx context.Context, environmentID string) ([]api.SchemaRegistryCluster, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/srcm/v2/clusters?environment=%s", url.QueryEscape(environmentID)),
+	}
+
+	resp, err := sm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schema registry clusters: %w", err)
+	}
+
+	var result struct {
+		Data []srClusterResource `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse schema registry cluster list response: %w", err)
+	}
+
+	clusters := make([]api.SchemaRegistryCluster, len(result.Data))
+	for i, r := range result.Data {
+		clusters[i] = r.cluster()
+	}
+	return clusters, nil
+}
+
+// GetCluster retrieves a Schema Registry cluster.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (sm *SchemaRegistryClusterManager) GetCluster(ctx context.Context, environmentID string, clusterID string) (*api.SchemaRegistryCluster, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/srcm/v2/clusters/%s?environment=%s", url.PathEscape(clusterID), url.QueryEscape(environmentID)),
+	}
+
+	resp, err := sm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe schema registry cluster %s: %w", clusterID, err)
+	}
+
+	var r srClusterResource
+	if err := resp.DecodeJSON(&r); err != nil {
+		return nil, fmt.Errorf("failed to parse schema registry cluster response: %w", err)
+	}
+	cluster := r.cluster()
+	return &cluster, nil
+}
+
+// CreateCluster enables Schema Registry in an environment with the given package
+// (SchemaRegistryPackageEssentials or SchemaRegistryPackageAdvanced) in a region from
+// ListRegions. Newer organizations have Schema Registry provisioned automatically with
+// the first Kafka cluster, in which case this returns a conflict.
+// Returns errors:
+//   - *api.Error with IsBadRequest() if the package or region is invalid
+//   - *api.Error with IsConflict() if the environment already has a cluster
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (sm *SchemaRegistryClusterManager) CreateCluster(ctx context.Context, environmentID string, pkg string, regionID string) (*api.SchemaRegistryCluster, error) {
+	body := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"package": pkg,
+			"environment": map[string]string{
+				"id": environmentID,
+			},
+			"region": map[string]string{
+				"id": regionID,
+			},
+		},
+	}
+
+	req := client.Request{
+		Method: "POST",
+		Path:   "/srcm/v2/clusters",
+		Body:   body,
+	}
+
+	resp, err := sm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schema registry cluster: %w", err)
+	}
+
+	var r srClusterResource
+	if err := resp.DecodeJSON(&r); err != nil {
+		return nil, fmt.Errorf("failed to parse create schema registry cluster response: %w", err)
+	}
+	cluster := r.cluster()
+	return &cluster, nil
+}
+
+// DeleteCluster disables Schema Registry in an environment, deleting every schema in it.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the cluster does not exist
+//   - *api.Error with IsForbidden() if user lacks permissions
+func (sm *SchemaRegistryClusterManager) DeleteCluster(ctx context.Context, environmentID string, clusterID string) error {
+	req := client.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/srcm/v2/clusters/%s?environment=%s", url.PathEscape(clusterID), url.QueryEscape(environmentID)),
+	}
+
+	if _, err := sm.client.Do(ctx, req); err != nil {
+		return fmt.Errorf("failed to delete schema registry cluster %s: %w", clusterID, err)
+	}
+	return nil
+}
+
+// ListRegions lists the regions where Schema Registry can be provisioned. Empty cloud,
+// regionName, or pkg arguments do not filter.
+func (sm *SchemaRegistryClusterManager) ListRegions(ctx context.Context, cloud string, regionName string, pkg string) ([]api.SchemaRegistryRegion, error) {
+	q := url.Values{}
+	if cloud != "" {
+		q.Set("spec.cloud", cloud)
+	}
+	if regionName != "" {
+		q.Set("spec.region_name", regionName)
+	}
+	if pkg != "" {
+		q.Set("spec.package", pkg)
+	}
+	path := "/srcm/v2/regions"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	resp, err := sm.client.Do(ctx, client.Request{Method: "GET", Path: path})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schema registry regions: %w", err)
+	}
+
+	var result struct {
+		Data []struct {
+			ID   string `json:"id"`
+			Spec struct {
+				DisplayName string   `json:"display_name"`
+				Cloud       string   `json:"cloud"`
+				RegionName  string   `json:"region_name"`
+				Packages    []string `json:"packages"`
+			} `json:"spec"`
+		} `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse schema registry region list response: %w", err)
+	}
+
+	regions := make([]api.SchemaRegistryRegion, len(result.Data))
+	for i, r := range result.Data {
+		regions[i] = api.SchemaRegistryRegion{
+			ID:          r.ID,
+			DisplayName: r.Spec.DisplayName,
+			Cloud:       r.Spec.Cloud,
+			RegionName:  r.Spec.RegionName,
+			Packages:    r.Spec.Packages,
+		}
+	}
+	return regions, nil
+}
+
+// GetEndpoint returns the public HTTP endpoint of an environment's Schema Registry
+// cluster, suitable for client.Config.Endpoints["schema-registry"] or a dedicated
+// client's BaseURL. Returns ErrNoSchemaRegistryCluster if the environment has none.
+func (sm *SchemaRegistryClusterManager) GetEndpoint(ctx context.Context, environmentID string) (string, error) {
+	clusters, err := sm.ListClusters(ctx, environmentID)
+	if err != nil {
+		return "", err
+	}
+	if len(clusters) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrNoSchemaRegistryCluster, environmentID)
+	}
+	return clusters[0].HTTPEndpoint, nil
+}
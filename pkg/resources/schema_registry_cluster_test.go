@@ -0,0 +1,109 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestSchemaRegistryClusterManager_GetEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/srcm/v2/clusters" {
+			t.Errorf("Expected path /srcm/v2/clusters, got %s", r.URL.Path)
+		}
+		data := []map[string]interface{}{}
+		if r.URL.Query().Get("environment") == "env-123" {
+			data = append(data, map[string]interface{}{
+				"id": "lsrc-abc",
+				"spec": map[string]interface{}{
+					"display_name":  "Stream Governance Package",
+					"package":       "ESSENTIALS",
+					"cloud":         "AWS",
+					"region":        "us-east-2",
+					"http_endpoint": "https://psrc-abc.us-east-2.aws.confluent.cloud",
+					"environment":   map[string]string{"id": "env-123"},
+				},
+				"status": map[string]string{"phase": "PROVISIONED"},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"data": data}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewSchemaRegistryClusterManager(c)
+
+	clusters, err := mgr.ListClusters(context.Background(), "env-123")
+	if err != nil {
+		t.Fatalf("ListClusters failed: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].EnvironmentID != "env-123" || clusters[0].Phase != "PROVISIONED" {
+		t.Fatalf("Unexpected clusters: %#v", clusters)
+	}
+
+	endpoint, err := mgr.GetEndpoint(context.Background(), "env-123")
+	if err != nil {
+		t.Fatalf("GetEndpoint failed: %v", err)
+	}
+	if endpoint != "https://psrc-abc.us-east-2.aws.confluent.cloud" {
+		t.Errorf("Unexpected endpoint %s", endpoint)
+	}
+
+	if _, err := mgr.GetEndpoint(context.Background(), "env-empty"); !errors.Is(err, resources.ErrNoSchemaRegistryCluster) {
+		t.Errorf("Expected ErrNoSchemaRegistryCluster, got %v", err)
+	}
+}
+
+func TestSchemaRegistryClusterManager_CreateCluster(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/srcm/v2/clusters" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Spec struct {
+				Package     string `json:"package"`
+				Environment struct {
+					ID string `json:"id"`
+				} `json:"environment"`
+				Region struct {
+					ID string `json:"id"`
+				} `json:"region"`
+			} `json:"spec"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Spec.Package != "ADVANCED" || body.Spec.Environment.ID != "env-123" || body.Spec.Region.ID != "sgreg-1" {
+			t.Errorf("Unexpected body: %#v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "lsrc-new",
+			"spec":   map[string]interface{}{"package": "ADVANCED", "environment": map[string]string{"id": "env-123"}},
+			"status": map[string]string{"phase": "PROVISIONING"},
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewSchemaRegistryClusterManager(c)
+
+	cluster, err := mgr.CreateCluster(context.Background(), "env-123", resources.SchemaRegistryPackageAdvanced, "sgreg-1")
+	if err != nil {
+		t.Fatalf("CreateCluster failed: %v", err)
+	}
+	if cluster.ID != "lsrc-new" || cluster.Phase != "PROVISIONING" {
+		t.Errorf("Unexpected cluster: %#v", cluster)
+	}
+}
@@ -11,11 +11,11 @@ import (
 
 // ServiceAccountManager handles service account operations via REST API.
 type ServiceAccountManager struct {
-	client *client.Client
+	client client.Doer
 }
 
 // NewServiceAccountManager creates a new service account manager.
-func NewServiceAccountManager(c *client.Client) *ServiceAccountManager {
+func NewServiceAccountManager(c client.Doer) *ServiceAccountManager {
 	return &ServiceAccountManager{client: c}
 }
 
@@ -166,13 +166,41 @@ func (sam *ServiceAccountManager) UpdateServiceAccount(ctx context.Context, serv
 //   - *api.Error with IsForbidden() if user lacks permissions
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (sam *ServiceAccountManager) CreateAPIKey(ctx context.Context, serviceAccountID string, description string) (*api.APIKey, error) {
-	body := map[string]interface{}{
-		"spec": map[string]interface{}{
-			"owner": map[string]string{
-				"id": serviceAccountID,
-			},
-			"description": description,
+	return sam.createAPIKey(ctx, serviceAccountID, description, nil)
+}
+
+// CreateAPIKeyForResource creates a new API key for a service account that is scoped to a
+// single resource, such as a Kafka cluster (lkc-...) or a Schema Registry cluster (lsrc-...).
+// Schema Registry and Kafka REST calls reject cloud API keys, so keys for them must be
+// created with this method. The Schema Registry cluster ID of an environment can be found
+// with SchemaRegistryClusterManager.ListClusters.
+// The API key secret is only returned once and cannot be retrieved later.
+// Returns errors:
+//   - *api.Error with IsNotFound() if the service account or resource does not exist
+//   - *api.Error with IsBadRequest() if parameters are invalid
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks permissions
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (sam *ServiceAccountManager) CreateAPIKeyForResource(ctx context.Context, serviceAccountID string, description string, resourceID string, environmentID string) (*api.APIKey, error) {
+	resource := map[string]string{
+		"id":          resourceID,
+		"environment": environmentID,
+	}
+	return sam.createAPIKey(ctx, serviceAccountID, description, resource)
+}
+
+func (sam *ServiceAccountManager) createAPIKey(ctx context.Context, serviceAccountID string, description string, resource map[string]string) (*api.APIKey, error) {
+	spec := map[string]interface{}{
+		"owner": map[string]string{
+			"id": serviceAccountID,
 		},
+		"description": description,
+	}
+	if resource != nil {
+		spec["resource"] = resource
+	}
+	body := map[string]interface{}{
+		"spec": spec,
 	}
 
 	req := client.Request{
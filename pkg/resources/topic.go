@@ -2,20 +2,43 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/creiche/confluent-go/pkg/api"
 	"github.com/creiche/confluent-go/pkg/client"
 )
 
+// Kafka REST v3 base paths. Confluent Cloud serves the API under /kafka/v3 on the
+// cluster's REST endpoint; Confluent Platform serves it under /v3 on the REST Proxy or
+// the broker-embedded REST server.
+const (
+	KafkaBasePathCloud    = "/kafka/v3"
+	KafkaBasePathPlatform = "/v3"
+)
+
 // TopicManager handles topic-related operations via REST API.
 type TopicManager struct {
-	client *client.Client
+	client   client.Doer
+	basePath string
+}
+
+// NewTopicManager creates a new topic manager for the Confluent Cloud Kafka REST API.
+func NewTopicManager(c client.Doer) *TopicManager {
+	return &TopicManager{client: c, basePath: KafkaBasePathCloud}
+}
+
+// WithBasePath sets the Kafka REST v3 base path, e.g. KafkaBasePathPlatform to target
+// a Confluent Platform REST Proxy or broker-embedded REST API (default KafkaBasePathCloud).
+func (tm *TopicManager) WithBasePath(basePath string) *TopicManager {
+	tm.basePath = strings.TrimSuffix(basePath, "/")
+	return tm
 }
 
-// NewTopicManager creates a new topic manager.
-func NewTopicManager(c *client.Client) *TopicManager {
-	return &TopicManager{client: c}
+func (tm *TopicManager) clusterPath(clusterID string) string {
+	return tm.basePath + "/clusters/" + clusterID
 }
 
 // ListTopics lists all topics in a cluster.
@@ -26,7 +49,7 @@ func NewTopicManager(c *client.Client) *TopicManager {
 func (tm *TopicManager) ListTopics(ctx context.Context, clusterID string) ([]api.Topic, error) {
 	req := client.Request{
 		Method: "GET",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/topics", clusterID),
+		Path:   fmt.Sprintf("%s/topics", tm.clusterPath(clusterID)),
 	}
 
 	resp, err := tm.client.Do(ctx, req)
@@ -35,40 +58,110 @@ func (tm *TopicManager) ListTopics(ctx context.Context, clusterID string) ([]api
 	}
 
 	var result struct {
-		Data []api.Topic `json:"data"`
+		Data []topicResource `json:"data"`
 	}
 	if err := resp.DecodeJSON(&result); err != nil {
 		return nil, fmt.Errorf("failed to parse topic list response: %w", err)
 	}
 
-	return result.Data, nil
+	topics := make([]api.Topic, len(result.Data))
+	for i, r := range result.Data {
+		topics[i] = r.topic()
+	}
+	return topics, nil
+}
+
+// ListTopicsWithConfigs lists all topics in a cluster with Config populated with every
+// topic's effective config values, using two requests regardless of the number of
+// topics. Sensitive configs, whose values the API does not return, are omitted. To
+// populate only explicitly set configs, combine ListTopics, GetAllTopicConfigs, and
+// OverriddenTopicConfigs instead.
+// Returns errors:
+//   - *api.Error with IsNotFound() if cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (tm *TopicManager) ListTopicsWithConfigs(ctx context.Context, clusterID string) ([]api.Topic, error) {
+	topics, err := tm.ListTopics(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := tm.GetAllTopicConfigs(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range topics {
+		topics[i].Config = make(map[string]string, len(configs[topics[i].Name]))
+		for _, c := range configs[topics[i].Name] {
+			if c.IsSensitive {
+				continue
+			}
+			topics[i].Config[c.Name] = c.Value
+		}
+	}
+	return topics, nil
+}
+
+// topicResource is the Kafka REST v3 wire format of a topic, which names fields
+// differently from api.Topic. The api.Topic names are accepted too.
+type topicResource struct {
+	api.Topic
+	TopicName       string `json:"topic_name"`
+	PartitionsCount int32  `json:"partitions_count"`
+}
+
+func (r topicResource) topic() api.Topic {
+	t := r.Topic
+	if t.Name == "" {
+		t.Name = r.TopicName
+	}
+	if t.PartitionCount == 0 {
+		t.PartitionCount = r.PartitionsCount
+	}
+	return t
 }
 
 // GetTopic retrieves information about a specific topic.
 // Returns errors:
-//   - *api.Error with IsNotFound() if topic does not exist
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
+//   - *api.Error with IsNotFound() if cluster does not exist
 //   - *api.Error with IsUnauthorized() for authentication failures
 //   - *api.Error with IsForbidden() if user lacks permissions
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (tm *TopicManager) GetTopic(ctx context.Context, clusterID string, topicName string) (*api.Topic, error) {
 	req := client.Request{
 		Method: "GET",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s", clusterID, topicName),
+		Path:   fmt.Sprintf("%s/topics/%s", tm.clusterPath(clusterID), topicName),
 	}
 
 	resp, err := tm.client.Do(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to describe topic %s: %w", topicName, err)
+		return nil, fmt.Errorf("failed to describe topic %s: %w", topicName, topicError(err))
 	}
 
-	var topic api.Topic
-	if err := resp.DecodeJSON(&topic); err != nil {
+	var r topicResource
+	if err := resp.DecodeJSON(&r); err != nil {
 		return nil, fmt.Errorf("failed to parse topic description: %w", err)
 	}
 
+	topic := r.topic()
 	return &topic, nil
 }
 
+// TopicExists reports whether a topic exists. Unlike checking GetTopic for any error,
+// authentication, permission, and network failures, as well as an unknown cluster,
+// are returned as errors rather than reported as a missing topic.
+func (tm *TopicManager) TopicExists(ctx context.Context, clusterID string, topicName string) (bool, error) {
+	_, err := tm.GetTopic(ctx, clusterID, topicName)
+	if errors.Is(err, ErrTopicNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // CreateTopic creates a new topic.
 // Returns errors:
 //   - *api.Error with IsBadRequest() if parameters are invalid
@@ -85,7 +178,7 @@ func (tm *TopicManager) CreateTopic(ctx context.Context, clusterID string, topic
 
 	req := client.Request{
 		Method: "POST",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/topics", clusterID),
+		Path:   fmt.Sprintf("%s/topics", tm.clusterPath(clusterID)),
 		Body:   body,
 	}
 
@@ -99,19 +192,19 @@ func (tm *TopicManager) CreateTopic(ctx context.Context, clusterID string, topic
 
 // DeleteTopic deletes a topic.
 // Returns errors:
-//   - *api.Error with IsNotFound() if topic does not exist
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
 //   - *api.Error with IsUnauthorized() for authentication failures
 //   - *api.Error with IsForbidden() if user lacks permissions
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (tm *TopicManager) DeleteTopic(ctx context.Context, clusterID string, topicName string) error {
 	req := client.Request{
 		Method: "DELETE",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s", clusterID, topicName),
+		Path:   fmt.Sprintf("%s/topics/%s", tm.clusterPath(clusterID), topicName),
 	}
 
 	_, err := tm.client.Do(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to delete topic %s: %w", topicName, err)
+		return fmt.Errorf("failed to delete topic %s: %w", topicName, topicError(err))
 	}
 	return nil
 }
@@ -119,7 +212,7 @@ func (tm *TopicManager) DeleteTopic(ctx context.Context, clusterID string, topic
 // UpdateTopicConfig updates topic configuration.
 // Returns errors:
 //   - *api.Error with IsBadRequest() if config values are invalid
-//   - *api.Error with IsNotFound() if topic does not exist
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
 //   - *api.Error with IsUnauthorized() for authentication failures
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (tm *TopicManager) UpdateTopicConfig(ctx context.Context, clusterID string, topicName string, configs map[string]string) error {
@@ -130,13 +223,61 @@ func (tm *TopicManager) UpdateTopicConfig(ctx context.Context, clusterID string,
 
 	req := client.Request{
 		Method: "PATCH",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s", clusterID, topicName),
+		Path:   fmt.Sprintf("%s/topics/%s", tm.clusterPath(clusterID), topicName),
 		Body:   body,
 	}
 
 	_, err := tm.client.Do(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to update topic config %s: %w", topicName, err)
+		return fmt.Errorf("failed to update topic config %s: %w", topicName, topicError(err))
+	}
+
+	return nil
+}
+
+// UpdatePartitionCount increases the number of partitions of a topic. Requesting the
+// current count is a no-op. Adding partitions changes the partition keyed records map
+// to, so per-key ordering is not preserved across the change.
+// Returns errors:
+//   - *PartitionDecreaseError if partitions is lower than the current count
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
+//   - *api.Error with IsBadRequest() if the count exceeds cluster limits
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (tm *TopicManager) UpdatePartitionCount(ctx context.Context, clusterID string, topicName string, partitions int32) error {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/topics/%s", tm.clusterPath(clusterID), topicName),
+	}
+
+	resp, err := tm.client.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to describe topic %s: %w", topicName, topicError(err))
+	}
+
+	var current struct {
+		PartitionsCount int32 `json:"partitions_count"`
+	}
+	if err := resp.DecodeJSON(&current); err != nil {
+		return fmt.Errorf("failed to parse topic description: %w", err)
+	}
+	if partitions < current.PartitionsCount {
+		return &PartitionDecreaseError{Topic: topicName, Current: current.PartitionsCount, Requested: partitions}
+	}
+	if partitions == current.PartitionsCount {
+		return nil
+	}
+
+	req = client.Request{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("%s/topics/%s", tm.clusterPath(clusterID), topicName),
+		Body: map[string]interface{}{
+			"partitions_count": partitions,
+		},
+	}
+
+	if _, err := tm.client.Do(ctx, req); err != nil {
+		return fmt.Errorf("failed to update partition count of topic %s: %w", topicName, topicError(err))
 	}
 
 	return nil
@@ -144,18 +285,18 @@ func (tm *TopicManager) UpdateTopicConfig(ctx context.Context, clusterID string,
 
 // GetTopicConfig retrieves topic configurations.
 // Returns errors:
-//   - *api.Error with IsNotFound() if topic does not exist
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
 //   - *api.Error with IsUnauthorized() for authentication failures
 //   - *api.Error with IsRateLimited() if rate limit is exceeded
 func (tm *TopicManager) GetTopicConfig(ctx context.Context, clusterID string, topicName string) ([]api.TopicConfig, error) {
 	req := client.Request{
 		Method: "GET",
-		Path:   fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/configs", clusterID, topicName),
+		Path:   fmt.Sprintf("%s/topics/%s/configs", tm.clusterPath(clusterID), topicName),
 	}
 
 	resp, err := tm.client.Do(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get topic config: %w", err)
+		return nil, fmt.Errorf("failed to get topic config: %w", topicError(err))
 	}
 
 	var result struct {
@@ -168,6 +309,177 @@ func (tm *TopicManager) GetTopicConfig(ctx context.Context, clusterID string, to
 	return result.Data, nil
 }
 
+// Config sources reported in api.TopicConfig.Source and api.ConfigSynonym.Source.
+const (
+	ConfigSourceDynamicTopic         = "DYNAMIC_TOPIC_CONFIG"
+	ConfigSourceDynamicBroker        = "DYNAMIC_BROKER_CONFIG"
+	ConfigSourceDynamicDefaultBroker = "DYNAMIC_DEFAULT_BROKER_CONFIG"
+	ConfigSourceStaticBroker         = "STATIC_BROKER_CONFIG"
+	ConfigSourceDefault              = "DEFAULT_CONFIG"
+	ConfigSourceUnknown              = "UNKNOWN"
+)
+
+// GetAllTopicConfigs retrieves the configs of every topic in a cluster in a single
+// request (GET .../topics/-/configs), keyed by topic name. On large clusters this
+// replaces one GetTopicConfig call per topic.
+// Returns errors:
+//   - *api.Error with IsNotFound() if cluster does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (tm *TopicManager) GetAllTopicConfigs(ctx context.Context, clusterID string) (map[string][]api.TopicConfig, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/topics/-/configs", tm.clusterPath(clusterID)),
+	}
+
+	resp, err := tm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic configs: %w", err)
+	}
+
+	var result struct {
+		Data []struct {
+			TopicName string `json:"topic_name"`
+			api.TopicConfig
+		} `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse topic configs response: %w", err)
+	}
+
+	configs := make(map[string][]api.TopicConfig)
+	for _, c := range result.Data {
+		configs[c.TopicName] = append(configs[c.TopicName], c.TopicConfig)
+	}
+	return configs, nil
+}
+
+// GetTopicConfigEntry retrieves a single topic configuration, including whether it
+// is defaulted and its synonyms.
+// Returns errors:
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
+//   - *api.Error with IsNotFound() if config does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (tm *TopicManager) GetTopicConfigEntry(ctx context.Context, clusterID string, topicName string, configName string) (*api.TopicConfig, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/topics/%s/configs/%s", tm.clusterPath(clusterID), topicName, configName),
+	}
+
+	resp, err := tm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic config %s: %w", configName, topicError(err))
+	}
+
+	var config api.TopicConfig
+	if err := resp.DecodeJSON(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse topic config response: %w", err)
+	}
+
+	return &config, nil
+}
+
+// OverriddenTopicConfigs returns the configs set on the topic itself as a map, skipping
+// defaulted values and values inherited from the broker, so reconcilers can diff desired
+// configs against only what was explicitly set.
+func OverriddenTopicConfigs(configs []api.TopicConfig) map[string]string {
+	out := make(map[string]string)
+	for _, c := range configs {
+		if c.IsDefault || c.Source != ConfigSourceDynamicTopic {
+			continue
+		}
+		out[c.Name] = c.Value
+	}
+	return out
+}
+
+// Outcomes of CreateTopics and DeleteTopics.
+const (
+	TopicCreated      = "created"
+	TopicEnsureExists = "exists"
+	TopicDeleted      = "deleted"
+	TopicNotFound     = "not_found"
+	TopicFailed       = "failed"
+)
+
+// kafkaErrorTopicExists is the Kafka REST error code for creating an existing topic.
+const kafkaErrorTopicExists = 40002
+
+// TopicResult is the outcome of one topic in CreateTopics or DeleteTopics.
+type TopicResult struct {
+	Name string
+	// Status is TopicCreated, TopicEnsureExists, TopicDeleted, TopicNotFound, or TopicFailed
+	Status string
+	// Err is set when Status is TopicFailed; it wraps the *api.Error returned by the API, if any
+	Err error
+}
+
+// CreateTopics creates topics concurrently, bounded by opts.Concurrency, and returns one
+// result per topic in input order. Topics that already exist are reported as TopicEnsureExists
+// rather than failures, so provisioning pipelines can be rerun. If any topic failed, the
+// error is a *client.BatchError indexed like topics.
+func (tm *TopicManager) CreateTopics(ctx context.Context, clusterID string, topics []api.Topic, opts client.BatchOptions) ([]TopicResult, error) {
+	results := make([]TopicResult, len(topics))
+	fns := make([]func(ctx context.Context) error, len(topics))
+	for i, topic := range topics {
+		i, topic := i, topic
+		results[i] = TopicResult{Name: topic.Name}
+		fns[i] = func(ctx context.Context) error {
+			err := tm.CreateTopic(ctx, clusterID, topic)
+			switch {
+			case err == nil:
+				results[i].Status = TopicCreated
+			case isTopicExists(err):
+				results[i].Status, err = TopicEnsureExists, nil
+			default:
+				results[i].Status, results[i].Err = TopicFailed, err
+			}
+			return err
+		}
+	}
+	return results, client.BatchFuncs(ctx, fns, opts)
+}
+
+// DeleteTopics deletes topics concurrently, bounded by opts.Concurrency, and returns one
+// result per topic in input order. Topics that do not exist are reported as TopicNotFound
+// rather than failures. If any topic failed, the error is a *client.BatchError indexed
+// like names.
+func (tm *TopicManager) DeleteTopics(ctx context.Context, clusterID string, names []string, opts client.BatchOptions) ([]TopicResult, error) {
+	results := make([]TopicResult, len(names))
+	fns := make([]func(ctx context.Context) error, len(names))
+	for i, name := range names {
+		i, name := i, name
+		results[i] = TopicResult{Name: name}
+		fns[i] = func(ctx context.Context) error {
+			err := tm.DeleteTopic(ctx, clusterID, name)
+			switch {
+			case err == nil:
+				results[i].Status = TopicDeleted
+			case errors.Is(err, ErrTopicNotFound):
+				results[i].Status, err = TopicNotFound, nil
+			default:
+				results[i].Status, results[i].Err = TopicFailed, err
+			}
+			return err
+		}
+	}
+	return results, client.BatchFuncs(ctx, fns, opts)
+}
+
+// isTopicExists reports whether err is the API rejecting an existing topic name.
+func isTopicExists(err error) bool {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.IsConflict() {
+		return true
+	}
+	code, _ := apiErr.Details["error_code"].(float64)
+	return int(code) == kafkaErrorTopicExists
+}
+
 // Helper function to convert map to array format for API
 func topicConfigsToArray(configs map[string]string) []map[string]string {
 	configArray := make([]map[string]string, 0, len(configs))
@@ -179,3 +491,163 @@ func topicConfigsToArray(configs map[string]string) []map[string]string {
 	}
 	return configArray
 }
+
+// ListPartitions lists the partitions of a topic with their leader, replicas, and
+// in-sync replicas, ordered by partition ID. It makes one request for the partition
+// list plus one per partition for its replicas.
+// Returns errors:
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (tm *TopicManager) ListPartitions(ctx context.Context, clusterID string, topicName string) ([]api.PartitionInfo, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/topics/%s/partitions", tm.clusterPath(clusterID), topicName),
+	}
+
+	resp, err := tm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of topic %s: %w", topicName, topicError(err))
+	}
+
+	var result struct {
+		Data []struct {
+			PartitionID int32 `json:"partition_id"`
+		} `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse partition list response: %w", err)
+	}
+
+	partitions := make([]api.PartitionInfo, 0, len(result.Data))
+	for _, p := range result.Data {
+		info, err := tm.GetPartition(ctx, clusterID, topicName, p.PartitionID)
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, *info)
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].Partition < partitions[j].Partition
+	})
+
+	return partitions, nil
+}
+
+// GetPartition retrieves the leader, replicas, and in-sync replicas of a topic partition.
+// Leader is -1 when the partition is offline.
+// Returns errors:
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
+//   - *api.Error with IsNotFound() if partition does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (tm *TopicManager) GetPartition(ctx context.Context, clusterID string, topicName string, partitionID int32) (*api.PartitionInfo, error) {
+	req := client.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/topics/%s/partitions/%d/replicas", tm.clusterPath(clusterID), topicName, partitionID),
+	}
+
+	resp, err := tm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe partition %d of topic %s: %w", partitionID, topicName, topicError(err))
+	}
+
+	var result struct {
+		Data []struct {
+			BrokerID int32 `json:"broker_id"`
+			IsLeader bool  `json:"is_leader"`
+			IsInSync bool  `json:"is_in_sync"`
+		} `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse partition replicas response: %w", err)
+	}
+
+	info := &api.PartitionInfo{
+		Topic:     topicName,
+		Partition: partitionID,
+		Leader:    -1,
+		Replicas:  make([]int32, 0, len(result.Data)),
+		ISR:       make([]int32, 0, len(result.Data)),
+	}
+	for _, r := range result.Data {
+		info.Replicas = append(info.Replicas, r.BrokerID)
+		if r.IsInSync {
+			info.ISR = append(info.ISR, r.BrokerID)
+		}
+		if r.IsLeader {
+			info.Leader = r.BrokerID
+		}
+	}
+
+	return info, nil
+}
+
+// DeleteRecordsToEnd deletes every record of a partition when used as the offset in
+// DeleteRecords; it stands for the partition's current high watermark.
+const DeleteRecordsToEnd int64 = -1
+
+// DeleteRecordsResult is the outcome of DeleteRecords for one partition.
+type DeleteRecordsResult struct {
+	PartitionID int32
+	// LowWatermark is the partition's new earliest offset
+	LowWatermark int64
+	// Err is the *api.Error reported for this partition, or nil if its records were deleted
+	Err error
+}
+
+// DeleteRecords deletes the records of a topic before the given offset of each
+// partition, keyed by partition ID, e.g. for retention cleanup or erasure requests.
+// Use DeleteRecordsToEnd to empty a partition. Deleted records cannot be recovered,
+// and compacted topics reject the request.
+// The returned error is only set when the request itself failed; per-partition
+// failures, such as an offset beyond the high watermark, are reported in each result.
+// Returns errors:
+//   - ErrTopicNotFound (with errors.Is), wrapping *api.Error with IsNotFound(), if topic does not exist
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if user lacks delete permission on the topic
+func (tm *TopicManager) DeleteRecords(ctx context.Context, clusterID string, topicName string, partitionOffsets map[int32]int64) ([]DeleteRecordsResult, error) {
+	if len(partitionOffsets) == 0 {
+		return nil, nil
+	}
+	partitions := make([]map[string]interface{}, 0, len(partitionOffsets))
+	for p, offset := range partitionOffsets {
+		partitions = append(partitions, map[string]interface{}{"partition_id": p, "offset": offset})
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i]["partition_id"].(int32) < partitions[j]["partition_id"].(int32)
+	})
+
+	req := client.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/topics/%s/delete-records", tm.clusterPath(clusterID), topicName),
+		Body:   map[string]interface{}{"partitions": partitions},
+	}
+
+	resp, err := tm.client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete records of topic %s: %w", topicName, topicError(err))
+	}
+
+	var result struct {
+		Data []struct {
+			PartitionID  int32  `json:"partition_id"`
+			LowWatermark int64  `json:"low_watermark"`
+			ErrorCode    int    `json:"error_code"`
+			ErrorMessage string `json:"error_message"`
+		} `json:"data"`
+	}
+	if err := resp.DecodeJSON(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse delete records response: %w", err)
+	}
+
+	results := make([]DeleteRecordsResult, len(result.Data))
+	for i, r := range result.Data {
+		results[i] = DeleteRecordsResult{
+			PartitionID:  r.PartitionID,
+			LowWatermark: r.LowWatermark,
+			Err:          kafkaRESTError(r.ErrorCode, r.ErrorMessage),
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidTopicConfig is wrapped by TopicConfigBuilder.Build for every value that
+// fails client-side validation.
+var ErrInvalidTopicConfig = errors.New("invalid topic config")
+
+// Topic config keys set by TopicConfigBuilder.
+const (
+	TopicConfigCleanupPolicy        = "cleanup.policy"
+	TopicConfigCompressionType      = "compression.type"
+	TopicConfigDeleteRetentionMs    = "delete.retention.ms"
+	TopicConfigMaxCompactionLagMs   = "max.compaction.lag.ms"
+	TopicConfigMaxMessageBytes      = "max.message.bytes"
+	TopicConfigMessageTimestampType = "message.timestamp.type"
+	TopicConfigMinCompactionLagMs   = "min.compaction.lag.ms"
+	TopicConfigMinInsyncReplicas    = "min.insync.replicas"
+	TopicConfigRetentionBytes       = "retention.bytes"
+	TopicConfigRetentionMs          = "retention.ms"
+	TopicConfigSegmentBytes         = "segment.bytes"
+	TopicConfigSegmentMs            = "segment.ms"
+)
+
+// Values of compression.type.
+const (
+	CompressionProducer     = "producer"
+	CompressionUncompressed = "uncompressed"
+	CompressionGzip         = "gzip"
+	CompressionSnappy       = "snappy"
+	CompressionLZ4          = "lz4"
+	CompressionZstd         = "zstd"
+)
+
+// minSegmentBytes is the smallest segment.bytes Kafka accepts.
+const minSegmentBytes = 14
+
+// TopicConfigBuilder builds a topic config map, such as api.Topic.Config or the
+// argument of UpdateTopicConfig, from typed setters for well-known keys, and validates
+// each value before any request is made:
+//
+//	configs, err := resources.NewTopicConfigBuilder().
+//		RetentionMs(7 * 24 * time.Hour).
+//		CleanupPolicyCompact().
+//		MinInsyncReplicas(2).
+//		Build()
+//
+// Limits that depend on the cluster type, such as the maximum max.message.bytes on
+// Confluent Cloud, are still enforced by the server.
+type TopicConfigBuilder struct {
+	configs map[string]string
+	errs    []error
+}
+
+// NewTopicConfigBuilder creates an empty topic config builder.
+func NewTopicConfigBuilder() *TopicConfigBuilder {
+	return &TopicConfigBuilder{configs: make(map[string]string)}
+}
+
+// Set sets a config without validation, for keys that have no typed setter.
+func (b *TopicConfigBuilder) Set(key string, value string) *TopicConfigBuilder {
+	b.configs[key] = value
+	return b
+}
+
+// RetentionMs sets how long records are retained. It must be positive, with millisecond
+// precision; use RetentionInfinite to retain records forever.
+func (b *TopicConfigBuilder) RetentionMs(d time.Duration) *TopicConfigBuilder {
+	return b.durationMs(TopicConfigRetentionMs, d, time.Millisecond)
+}
+
+// RetentionInfinite retains records regardless of age.
+func (b *TopicConfigBuilder) RetentionInfinite() *TopicConfigBuilder {
+	return b.Set(TopicConfigRetentionMs, "-1")
+}
+
+// RetentionBytes sets the maximum size of each partition before old segments are
+// deleted, or -1 for no size limit.
+func (b *TopicConfigBuilder) RetentionBytes(n int64) *TopicConfigBuilder {
+	if n < -1 || n == 0 {
+		return b.invalid(TopicConfigRetentionBytes, n, "must be positive or -1")
+	}
+	return b.Set(TopicConfigRetentionBytes, strconv.FormatInt(n, 10))
+}
+
+// CleanupPolicyDelete deletes segments once they exceed the retention limits.
+func (b *TopicConfigBuilder) CleanupPolicyDelete() *TopicConfigBuilder {
+	return b.Set(TopicConfigCleanupPolicy, "delete")
+}
+
+// CleanupPolicyCompact keeps only the latest record of each key.
+func (b *TopicConfigBuilder) CleanupPolicyCompact() *TopicConfigBuilder {
+	return b.Set(TopicConfigCleanupPolicy, "compact")
+}
+
+// CleanupPolicyCompactDelete compacts segments and also deletes them once they exceed
+// the retention limits.
+func (b *TopicConfigBuilder) CleanupPolicyCompactDelete() *TopicConfigBuilder {
+	return b.Set(TopicConfigCleanupPolicy, "compact,delete")
+}
+
+// MaxMessageBytes sets the largest record batch size the topic accepts.
+func (b *TopicConfigBuilder) MaxMessageBytes(n int) *TopicConfigBuilder {
+	if n <= 0 || n > math.MaxInt32 {
+		return b.invalid(TopicConfigMaxMessageBytes, n, "must be between 1 and 2147483647")
+	}
+	return b.Set(TopicConfigMaxMessageBytes, strconv.Itoa(n))
+}
+
+// MinInsyncReplicas sets how many replicas must acknowledge a write with acks=all.
+func (b *TopicConfigBuilder) MinInsyncReplicas(n int) *TopicConfigBuilder {
+	if n < 1 {
+		return b.invalid(TopicConfigMinInsyncReplicas, n, "must be at least 1")
+	}
+	return b.Set(TopicConfigMinInsyncReplicas, strconv.Itoa(n))
+}
+
+// CompressionType sets the compression codec of stored records, one of the
+// Compression* constants.
+func (b *TopicConfigBuilder) CompressionType(codec string) *TopicConfigBuilder {
+	switch codec {
+	case CompressionProducer, CompressionUncompressed, CompressionGzip, CompressionSnappy, CompressionLZ4, CompressionZstd:
+		return b.Set(TopicConfigCompressionType, codec)
+	}
+	return b.invalid(TopicConfigCompressionType, codec, "unknown codec")
+}
+
+// MessageTimestampLogAppendTime stamps records with the broker time instead of the
+// producer time.
+func (b *TopicConfigBuilder) MessageTimestampLogAppendTime() *TopicConfigBuilder {
+	return b.Set(TopicConfigMessageTimestampType, "LogAppendTime")
+}
+
+// MessageTimestampCreateTime keeps the timestamp set by the producer.
+func (b *TopicConfigBuilder) MessageTimestampCreateTime() *TopicConfigBuilder {
+	return b.Set(TopicConfigMessageTimestampType, "CreateTime")
+}
+
+// SegmentBytes sets the size at which a partition's active segment is rolled.
+func (b *TopicConfigBuilder) SegmentBytes(n int) *TopicConfigBuilder {
+	if n < minSegmentBytes || n > math.MaxInt32 {
+		return b.invalid(TopicConfigSegmentBytes, n, "must be between 14 and 2147483647")
+	}
+	return b.Set(TopicConfigSegmentBytes, strconv.Itoa(n))
+}
+
+// SegmentMs sets the age at which a partition's active segment is rolled.
+func (b *TopicConfigBuilder) SegmentMs(d time.Duration) *TopicConfigBuilder {
+	return b.durationMs(TopicConfigSegmentMs, d, time.Millisecond)
+}
+
+// DeleteRetentionMs sets how long tombstones are kept on compacted topics.
+func (b *TopicConfigBuilder) DeleteRetentionMs(d time.Duration) *TopicConfigBuilder {
+	return b.durationMs(TopicConfigDeleteRetentionMs, d, 0)
+}
+
+// MinCompactionLagMs sets the minimum age before a record can be compacted.
+func (b *TopicConfigBuilder) MinCompactionLagMs(d time.Duration) *TopicConfigBuilder {
+	return b.durationMs(TopicConfigMinCompactionLagMs, d, 0)
+}
+
+// MaxCompactionLagMs sets the maximum age before a record is eligible for compaction.
+func (b *TopicConfigBuilder) MaxCompactionLagMs(d time.Duration) *TopicConfigBuilder {
+	return b.durationMs(TopicConfigMaxCompactionLagMs, d, time.Millisecond)
+}
+
+// Build returns the config map, or an error wrapping ErrInvalidTopicConfig for every
+// invalid value. The builder can be reused after Build.
+func (b *TopicConfigBuilder) Build() (map[string]string, error) {
+	if len(b.errs) > 0 {
+		return nil, errors.Join(b.errs...)
+	}
+	configs := make(map[string]string, len(b.configs))
+	for k, v := range b.configs {
+		configs[k] = v
+	}
+	return configs, nil
+}
+
+// durationMs sets key to d in whole milliseconds, which must be at least min.
+func (b *TopicConfigBuilder) durationMs(key string, d time.Duration, min time.Duration) *TopicConfigBuilder {
+	if d < min {
+		return b.invalid(key, d, fmt.Sprintf("must be at least %s", min))
+	}
+	if d%time.Millisecond != 0 {
+		return b.invalid(key, d, "must be a whole number of milliseconds")
+	}
+	return b.Set(key, strconv.FormatInt(d.Milliseconds(), 10))
+}
+
+func (b *TopicConfigBuilder) invalid(key string, value interface{}, reason string) *TopicConfigBuilder {
+	b.errs = append(b.errs, fmt.Errorf("%w: %s=%v %s", ErrInvalidTopicConfig, key, value, reason))
+	return b
+}
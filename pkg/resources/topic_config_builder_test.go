@@ -0,0 +1,61 @@
+package resources_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestTopicConfigBuilder_Build(t *testing.T) {
+	configs, err := resources.NewTopicConfigBuilder().
+		RetentionMs(7*24*time.Hour).
+		CleanupPolicyCompactDelete().
+		MaxMessageBytes(2<<20).
+		MinInsyncReplicas(2).
+		CompressionType(resources.CompressionZstd).
+		Set("confluent.value.schema.validation", "true").
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"retention.ms":                      "604800000",
+		"cleanup.policy":                    "compact,delete",
+		"max.message.bytes":                 "2097152",
+		"min.insync.replicas":               "2",
+		"compression.type":                  "zstd",
+		"confluent.value.schema.validation": "true",
+	}
+	if len(configs) != len(expected) {
+		t.Errorf("Expected %d configs, got %v", len(expected), configs)
+	}
+	for k, v := range expected {
+		if configs[k] != v {
+			t.Errorf("Expected %s=%s, got %q", k, v, configs[k])
+		}
+	}
+}
+
+func TestTopicConfigBuilder_Invalid(t *testing.T) {
+	_, err := resources.NewTopicConfigBuilder().
+		RetentionMs(1500 * time.Microsecond).
+		MinInsyncReplicas(0).
+		CompressionType("brotli").
+		RetentionBytes(-1).
+		Build()
+	if !errors.Is(err, resources.ErrInvalidTopicConfig) {
+		t.Fatalf("Expected ErrInvalidTopicConfig, got %v", err)
+	}
+	for _, key := range []string{"retention.ms", "min.insync.replicas", "compression.type"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Expected error to mention %s, got %v", key, err)
+		}
+	}
+	if strings.Contains(err.Error(), "retention.bytes") {
+		t.Errorf("Expected retention.bytes=-1 to be valid, got %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Confluent Metrics API metrics queried by TopicMetricsManager.
+const (
+	MetricReceivedBytes = "io.confluent.kafka.server/received_bytes"
+	MetricSentBytes     = "io.confluent.kafka.server/sent_bytes"
+	MetricRetainedBytes = "io.confluent.kafka.server/retained_bytes"
+)
+
+// metricsDelay keeps queries clear of the last minutes, which the Metrics API has not
+// finished aggregating yet.
+const metricsDelay = "now-2m|m"
+
+// TopicMetricsManager queries per-topic metrics from the Confluent Metrics API v2.
+// The client must point at the Metrics API, e.g. https://api.telemetry.confluent.cloud,
+// with a Cloud API key that has the MetricsViewer role.
+type TopicMetricsManager struct {
+	client client.Doer
+}
+
+// NewTopicMetricsManager creates a new topic metrics manager.
+func NewTopicMetricsManager(c client.Doer) *TopicMetricsManager {
+	return &TopicMetricsManager{client: c}
+}
+
+// GetTopicMetrics returns the bytes produced and consumed over the last window, and the
+// bytes currently retained, of every topic of a cluster that has metrics, keyed by
+// topic name. window is rounded down to whole minutes, with a minimum of one minute.
+// Returns errors:
+//   - *api.Error with IsBadRequest() if the query is rejected, e.g. for an unknown cluster
+//   - *api.Error with IsUnauthorized() for authentication failures
+//   - *api.Error with IsForbidden() if the API key lacks the MetricsViewer role
+//   - *api.Error with IsRateLimited() if rate limit is exceeded
+func (mm *TopicMetricsManager) GetTopicMetrics(ctx context.Context, clusterID string, window time.Duration) (map[string]api.TopicMetrics, error) {
+	minutes := int(window / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	throughput := fmt.Sprintf("PT%dM/%s", minutes, metricsDelay)
+
+	bytesIn, err := mm.queryByTopic(ctx, clusterID, MetricReceivedBytes, "ALL", throughput)
+	if err != nil {
+		return nil, err
+	}
+	bytesOut, err := mm.queryByTopic(ctx, clusterID, MetricSentBytes, "ALL", throughput)
+	if err != nil {
+		return nil, err
+	}
+	// retained_bytes is a gauge, so read the latest minute rather than summing the window
+	retained, err := mm.queryByTopic(ctx, clusterID, MetricRetainedBytes, "PT1M", "PT1M/"+metricsDelay)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make(map[string]api.TopicMetrics)
+	for topic, v := range bytesIn {
+		m := metrics[topic]
+		m.BytesIn = int64(v)
+		metrics[topic] = m
+	}
+	for topic, v := range bytesOut {
+		m := metrics[topic]
+		m.BytesOut = int64(v)
+		metrics[topic] = m
+	}
+	for topic, v := range retained {
+		m := metrics[topic]
+		m.RetainedBytes = int64(v)
+		metrics[topic] = m
+	}
+	return metrics, nil
+}
+
+// AttachTopicMetrics sets the Metrics field of each topic from GetTopicMetrics. Topics
+// without metrics, e.g. ones created within the window, get zero-valued metrics.
+func (mm *TopicMetricsManager) AttachTopicMetrics(ctx context.Context, clusterID string, topics []api.Topic, window time.Duration) error {
+	metrics, err := mm.GetTopicMetrics(ctx, clusterID, window)
+	if err != nil {
+		return err
+	}
+	for i := range topics {
+		m := metrics[topics[i].Name]
+		topics[i].Metrics = &m
+	}
+	return nil
+}
+
+// queryByTopic runs a Metrics API query of metric for a cluster grouped by topic and
+// returns the latest value per topic, following pagination.
+func (mm *TopicMetricsManager) queryByTopic(ctx context.Context, clusterID string, metric string, granularity string, interval string) (map[string]float64, error) {
+	body := map[string]interface{}{
+		"aggregations": []map[string]string{{"metric": metric}},
+		"filter":       map[string]string{"field": "resource.kafka.id", "op": "EQ", "value": clusterID},
+		"granularity":  granularity,
+		"group_by":     []string{"metric.topic"},
+		"intervals":    []string{interval},
+		"limit":        1000,
+	}
+
+	type point struct {
+		Timestamp time.Time `json:"timestamp"`
+		Value     float64   `json:"value"`
+		Topic     string    `json:"metric.topic"`
+	}
+	var points []point
+	pageToken := ""
+	for {
+		path := "/v2/metrics/cloud/query"
+		if pageToken != "" {
+			path += "?" + url.Values{"page_token": {pageToken}}.Encode()
+		}
+		resp, err := mm.client.Do(ctx, client.Request{Method: "POST", Path: path, Body: body})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s: %w", metric, err)
+		}
+
+		var result struct {
+			Data []point `json:"data"`
+			Meta struct {
+				Pagination struct {
+					NextPageToken string `json:"next_page_token"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := resp.DecodeJSON(&result); err != nil {
+			return nil, fmt.Errorf("failed to parse metrics query response: %w", err)
+		}
+		points = append(points, result.Data...)
+
+		pageToken = result.Meta.Pagination.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Timestamp.Before(points[j].Timestamp) })
+	values := make(map[string]float64)
+	for _, p := range points {
+		values[p.Topic] = p.Value
+	}
+	return values, nil
+}
@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestTopicMetricsManager_AttachTopicMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v2/metrics/cloud/query" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Aggregations []struct {
+				Metric string `json:"metric"`
+			} `json:"aggregations"`
+			Filter struct {
+				Value string `json:"value"`
+			} `json:"filter"`
+			Intervals []string `json:"intervals"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Filter.Value != "lkc-123" {
+			t.Errorf("Unexpected filter: %+v", body.Filter)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch body.Aggregations[0].Metric {
+		case resources.MetricReceivedBytes:
+			if body.Intervals[0] != "PT60M/now-2m|m" {
+				t.Errorf("Unexpected interval: %v", body.Intervals)
+			}
+			if r.URL.Query().Get("page_token") == "" {
+				_, _ = w.Write([]byte(`{"data":[{"timestamp":"2024-01-01T00:00:00Z","value":1000,"metric.topic":"orders"}],
+					"meta":{"pagination":{"next_page_token":"p2"}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"timestamp":"2024-01-01T00:00:00Z","value":50,"metric.topic":"payments"}]}`))
+		case resources.MetricSentBytes:
+			_, _ = w.Write([]byte(`{"data":[{"timestamp":"2024-01-01T00:00:00Z","value":3000,"metric.topic":"orders"}]}`))
+		case resources.MetricRetainedBytes:
+			_, _ = w.Write([]byte(`{"data":[{"timestamp":"2024-01-01T00:59:00Z","value":9000,"metric.topic":"orders"},
+				{"timestamp":"2024-01-01T00:58:00Z","value":8000,"metric.topic":"orders"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicMetricsManager(c)
+
+	topics := []api.Topic{{Name: "orders"}, {Name: "payments"}, {Name: "new"}}
+	if err := mgr.AttachTopicMetrics(context.Background(), "lkc-123", topics, time.Hour); err != nil {
+		t.Fatalf("AttachTopicMetrics failed: %v", err)
+	}
+
+	expected := []api.TopicMetrics{
+		{BytesIn: 1000, BytesOut: 3000, RetainedBytes: 9000},
+		{BytesIn: 50},
+		{},
+	}
+	for i, want := range expected {
+		if topics[i].Metrics == nil || *topics[i].Metrics != want {
+			t.Errorf("Expected %s metrics %+v, got %+v", topics[i].Name, want, topics[i].Metrics)
+		}
+	}
+}
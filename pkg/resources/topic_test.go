@@ -0,0 +1,485 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/clock"
+	"github.com/creiche/confluent-go/pkg/resources"
+)
+
+func TestTopicManager_ListPartitions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var data []map[string]interface{}
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-123/topics/orders/partitions":
+			data = []map[string]interface{}{{"partition_id": 1}, {"partition_id": 0}}
+		case "/kafka/v3/clusters/lkc-123/topics/orders/partitions/0/replicas":
+			data = []map[string]interface{}{
+				{"broker_id": 1, "is_leader": true, "is_in_sync": true},
+				{"broker_id": 2, "is_leader": false, "is_in_sync": true},
+				{"broker_id": 3, "is_leader": false, "is_in_sync": false},
+			}
+		case "/kafka/v3/clusters/lkc-123/topics/orders/partitions/1/replicas":
+			data = []map[string]interface{}{
+				{"broker_id": 2, "is_leader": false, "is_in_sync": false},
+			}
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"data": data}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+
+	partitions, err := mgr.ListPartitions(context.Background(), "lkc-123", "orders")
+	if err != nil {
+		t.Fatalf("ListPartitions failed: %v", err)
+	}
+	if len(partitions) != 2 {
+		t.Fatalf("Expected 2 partitions, got %d", len(partitions))
+	}
+	p0 := partitions[0]
+	if p0.Partition != 0 || p0.Leader != 1 || len(p0.Replicas) != 3 || len(p0.ISR) != 2 {
+		t.Errorf("Unexpected partition 0: %#v", p0)
+	}
+	if partitions[1].Leader != -1 {
+		t.Errorf("Expected offline partition 1 to have leader -1, got %d", partitions[1].Leader)
+	}
+}
+
+func TestTopicManager_UpdatePartitionCount(t *testing.T) {
+	var patched map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"topic_name": "orders", "partitions_count": 6})
+		case "PATCH":
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"topic_name": "orders", "partitions_count": 12})
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+	ctx := context.Background()
+
+	if err := mgr.UpdatePartitionCount(ctx, "lkc-123", "orders", 12); err != nil {
+		t.Fatalf("UpdatePartitionCount failed: %v", err)
+	}
+	if patched["partitions_count"] != float64(12) {
+		t.Errorf("Unexpected PATCH body: %v", patched)
+	}
+
+	patched = nil
+	if err := mgr.UpdatePartitionCount(ctx, "lkc-123", "orders", 6); err != nil || patched != nil {
+		t.Errorf("Expected no-op for unchanged count, got err=%v patch=%v", err, patched)
+	}
+
+	err := mgr.UpdatePartitionCount(ctx, "lkc-123", "orders", 3)
+	var decErr *resources.PartitionDecreaseError
+	if !errors.As(err, &decErr) || decErr.Current != 6 || decErr.Requested != 3 {
+		t.Errorf("Expected PartitionDecreaseError, got %v", err)
+	}
+	if !resources.IsPartitionDecreaseError(err) {
+		t.Error("IsPartitionDecreaseError should return true")
+	}
+}
+
+func TestTopicManager_CreateTopics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		switch body["topic_name"] {
+		case "existing":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error_code":40002,"message":"Topic 'existing' already exists."}`))
+		default:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+
+	results, err := mgr.CreateTopics(context.Background(), "lkc-123", []api.Topic{
+		{Name: "new", PartitionCount: 3},
+		{Name: "existing", PartitionCount: 3},
+	}, client.BatchOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("CreateTopics failed: %v", err)
+	}
+	if results[0].Status != resources.TopicCreated || results[1].Status != resources.TopicEnsureExists {
+		t.Errorf("Unexpected results: %#v", results)
+	}
+}
+
+func TestTopicManager_DeleteTopics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-123/topics/gone":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40403,"message":"This server does not host this topic-partition."}`))
+		case "/kafka/v3/clusters/lkc-123/topics/locked":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error_code":40301,"message":"Authorization failed"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+
+	results, err := mgr.DeleteTopics(context.Background(), "lkc-123", []string{"orders", "gone", "locked"}, client.BatchOptions{})
+	var batchErr *client.BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Failed()) != 1 || batchErr.Failed()[0] != 2 {
+		t.Fatalf("Expected batch error for item 2, got %v", err)
+	}
+	want := []string{resources.TopicDeleted, resources.TopicNotFound, resources.TopicFailed}
+	for i, r := range results {
+		if r.Status != want[i] {
+			t.Errorf("results[%d].Status = %s, want %s", i, r.Status, want[i])
+		}
+	}
+	var apiErr *api.Error
+	if !errors.As(results[2].Err, &apiErr) || !apiErr.IsForbidden() {
+		t.Errorf("Expected forbidden error, got %v", results[2].Err)
+	}
+}
+
+func TestTopicManager_GetTopicConfigEntry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-123/topics/orders/configs/retention.ms":
+			_, _ = w.Write([]byte(`{"name":"retention.ms","value":"86400000","is_default":false,"source":"DYNAMIC_TOPIC_CONFIG",
+				"synonyms":[{"name":"retention.ms","value":"86400000","source":"DYNAMIC_TOPIC_CONFIG"},
+				{"name":"log.retention.ms","value":"604800000","source":"DEFAULT_CONFIG"}]}`))
+		case "/kafka/v3/clusters/lkc-123/topics/orders/configs":
+			_, _ = w.Write([]byte(`{"data":[
+				{"name":"retention.ms","value":"86400000","source":"DYNAMIC_TOPIC_CONFIG"},
+				{"name":"cleanup.policy","value":"delete","is_default":true,"source":"DEFAULT_CONFIG"},
+				{"name":"min.insync.replicas","value":"2","source":"STATIC_BROKER_CONFIG"}]}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+
+	config, err := mgr.GetTopicConfigEntry(context.Background(), "lkc-123", "orders", "retention.ms")
+	if err != nil {
+		t.Fatalf("GetTopicConfigEntry failed: %v", err)
+	}
+	if config.IsDefault || config.Source != resources.ConfigSourceDynamicTopic || len(config.Synonyms) != 2 || config.Synonyms[1].Name != "log.retention.ms" {
+		t.Errorf("Unexpected config: %#v", config)
+	}
+
+	configs, err := mgr.GetTopicConfig(context.Background(), "lkc-123", "orders")
+	if err != nil {
+		t.Fatalf("GetTopicConfig failed: %v", err)
+	}
+	overridden := resources.OverriddenTopicConfigs(configs)
+	if len(overridden) != 1 || overridden["retention.ms"] != "86400000" {
+		t.Errorf("Unexpected overridden configs: %v", overridden)
+	}
+}
+
+func TestTopicManager_TopicExists(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-123/topics/orders":
+			_, _ = w.Write([]byte(`{"topic_name":"orders"}`))
+		case "/kafka/v3/clusters/lkc-123/topics/missing", "/kafka/v3/clusters/lkc-123/topics/missing/configs":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40403,"message":"This server does not host this topic-partition."}`))
+		case "/kafka/v3/clusters/lkc-gone/topics/orders":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":404,"message":"Cluster lkc-gone cannot be found."}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error_code":40101,"message":"Unauthorized"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+	ctx := context.Background()
+
+	if exists, err := mgr.TopicExists(ctx, "lkc-123", "orders"); err != nil || !exists {
+		t.Errorf("Expected orders to exist, got %v, %v", exists, err)
+	}
+	if exists, err := mgr.TopicExists(ctx, "lkc-123", "missing"); err != nil || exists {
+		t.Errorf("Expected missing to not exist, got %v, %v", exists, err)
+	}
+	if _, err := mgr.TopicExists(ctx, "lkc-123", "secret"); err == nil || errors.Is(err, resources.ErrTopicNotFound) {
+		t.Errorf("Expected auth error not to be reported as missing topic, got %v", err)
+	}
+	if _, err := mgr.TopicExists(ctx, "lkc-gone", "orders"); err == nil || errors.Is(err, resources.ErrTopicNotFound) {
+		t.Errorf("Expected unknown cluster not to be reported as missing topic, got %v", err)
+	}
+	if err := mgr.DeleteTopic(ctx, "lkc-123", "missing"); !errors.Is(err, resources.ErrTopicNotFound) {
+		t.Errorf("Expected DeleteTopic to wrap ErrTopicNotFound, got %v", err)
+	}
+	if _, err := mgr.GetTopicConfig(ctx, "lkc-123", "missing"); !errors.Is(err, resources.ErrTopicNotFound) {
+		t.Errorf("Expected GetTopicConfig to wrap ErrTopicNotFound, got %v", err)
+	}
+
+	_, err := mgr.GetTopic(ctx, "lkc-123", "missing")
+	var apiErr *api.Error
+	if !errors.Is(err, resources.ErrTopicNotFound) || !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+		t.Errorf("Expected ErrTopicNotFound wrapping *api.Error, got %v", err)
+	}
+}
+
+func TestTopicManager_GetAllTopicConfigs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kafka/v3/clusters/lkc-123/topics/-/configs" {
+			t.Errorf("Expected path /kafka/v3/clusters/lkc-123/topics/-/configs, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+			{"topic_name":"orders","name":"retention.ms","value":"1000","source":"DYNAMIC_TOPIC_CONFIG"},
+			{"topic_name":"orders","name":"cleanup.policy","value":"delete","is_default":true},
+			{"topic_name":"payments","name":"cleanup.policy","value":"compact","source":"DYNAMIC_TOPIC_CONFIG"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+
+	configs, err := mgr.GetAllTopicConfigs(context.Background(), "lkc-123")
+	if err != nil {
+		t.Fatalf("GetAllTopicConfigs failed: %v", err)
+	}
+	if len(configs) != 2 || len(configs["orders"]) != 2 || configs["payments"][0].Value != "compact" {
+		t.Errorf("Unexpected configs: %#v", configs)
+	}
+}
+
+func TestTopicManager_WatchTopics(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&polls, 1) == 1 {
+			_, _ = w.Write([]byte(`{"data":[{"name":"a","partition_count":1},{"name":"b","partition_count":1}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"name":"b","partition_count":3},{"name":"c","partition_count":1}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := mgr.WatchTopics(ctx, "lkc-123", resources.TopicWatchOptions{Interval: 30 * time.Second, Clock: fake})
+
+	next := func() resources.TopicEvent {
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for topic event")
+			return resources.TopicEvent{}
+		}
+	}
+
+	for _, name := range []string{"a", "b"} {
+		if ev := next(); ev.Type != resources.TopicEventCreated || ev.Topic.Name != name {
+			t.Errorf("Expected created %s, got %+v", name, ev)
+		}
+	}
+
+	fake.BlockUntil(1)
+	fake.Advance(time.Minute)
+
+	want := []struct{ typ, name string }{
+		{resources.TopicEventDeleted, "a"},
+		{resources.TopicEventUpdated, "b"},
+		{resources.TopicEventCreated, "c"},
+	}
+	for _, w := range want {
+		if ev := next(); ev.Type != w.typ || ev.Topic.Name != w.name {
+			t.Errorf("Expected %s %s, got %+v", w.typ, w.name, ev)
+		}
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestTopicManager_WatchTopicsClampsJitter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	mgr := resources.NewTopicManager(newTestClient(t, server.URL))
+	clk := &recordingClock{Fake: clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := mgr.WatchTopics(ctx, "lkc-123", resources.TopicWatchOptions{Interval: 30 * time.Second, Jitter: 5, Clock: clk})
+	for i := 0; i < 20; i++ {
+		clk.BlockUntil(1)
+		clk.Advance(time.Minute)
+	}
+	cancel()
+	for range events {
+	}
+
+	clk.mu.Lock()
+	defer clk.mu.Unlock()
+	for _, d := range clk.waits {
+		if d < 3*time.Second {
+			t.Fatalf("Expected waits of at least a tenth of the interval, got %v", clk.waits)
+		}
+	}
+}
+
+// recordingClock is a fake clock that records the durations passed to After.
+type recordingClock struct {
+	*clock.Fake
+	mu    sync.Mutex
+	waits []time.Duration
+}
+
+func (c *recordingClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	c.waits = append(c.waits, d)
+	c.mu.Unlock()
+	return c.Fake.After(d)
+}
+
+func TestTopicManager_WithBasePath(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	topics := resources.NewTopicManager(c).WithBasePath(resources.KafkaBasePathPlatform)
+	acls := resources.NewACLManager(c).WithBasePath(resources.KafkaBasePathPlatform)
+
+	if _, err := topics.ListTopics(context.Background(), "cp-cluster"); err != nil {
+		t.Fatalf("ListTopics failed: %v", err)
+	}
+	if _, err := acls.ListACLs(context.Background(), "cp-cluster"); err != nil {
+		t.Fatalf("ListACLs failed: %v", err)
+	}
+
+	expected := []string{"/v3/clusters/cp-cluster/topics", "/v3/clusters/cp-cluster/acls"}
+	if len(paths) != 2 || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("Expected paths %v, got %v", expected, paths)
+	}
+}
+
+func TestTopicManager_ListTopicsWithConfigs(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-123/topics":
+			_, _ = w.Write([]byte(`{"data":[{"topic_name":"orders","partitions_count":6,"replication_factor":3},{"topic_name":"empty"}]}`))
+		case "/kafka/v3/clusters/lkc-123/topics/-/configs":
+			_, _ = w.Write([]byte(`{"data":[
+				{"topic_name":"orders","name":"retention.ms","value":"86400000"},
+				{"topic_name":"orders","name":"cleanup.policy","value":"delete","is_default":true},
+				{"topic_name":"orders","name":"sasl.secret","value":"","is_sensitive":true}]}`))
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+
+	topics, err := mgr.ListTopicsWithConfigs(context.Background(), "lkc-123")
+	if err != nil {
+		t.Fatalf("ListTopicsWithConfigs failed: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	if len(topics) != 2 || topics[0].Name != "orders" || topics[0].PartitionCount != 6 || topics[0].ReplicationFactor != 3 {
+		t.Fatalf("Unexpected topics: %+v", topics)
+	}
+	if len(topics[0].Config) != 2 || topics[0].Config["retention.ms"] != "86400000" || topics[0].Config["cleanup.policy"] != "delete" {
+		t.Errorf("Unexpected configs: %v", topics[0].Config)
+	}
+	if topics[1].Config == nil || len(topics[1].Config) != 0 {
+		t.Errorf("Expected empty config map for topic without configs, got %v", topics[1].Config)
+	}
+}
+
+func TestTopicManager_DeleteRecords(t *testing.T) {
+	var body struct {
+		Partitions []struct {
+			PartitionID int32 `json:"partition_id"`
+			Offset      int64 `json:"offset"`
+		} `json:"partitions"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/kafka/v3/clusters/lkc-123/topics/orders/delete-records" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"partition_id":0,"low_watermark":100},
+			{"partition_id":1,"low_watermark":0,"error_code":40002,"error_message":"Offset out of range"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	mgr := resources.NewTopicManager(c)
+
+	results, err := mgr.DeleteRecords(context.Background(), "lkc-123", "orders", map[int32]int64{1: 9999, 0: resources.DeleteRecordsToEnd})
+	if err != nil {
+		t.Fatalf("DeleteRecords failed: %v", err)
+	}
+	if len(body.Partitions) != 2 || body.Partitions[0].PartitionID != 0 || body.Partitions[0].Offset != -1 || body.Partitions[1].Offset != 9999 {
+		t.Errorf("Unexpected body: %+v", body)
+	}
+	if len(results) != 2 || results[0].Err != nil || results[0].LowWatermark != 100 {
+		t.Fatalf("Unexpected results: %+v", results)
+	}
+	var apiErr *api.Error
+	if !errors.As(results[1].Err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Errorf("Expected bad request for partition 1, got %v", results[1].Err)
+	}
+}
@@ -0,0 +1,163 @@
+package resources
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// Types of TopicEvent.
+const (
+	TopicEventCreated = "created"
+	TopicEventUpdated = "updated"
+	TopicEventDeleted = "deleted"
+	TopicEventError   = "error"
+)
+
+// TopicEvent is a change observed by WatchTopics.
+type TopicEvent struct {
+	// Type is TopicEventCreated, TopicEventUpdated, TopicEventDeleted, or TopicEventError
+	Type string
+	// Topic is the topic as last seen; for deleted topics, as seen before deletion
+	Topic api.Topic
+	// Configs are the topic's overridden configs, as returned by OverriddenTopicConfigs.
+	// Only populated when TopicWatchOptions.IncludeConfigs is set.
+	Configs map[string]string
+	// Err is the poll failure for TopicEventError; the watch keeps polling afterwards
+	Err error
+}
+
+// maxTopicWatchJitter keeps the jittered interval of WatchTopics well above zero.
+const maxTopicWatchJitter = 0.9
+
+// TopicWatchOptions configures WatchTopics.
+type TopicWatchOptions struct {
+	// Interval between polls (optional, defaults to 30 seconds)
+	Interval time.Duration
+	// Jitter randomly varies each interval by up to this fraction of it, so that many
+	// watchers do not poll in lockstep (optional, defaults to 0.1, at most 0.9)
+	Jitter float64
+	// IncludeConfigs also polls topic configs, so that config changes are reported as
+	// TopicEventUpdated. It costs one extra request per poll.
+	IncludeConfigs bool
+	// Clock is used to wait between polls (optional, defaults to clock.Real)
+	Clock clock.Clock
+}
+
+// WatchTopics polls the topics of a cluster and sends an event for every topic created,
+// updated, or deleted between polls, until ctx is cancelled, after which the channel is
+// closed. The first poll reports every existing topic as TopicEventCreated, so a
+// controller can build its state from the event stream alone. Poll failures are sent as
+// TopicEventError and retried at the next interval. Changes made and reverted between
+// two polls are not observed.
+func (tm *TopicManager) WatchTopics(ctx context.Context, clusterID string, opts TopicWatchOptions) <-chan TopicEvent {
+	if opts.Interval <= 0 {
+		opts.Interval = 30 * time.Second
+	}
+	if opts.Jitter <= 0 {
+		opts.Jitter = 0.1
+	}
+	if opts.Jitter > maxTopicWatchJitter {
+		opts.Jitter = maxTopicWatchJitter
+	}
+	clk := clock.OrReal(opts.Clock)
+
+	events := make(chan TopicEvent)
+	go func() {
+		defer close(events)
+
+		send := func(ev TopicEvent) bool {
+			select {
+			case events <- ev:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var seen map[string]TopicEvent
+		for {
+			current, err := tm.snapshotTopics(ctx, clusterID, opts.IncludeConfigs)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if !send(TopicEvent{Type: TopicEventError, Err: err}) {
+					return
+				}
+			} else {
+				for _, ev := range diffTopics(seen, current) {
+					if !send(ev) {
+						return
+					}
+				}
+				seen = current
+			}
+
+			wait := opts.Interval + time.Duration(float64(opts.Interval)*opts.Jitter*(2*rand.Float64()-1))
+			if minWait := opts.Interval / 10; wait < minWait {
+				wait = minWait
+			}
+			select {
+			case <-clk.After(wait):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// snapshotTopics lists the topics of a cluster, keyed by name.
+func (tm *TopicManager) snapshotTopics(ctx context.Context, clusterID string, includeConfigs bool) (map[string]TopicEvent, error) {
+	topics, err := tm.ListTopics(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	var configs map[string][]api.TopicConfig
+	if includeConfigs {
+		if configs, err = tm.GetAllTopicConfigs(ctx, clusterID); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := make(map[string]TopicEvent, len(topics))
+	for _, t := range topics {
+		ev := TopicEvent{Topic: t}
+		if includeConfigs {
+			ev.Configs = OverriddenTopicConfigs(configs[t.Name])
+		}
+		snapshot[t.Name] = ev
+	}
+	return snapshot, nil
+}
+
+// diffTopics returns the events that turn prev into next, ordered by topic name.
+func diffTopics(prev, next map[string]TopicEvent) []TopicEvent {
+	var events []TopicEvent
+	for name, cur := range next {
+		old, ok := prev[name]
+		switch {
+		case !ok:
+			cur.Type = TopicEventCreated
+		case !reflect.DeepEqual(old.Topic, cur.Topic) || !reflect.DeepEqual(old.Configs, cur.Configs):
+			cur.Type = TopicEventUpdated
+		default:
+			continue
+		}
+		events = append(events, cur)
+	}
+	for name, old := range prev {
+		if _, ok := next[name]; !ok {
+			old.Type = TopicEventDeleted
+			events = append(events, old)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Topic.Name < events[j].Topic.Name })
+	return events
+}
@@ -0,0 +1,179 @@
+package retry
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// DefaultProvisioningWindow is the grace window used when NewProvisioningGrace is given zero.
+const DefaultProvisioningWindow = 2 * time.Minute
+
+// ProvisioningGrace treats 403 and 404 responses as retryable for a short window
+// after the resource they concern is created. Newly created clusters, Schema Registry
+// clusters, and API keys often return 403/404 until the creation has propagated, which
+// otherwise breaks create-then-use flows. Failures of requests for other resources are
+// not retried, so a missing resource still fails fast.
+//
+// A failed request concerns a created resource when its path has the resource ID as a
+// segment or query value (e.g. /cmk/v2/clusters/lkc-123 or ?environment=env-123), or
+// lies under the resource's path.
+//
+// Creations are observed by installing Middleware on the client, or reported
+// explicitly with MarkCreated:
+//
+//	grace := retry.NewProvisioningGrace(0)
+//	c, _ := client.NewClient(client.Config{
+//		// ...
+//		Middlewares: []client.Middleware{grace.Middleware()},
+//	})
+//	strategy := retry.DefaultStrategy().
+//		WithRetryableErrors(grace.RetryableErrors(retry.DefaultRetryableErrors))
+type ProvisioningGrace struct {
+	window time.Duration
+	clock  clock.Clock
+
+	mu      sync.Mutex
+	created map[string]time.Time
+}
+
+// NewProvisioningGrace creates a classifier with the given grace window
+// (DefaultProvisioningWindow when zero).
+func NewProvisioningGrace(window time.Duration) *ProvisioningGrace {
+	if window <= 0 {
+		window = DefaultProvisioningWindow
+	}
+	return &ProvisioningGrace{
+		window:  window,
+		clock:   clock.Real,
+		created: make(map[string]time.Time),
+	}
+}
+
+// WithClock sets the clock used to track grace windows (default clock.Real).
+func (g *ProvisioningGrace) WithClock(c clock.Clock) *ProvisioningGrace {
+	g.clock = clock.OrReal(c)
+	return g
+}
+
+// MarkCreated records that a resource was just created, opening its grace window.
+// resource is either its ID (e.g. "lsrc-123") or its path (e.g. "/kafka/v3/clusters/lkc-1/topics/orders").
+func (g *ProvisioningGrace) MarkCreated(resource string) {
+	if resource == "" {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.created[resource] = g.clock.Now()
+}
+
+// InGrace returns true if a request to path concerns a resource created within the
+// grace window.
+func (g *ProvisioningGrace) InGrace(path string) bool {
+	if path == "" {
+		return false
+	}
+	u, err := url.Parse(path)
+	if err != nil {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.clock.Now()
+	active := false
+	for resource, at := range g.created {
+		if now.Sub(at) >= g.window {
+			delete(g.created, resource)
+			continue
+		}
+		if !active && concerns(u, resource) {
+			active = true
+		}
+	}
+	return active
+}
+
+// concerns reports whether the request URL u is about resource, an ID or a path.
+func concerns(u *url.URL, resource string) bool {
+	if strings.HasPrefix(resource, "/") {
+		return u.Path == resource || strings.HasPrefix(u.Path, resource+"/")
+	}
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == resource {
+			return true
+		}
+	}
+	for _, values := range u.Query() {
+		for _, v := range values {
+			if v == resource {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RetryableErrors wraps a classifier so that 403 and 404 errors are also retried
+// while the resource of the failed request is within its grace window.
+func (g *ProvisioningGrace) RetryableErrors(base func(*api.Error) bool) func(*api.Error) bool {
+	return func(err *api.Error) bool {
+		if base != nil && base(err) {
+			return true
+		}
+		if err == nil || (!err.IsForbidden() && !err.IsNotFound()) {
+			return false
+		}
+		return g.InGrace(err.Path)
+	}
+}
+
+// Middleware returns a client middleware that calls MarkCreated for every POST
+// answered with 201 Created or 202 Accepted, marking the "id" of the response body
+// and the path of its "metadata.self" URL or Location header. Other POSTs, such as
+// schema lookups, compatibility checks, produce requests, and token exchanges, are
+// not creations and are ignored.
+func (g *ProvisioningGrace) Middleware() client.Middleware {
+	return func(next client.RoundTripFunc) client.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil || req.Method != http.MethodPost ||
+				(resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted) {
+				return resp, err
+			}
+
+			body, readErr := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if readErr != nil {
+				return resp, readErr
+			}
+
+			var created struct {
+				ID       interface{} `json:"id"`
+				Metadata struct {
+					Self string `json:"self"`
+				} `json:"metadata"`
+			}
+			_ = json.Unmarshal(body, &created)
+			if id, ok := created.ID.(string); ok {
+				g.MarkCreated(id)
+			}
+			for _, ref := range []string{created.Metadata.Self, resp.Header.Get("Location")} {
+				if u, err := url.Parse(ref); err == nil && u.Path != "" {
+					g.MarkCreated(u.Path)
+				}
+			}
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package retry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+func TestProvisioningGrace_RetriesForbiddenAfterCreate(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	grace := NewProvisioningGrace(time.Minute).WithClock(fake)
+
+	classify := grace.RetryableErrors(DefaultRetryableErrors)
+	forbidden := &api.Error{Code: http.StatusForbidden, Path: "/srcm/v3/clusters/lsrc-123"}
+
+	if classify(forbidden) {
+		t.Fatal("403 should not be retryable before any creation")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/subjects/orders-value" {
+			// Schema lookups are POSTs answered with 200 and a numeric id
+			_, _ = w.Write([]byte(`{"id":1,"version":1}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"lsrc-123"}`))
+	}))
+	defer server.Close()
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		APIKey:      "key",
+		APISecret:   "secret",
+		Middlewares: []client.Middleware{grace.Middleware()},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	resp, err := c.Do(context.Background(), client.Request{Method: "POST", Path: "/srcm/v3/clusters", Body: map[string]string{}})
+	if err != nil || string(resp.Body) != `{"id":"lsrc-123"}` {
+		t.Fatalf("unexpected create response %v (err %v)", resp, err)
+	}
+
+	if _, err := c.Do(context.Background(), client.Request{Method: "POST", Path: "/subjects/orders-value", Body: map[string]string{}}); err != nil {
+		t.Fatalf("lookup failed: %v", err)
+	}
+
+	if !classify(forbidden) || !classify(&api.Error{Code: http.StatusNotFound, Path: "/srcm/v3/clusters?environment=env-1&id=lsrc-123"}) {
+		t.Error("403/404 of the created resource should be retryable within the grace window")
+	}
+	if classify(&api.Error{Code: http.StatusNotFound, Path: "/srcm/v3/clusters/lsrc-999"}) ||
+		classify(&api.Error{Code: http.StatusNotFound, Path: "/subjects/orders-value"}) {
+		t.Error("403/404 of unrelated resources should fail fast")
+	}
+	if classify(&api.Error{Code: http.StatusBadRequest}) {
+		t.Error("400 should never be retryable")
+	}
+
+	fake.Advance(2 * time.Minute)
+	if classify(forbidden) {
+		t.Error("403 should not be retryable after the grace window")
+	}
+}
+
+func TestProvisioningGrace_MarksPathOfCreatedTopic(t *testing.T) {
+	grace := NewProvisioningGrace(time.Minute)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"topic_name":"orders","metadata":{"self":"https://pkc.example.com/kafka/v3/clusters/lkc-1/topics/orders"}}`))
+	}))
+	defer server.Close()
+	c, err := client.NewClient(client.Config{
+		BaseURL:     server.URL,
+		APIKey:      "key",
+		APISecret:   "secret",
+		Middlewares: []client.Middleware{grace.Middleware()},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := c.Do(context.Background(), client.Request{Method: "POST", Path: "/kafka/v3/clusters/lkc-1/topics", Body: map[string]string{}}); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	if !grace.InGrace("/kafka/v3/clusters/lkc-1/topics/orders/configs") {
+		t.Error("expected requests under the created topic to be in grace")
+	}
+	if grace.InGrace("/kafka/v3/clusters/lkc-1/topics/orders-dlq") {
+		t.Error("expected other topics not to be in grace")
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"context"
 	crand "crypto/rand"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"log/slog"
 	"math"
 	"time"
 
 	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
 )
 
 // Strategy defines how retries should be performed.
@@ -20,6 +23,9 @@ type Strategy struct {
 	multiplier      float64
 	addJitter       bool
 	retryableErrors func(*api.Error) bool
+	retryNetwork    bool
+	logger          *slog.Logger
+	clock           clock.Clock
 }
 
 // DefaultStrategy returns a Strategy with sensible defaults:
@@ -29,6 +35,7 @@ type Strategy struct {
 // - Exponential multiplier of 2.0
 // - Jitter enabled
 // - Retries on 429 (rate limit) and 500+ (server errors)
+// - Retries on network errors (connection resets, timeouts) of replayable requests
 func DefaultStrategy() *Strategy {
 	return &Strategy{
 		maxAttempts:     5,
@@ -37,6 +44,8 @@ func DefaultStrategy() *Strategy {
 		multiplier:      2.0,
 		addJitter:       true,
 		retryableErrors: DefaultRetryableErrors,
+		retryNetwork:    true,
+		clock:           clock.Real,
 	}
 }
 
@@ -95,6 +104,29 @@ func (s *Strategy) WithRetryableErrors(fn func(*api.Error) bool) *Strategy {
 	return s
 }
 
+// WithNetworkErrors enables or disables retrying *api.NetworkError failures, i.e. requests
+// that received no response. Requests cancelled by the caller are never retried, nor are
+// requests that are not replayable (see api.NetworkError.Replayable), such as a POST
+// without an idempotency key, since it may already have been applied. Default is true.
+func (s *Strategy) WithNetworkErrors(enabled bool) *Strategy {
+	s.retryNetwork = enabled
+	return s
+}
+
+// WithLogger sets a logger that records each retry attempt at debug level.
+// Default is nil (no logging).
+func (s *Strategy) WithLogger(logger *slog.Logger) *Strategy {
+	s.logger = logger
+	return s
+}
+
+// WithClock sets the clock used to wait between attempts.
+// Default is clock.Real; tests can pass a *clock.Fake.
+func (s *Strategy) WithClock(c clock.Clock) *Strategy {
+	s.clock = clock.OrReal(c)
+	return s
+}
+
 // Do executes the operation with retry logic.
 // It retries on retryable errors (rate limiting and server errors) up to maxAttempts times.
 // Returns the operation result or the last error if all retries fail.
@@ -117,9 +149,14 @@ func (s *Strategy) Do(ctx context.Context, operation func() error) error {
 
 		lastErr = err
 
-		// Check if error is retryable
-		apiErr, ok := err.(*api.Error)
-		if !ok || !s.retryableErrors(apiErr) {
+		// Check if error is retryable; managers wrap API errors with context
+		var apiErr *api.Error
+		isAPIErr := errors.As(err, &apiErr)
+		var netErr *api.NetworkError
+		switch {
+		case isAPIErr && s.retryableErrors(apiErr):
+		case !isAPIErr && s.retryNetwork && errors.As(err, &netErr) && netErr.IsRetryable() && netErr.Replayable():
+		default:
 			// Not retryable, fail immediately
 			return err
 		}
@@ -133,16 +170,30 @@ func (s *Strategy) Do(ctx context.Context, operation func() error) error {
 		waitDuration := s.calculateBackoff(attempt - 1)
 
 		// Use Retry-After header if available
-		if apiErr.IsRateLimited() {
+		if isAPIErr && apiErr.IsRateLimited() {
 			retryAfter := apiErr.RetryAfter()
 			if retryAfter > 0 {
 				waitDuration = time.Duration(retryAfter) * time.Second
 			}
 		}
 
+		if s.logger != nil {
+			status := 0
+			if isAPIErr {
+				status = apiErr.Code
+			}
+			s.logger.LogAttrs(ctx, slog.LevelDebug, "retrying operation",
+				slog.Int("attempt", attempt),
+				slog.Int("max_attempts", s.maxAttempts),
+				slog.Int("status", status),
+				slog.Duration("backoff", waitDuration),
+				slog.String("error", err.Error()),
+			)
+		}
+
 		// Wait before retrying
 		select {
-		case <-time.After(waitDuration):
+		case <-clock.OrReal(s.clock).After(waitDuration):
 			// Continue to next attempt
 		case <-ctx.Done():
 			return fmt.Errorf("retry cancelled after attempt %d: %w", attempt, ctx.Err())
@@ -150,10 +201,7 @@ func (s *Strategy) Do(ctx context.Context, operation func() error) error {
 	}
 
 	if lastErr != nil {
-		apiErr, ok := lastErr.(*api.Error)
-		if ok {
-			return fmt.Errorf("operation failed after %d attempts: %w", s.maxAttempts, apiErr)
-		}
+		return fmt.Errorf("operation failed after %d attempts: %w", s.maxAttempts, lastErr)
 	}
 	return lastErr
 }
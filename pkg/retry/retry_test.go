@@ -1,12 +1,18 @@
 package retry_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/clock"
 	"github.com/creiche/confluent-go/pkg/retry"
 )
 
@@ -111,6 +117,32 @@ func TestRetry_ServerErrorRetryable(t *testing.T) {
 	}
 }
 
+func TestRetry_WrappedAPIErrorRetryable(t *testing.T) {
+	t.Parallel()
+	strategy := retry.DefaultStrategy().WithMaxAttempts(3).WithInitialBackoff(time.Millisecond)
+	attempts := 0
+
+	err := strategy.Do(context.Background(), func() error {
+		attempts++
+		if attempts < 3 {
+			// Managers wrap the *api.Error returned by the client
+			return fmt.Errorf("failed to create service account: %w", &api.Error{
+				Code:      http.StatusServiceUnavailable,
+				ErrorCode: api.ErrorCodeServiceUnavailable,
+				Message:   "Service unavailable",
+			})
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
 func TestRetry_ExceedsMaxAttempts(t *testing.T) {
 	t.Parallel()
 	strategy := retry.DefaultStrategy().WithMaxAttempts(3).WithInitialBackoff(10 * time.Millisecond)
@@ -379,3 +411,87 @@ func TestRetry_ChainableConfig(t *testing.T) {
 		t.Fatalf("Expected no error for successful operation: %v", err)
 	}
 }
+
+func TestRetry_WithLoggerRecordsAttempts(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	strategy := retry.DefaultStrategy().
+		WithMaxAttempts(3).
+		WithInitialBackoff(time.Millisecond).
+		WithJitter(false).
+		WithLogger(logger)
+
+	_ = strategy.Do(context.Background(), func() error {
+		return &api.Error{Code: http.StatusServiceUnavailable, Message: "unavailable"}
+	})
+
+	if got := strings.Count(buf.String(), "retrying operation"); got != 2 {
+		t.Errorf("Expected 2 retry log entries, got %d: %s", got, buf.String())
+	}
+}
+
+func TestRetry_WithFakeClock(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	strategy := retry.DefaultStrategy().
+		WithMaxAttempts(3).
+		WithJitter(false).
+		WithClock(fake)
+
+	attempts := 0
+	done := make(chan error, 1)
+	go func() {
+		done <- strategy.Do(context.Background(), func() error {
+			attempts++
+			if attempts < 3 {
+				return &api.Error{Code: http.StatusServiceUnavailable}
+			}
+			return nil
+		})
+	}()
+
+	// Backoffs of 1s and 2s elapse instantly on the fake clock
+	fake.BlockUntil(1)
+	fake.Advance(time.Second)
+	fake.BlockUntil(1)
+	fake.Advance(2 * time.Second)
+
+	if err := <-done; err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetry_NetworkErrors(t *testing.T) {
+	t.Parallel()
+	reset := &api.NetworkError{Method: "GET", Path: "/org/v2/environments", Err: errors.New("connection reset by peer")}
+	cancelled := &api.NetworkError{Method: "GET", Path: "/org/v2/environments", Err: context.Canceled}
+
+	tests := []struct {
+		name     string
+		strategy *retry.Strategy
+		err      error
+		want     int
+	}{
+		{"transient", retry.DefaultStrategy(), fmt.Errorf("failed to list environments: %w", reset), 3},
+		{"cancelled", retry.DefaultStrategy(), cancelled, 1},
+		{"post", retry.DefaultStrategy(), &api.NetworkError{Method: "POST", Path: "/cmk/v2/clusters", Err: reset.Err}, 1},
+		{"post with idempotency key", retry.DefaultStrategy(), &api.NetworkError{Method: "POST", Path: "/cmk/v2/clusters", IdempotencyKey: "k", Err: reset.Err}, 3},
+		{"disabled", retry.DefaultStrategy().WithNetworkErrors(false), reset, 1},
+	}
+	for _, tc := range tests {
+		attempts := 0
+		err := tc.strategy.WithMaxAttempts(3).WithInitialBackoff(time.Millisecond).Do(context.Background(), func() error {
+			attempts++
+			return tc.err
+		})
+		if attempts != tc.want {
+			t.Errorf("%s: expected %d attempts, got %d", tc.name, tc.want, attempts)
+		}
+		if !api.IsNetworkError(err) {
+			t.Errorf("%s: expected network error, got %v", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package schemaregistry
+
+import (
+	"container/list"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+// CacheConfig bounds the caches kept by CachedManager.
+type CacheConfig struct {
+	// MaxEntries caps each cache (schemas by ID, schemas by subject version, and
+	// latest schemas by subject); the least recently used entry is evicted first.
+	// Default 1000.
+	MaxEntries int
+	// LatestTTL is how long GetLatestSchema results are trusted, since a new version
+	// may be registered at any time. Default 30s; negative disables latest caching.
+	LatestTTL time.Duration
+}
+
+// CachedManager wraps a Manager with in-memory LRU caches for the hot read paths used
+// when deserializing records: GetSchemaByID, GetSchemaVersion, and GetLatestSchema, which
+// also back its GetSchemaAt and ResolveReferences.
+// Schemas by ID and by subject version are immutable and only evicted for space;
+// latest schemas expire after LatestTTL and are dropped when the subject is written
+// through this CachedManager. Every other Manager method is passed through uncached.
+// Each call returns its own copy of the schema, so callers may modify it without
+// affecting the cache. It is safe for concurrent use.
+type CachedManager struct {
+	*Manager
+	latestTTL time.Duration
+	clock     clock.Clock
+
+	byID      *lruCache[int, *Schema]
+	byVersion *lruCache[versionKey, *Schema]
+	latest    *lruCache[string, *Schema]
+}
+
+// NewCachedManager wraps m with caches configured by config.
+func NewCachedManager(m *Manager, config CacheConfig) *CachedManager {
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = 1000
+	}
+	if config.LatestTTL == 0 {
+		config.LatestTTL = 30 * time.Second
+	}
+	return &CachedManager{
+		Manager:   m,
+		latestTTL: config.LatestTTL,
+		clock:     clock.Real,
+		byID:      newLRUCache[int, *Schema](config.MaxEntries),
+		byVersion: newLRUCache[versionKey, *Schema](config.MaxEntries),
+		latest:    newLRUCache[string, *Schema](config.MaxEntries),
+	}
+}
+
+// WithClock sets the clock used to expire latest schemas (default clock.Real).
+func (cm *CachedManager) WithClock(c clock.Clock) *CachedManager {
+	cm.clock = clock.OrReal(c)
+	return cm
+}
+
+// GetSchemaByID returns the schema for a global ID, from cache when possible.
+func (cm *CachedManager) GetSchemaByID(ctx context.Context, id int) (*Schema, error) {
+	if s, ok := cm.byID.get(id, time.Time{}); ok {
+		return cloneSchema(s), nil
+	}
+	s, err := cm.Manager.GetSchemaByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	cm.byID.put(id, cloneSchema(s), time.Time{})
+	return s, nil
+}
+
+// GetSchemaVersion returns a specific version of a subject, from cache when possible.
+// A negative version, which the registry reads as the latest, is never cached.
+func (cm *CachedManager) GetSchemaVersion(ctx context.Context, subject string, version int) (*Schema, error) {
+	if version < 0 {
+		return cm.Manager.GetSchemaVersion(ctx, subject, version)
+	}
+	key := versionKey{subject: subject, version: version}
+	if s, ok := cm.byVersion.get(key, time.Time{}); ok {
+		return cloneSchema(s), nil
+	}
+	s, err := cm.Manager.GetSchemaVersion(ctx, subject, version)
+	if err != nil {
+		return nil, err
+	}
+	cm.byVersion.put(key, cloneSchema(s), time.Time{})
+	return s, nil
+}
+
+// GetSchemaAt is Manager.GetSchemaAt with subject versions served from cache.
+func (cm *CachedManager) GetSchemaAt(ctx context.Context, subject string, at time.Time) (*Schema, error) {
+	versions, err := cm.ListVersions(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return schemaAt(subject, versions, at, func(version int) (*Schema, error) {
+		return cm.GetSchemaVersion(ctx, subject, version)
+	})
+}
+
+// ResolveReferences is Manager.ResolveReferences with referenced versions served from cache.
+func (cm *CachedManager) ResolveReferences(ctx context.Context, root *Schema) (*SchemaBundle, error) {
+	return resolveReferences(root, func(subject string, version int) (*Schema, error) {
+		return cm.GetSchemaVersion(ctx, subject, version)
+	})
+}
+
+// GetLatestSchema returns the latest schema of a subject, from cache when a result
+// younger than LatestTTL is available.
+func (cm *CachedManager) GetLatestSchema(ctx context.Context, subject string) (*Schema, error) {
+	if cm.latestTTL < 0 {
+		return cm.Manager.GetLatestSchema(ctx, subject)
+	}
+	now := cm.clock.Now()
+	if s, ok := cm.latest.get(subject, now); ok {
+		return cloneSchema(s), nil
+	}
+	s, err := cm.Manager.GetLatestSchema(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	cm.latest.put(subject, cloneSchema(s), now.Add(cm.latestTTL))
+	return s, nil
+}
+
+// RegisterSchema registers a schema and drops the cached latest schema of the subject.
+func (cm *CachedManager) RegisterSchema(ctx context.Context, subject string, payload RegisterRequest) (int, error) {
+	defer cm.Invalidate(subject)
+	return cm.Manager.RegisterSchema(ctx, subject, payload)
+}
+
+// DeleteSubject deletes a subject and drops its cached latest schema and versions.
+func (cm *CachedManager) DeleteSubject(ctx context.Context, subject string, permanent bool) error {
+	defer cm.Invalidate(subject)
+	defer cm.byVersion.removeFunc(func(k versionKey) bool { return k.subject == subject })
+	return cm.Manager.DeleteSubject(ctx, subject, permanent)
+}
+
+// DeleteSchemaVersion deletes a subject version and drops the cached entries for it.
+func (cm *CachedManager) DeleteSchemaVersion(ctx context.Context, subject string, version int, permanent bool) error {
+	defer cm.Invalidate(subject)
+	defer cm.byVersion.remove(versionKey{subject: subject, version: version})
+	return cm.Manager.DeleteSchemaVersion(ctx, subject, version, permanent)
+}
+
+// Invalidate drops the cached latest schema of a subject.
+func (cm *CachedManager) Invalidate(subject string) {
+	cm.latest.remove(subject)
+}
+
+// Purge empties every cache.
+func (cm *CachedManager) Purge() {
+	cm.byID.purge()
+	cm.byVersion.purge()
+	cm.latest.purge()
+}
+
+// lruCache is a size-bounded LRU map with optional per-entry expiry.
+type lruCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	max     int
+	order   *list.List // front is most recently used
+	entries map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time // zero never expires
+}
+
+func newLRUCache[K comparable, V any](max int) *lruCache[K, V] {
+	return &lruCache[K, V]{max: max, order: list.New(), entries: make(map[K]*list.Element)}
+}
+
+// get returns the value for key unless it is missing or expired at now.
+func (c *lruCache[K, V]) get(key K, now time.Time) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var zero V
+	el, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	e := el.Value.(*lruEntry[K, V])
+	if !e.expiresAt.IsZero() && !now.Before(e.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return zero, false
+	}
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+func (c *lruCache[K, V]) put(key K, value V, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value = &lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt}
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+func (c *lruCache[K, V]) remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.Remove(el)
+		delete(c.entries, key)
+	}
+}
+
+// removeFunc removes every entry whose key matches.
+func (c *lruCache[K, V]) removeFunc(match func(K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, el := range c.entries {
+		if match(key) {
+			c.order.Remove(el)
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *lruCache[K, V]) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.entries = make(map[K]*list.Element)
+}
+
+// cloneSchema returns a deep copy of s, so cached schemas are never shared with callers.
+func cloneSchema(s *Schema) *Schema {
+	c := *s
+	c.References = slices.Clone(s.References)
+	c.SubjectVersions = slices.Clone(s.SubjectVersions)
+	if s.Metadata != nil {
+		m := Metadata{Properties: maps.Clone(s.Metadata.Properties), Sensitive: slices.Clone(s.Metadata.Sensitive)}
+		if s.Metadata.Tags != nil {
+			m.Tags = make(map[string][]string, len(s.Metadata.Tags))
+			for k, v := range s.Metadata.Tags {
+				m.Tags[k] = slices.Clone(v)
+			}
+		}
+		c.Metadata = &m
+	}
+	if s.RuleSet != nil {
+		c.RuleSet = &RuleSet{MigrationRules: cloneRules(s.RuleSet.MigrationRules), DomainRules: cloneRules(s.RuleSet.DomainRules)}
+	}
+	return &c
+}
+
+func cloneRules(rules []Rule) []Rule {
+	if rules == nil {
+		return nil
+	}
+	c := make([]Rule, len(rules))
+	for i, r := range rules {
+		r.Tags = slices.Clone(r.Tags)
+		r.Params = maps.Clone(r.Params)
+		c[i] = r
+	}
+	return c
+}
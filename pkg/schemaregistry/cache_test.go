@@ -0,0 +1,173 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/clock"
+)
+
+func TestCachedManager(t *testing.T) {
+	var calls atomic.Int32
+	var latestVersion atomic.Int32
+	latestVersion.Store(1)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/schema-registry/v1/schemas/ids/"):
+			_ = json.NewEncoder(w).Encode(Schema{Schema: `"string"`})
+		case r.URL.Path == "/schema-registry/v1/subjects/orders-value/versions/latest":
+			v := int(latestVersion.Load())
+			_ = json.NewEncoder(w).Encode(Schema{ID: 10 + v, Subject: "orders-value", Version: v, Schema: `"string"`})
+		case r.Method == http.MethodPost && r.URL.Path == "/schema-registry/v1/subjects/orders-value/versions":
+			latestVersion.Add(1)
+			_ = json.NewEncoder(w).Encode(RegisterResponse{ID: 12})
+		case strings.HasPrefix(r.URL.Path, "/schema-registry/v1/subjects/orders-value/versions/"):
+			_ = json.NewEncoder(w).Encode(Schema{ID: 11, Subject: "orders-value", Version: 1, Schema: `"string"`})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}
+	fake := clock.NewFake(time.Unix(0, 0))
+	cm := NewCachedManager(NewManager(newTestClient(t, handler), "/schema-registry/v1"), CacheConfig{LatestTTL: time.Minute}).WithClock(fake)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if s, err := cm.GetSchemaByID(ctx, 5); err != nil || s.ID != 5 {
+			t.Fatalf("GetSchemaByID: %#v err=%v", s, err)
+		}
+		if _, err := cm.GetSchemaVersion(ctx, "orders-value", 1); err != nil {
+			t.Fatalf("GetSchemaVersion: %v", err)
+		}
+		if s, err := cm.GetLatestSchema(ctx, "orders-value"); err != nil || s.Version != 1 {
+			t.Fatalf("GetLatestSchema: %#v err=%v", s, err)
+		}
+	}
+	if n := calls.Load(); n != 3 {
+		t.Fatalf("expected 3 registry calls, got %d", n)
+	}
+
+	// Registering through the cache drops the stale latest entry
+	if _, err := cm.RegisterSchema(ctx, "orders-value", RegisterRequest{Schema: `{"type":"record","name":"O","fields":[]}`}); err != nil {
+		t.Fatalf("RegisterSchema: %v", err)
+	}
+	if s, _ := cm.GetLatestSchema(ctx, "orders-value"); s.Version != 2 {
+		t.Fatalf("expected latest version 2 after register, got %d", s.Version)
+	}
+
+	// A version registered elsewhere is picked up once the TTL expires
+	latestVersion.Store(3)
+	if s, _ := cm.GetLatestSchema(ctx, "orders-value"); s.Version != 2 {
+		t.Fatalf("expected cached version 2, got %d", s.Version)
+	}
+	fake.Advance(time.Minute)
+	if s, _ := cm.GetLatestSchema(ctx, "orders-value"); s.Version != 3 {
+		t.Fatalf("expected version 3 after TTL, got %d", s.Version)
+	}
+}
+
+func TestCachedManager_DeleteSubjectAndCopies(t *testing.T) {
+	var calls atomic.Int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/schema-registry/v1/subjects/orders-value":
+			_ = json.NewEncoder(w).Encode([]int{1, 2})
+		case strings.HasPrefix(r.URL.Path, "/schema-registry/v1/subjects/orders-value/versions/"):
+			calls.Add(1)
+			_ = json.NewEncoder(w).Encode(Schema{ID: 11, Subject: "orders-value", Version: 1, Schema: `"string"`,
+				Metadata: &Metadata{Properties: map[string]string{"owner": "payments"}}})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}
+	cm := NewCachedManager(NewManager(newTestClient(t, handler), "/schema-registry/v1"), CacheConfig{})
+	ctx := context.Background()
+
+	s, err := cm.GetSchemaVersion(ctx, "orders-value", 1)
+	if err != nil {
+		t.Fatalf("GetSchemaVersion: %v", err)
+	}
+	s.Schema = "mutated"
+	s.Metadata.Properties["owner"] = "mutated"
+	cached, err := cm.GetSchemaVersion(ctx, "orders-value", 1)
+	if err != nil || cached.Schema != `"string"` || cached.Metadata.Properties["owner"] != "payments" {
+		t.Fatalf("expected cached schema unaffected by caller changes, got %#v err=%v", cached, err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected 1 registry call, got %d", n)
+	}
+
+	if err := cm.DeleteSubject(ctx, "orders-value", false); err != nil {
+		t.Fatalf("DeleteSubject: %v", err)
+	}
+	if _, err := cm.GetSchemaVersion(ctx, "orders-value", 1); err != nil {
+		t.Fatalf("GetSchemaVersion: %v", err)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("expected versions to be evicted by DeleteSubject, got %d registry calls", n)
+	}
+}
+
+func TestCachedManager_LatestVersionNotPinned(t *testing.T) {
+	var latestVersion atomic.Int32
+	latestVersion.Store(1)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/schema-registry/v1/subjects/orders-value/versions/-1" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Schema{Subject: "orders-value", Version: int(latestVersion.Load()), Schema: `"string"`})
+	}
+	cm := NewCachedManager(NewManager(newTestClient(t, handler), "/schema-registry/v1"), CacheConfig{})
+	ctx := context.Background()
+
+	if s, err := cm.GetSchemaVersion(ctx, "orders-value", -1); err != nil || s.Version != 1 {
+		t.Fatalf("GetSchemaVersion: %#v err=%v", s, err)
+	}
+	latestVersion.Store(2)
+	if s, err := cm.GetSchemaVersion(ctx, "orders-value", -1); err != nil || s.Version != 2 {
+		t.Fatalf("expected version -1 to follow the latest version, got %#v err=%v", s, err)
+	}
+}
+
+func TestCachedManager_ErrorsNotCached(t *testing.T) {
+	var calls atomic.Int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_code":40403,"message":"Schema not found"}`))
+	}
+	cm := NewCachedManager(NewManager(newTestClient(t, handler), ""), CacheConfig{})
+
+	for i := 0; i < 2; i++ {
+		if _, err := cm.GetSchemaByID(context.Background(), 1); !IsSchemaNotFound(err) {
+			t.Fatalf("expected schema not found, got %v", err)
+		}
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("errors should not be cached, got %d calls", calls.Load())
+	}
+}
+
+func TestLRUCache_Eviction(t *testing.T) {
+	c := newLRUCache[string, int](2)
+	c.put("a", 1, time.Time{})
+	c.put("b", 2, time.Time{})
+	c.get("a", time.Time{}) // a is now most recently used
+	c.put("c", 3, time.Time{})
+
+	for key, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok := c.get(key, time.Time{}); ok != want {
+			t.Errorf("get(%q) present=%v, want %v", key, ok, want)
+		}
+	}
+}
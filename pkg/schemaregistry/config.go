@@ -0,0 +1,101 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Config is the full Schema Registry configuration of the registry or of a single
+// subject, including the data contract governance settings. Empty fields are left
+// unchanged by SetGlobalConfig and SetSubjectConfig.
+type Config struct {
+	// Compatibility is the compatibility level, one of the Compat* constants.
+	Compatibility CompatibilityLevel `json:"compatibility,omitempty"`
+	// CompatibilityGroup names a metadata property; compatibility is only checked
+	// between versions that share the same value for it, e.g. "application.major.version".
+	CompatibilityGroup string `json:"compatibilityGroup,omitempty"`
+	// Normalize canonicalizes schemas before registering or looking them up.
+	Normalize *bool `json:"normalize,omitempty"`
+	// Alias makes the subject an alias of another subject.
+	Alias string `json:"alias,omitempty"`
+	// DefaultMetadata is merged into the metadata of new versions that do not set it.
+	DefaultMetadata *Metadata `json:"defaultMetadata,omitempty"`
+	// OverrideMetadata is merged into the metadata of every new version, taking precedence.
+	OverrideMetadata *Metadata `json:"overrideMetadata,omitempty"`
+	// DefaultRuleSet is applied to new versions that do not define rules.
+	DefaultRuleSet *RuleSet `json:"defaultRuleSet,omitempty"`
+	// OverrideRuleSet is applied to every new version, taking precedence.
+	OverrideRuleSet *RuleSet `json:"overrideRuleSet,omitempty"`
+}
+
+// GetGlobalConfig returns the global configuration.
+func (m *Manager) GetGlobalConfig(ctx context.Context) (*Config, error) {
+	return m.getConfig(ctx, fmt.Sprintf("%s/config", m.basePath))
+}
+
+// GetSubjectConfig returns the configuration override for a subject. With defaultToGlobal
+// the global configuration is returned for subjects without an override; otherwise such
+// subjects yield an error matching IsSubjectCompatibilityNotConfigured.
+func (m *Manager) GetSubjectConfig(ctx context.Context, subject string, defaultToGlobal bool) (*Config, error) {
+	path := fmt.Sprintf("%s/config/%s", m.basePath, url.PathEscape(subject))
+	if defaultToGlobal {
+		path += "?defaultToGlobal=true"
+	}
+	return m.getConfig(ctx, path)
+}
+
+// SetGlobalConfig updates the global configuration and returns the configuration
+// echoed by the registry.
+func (m *Manager) SetGlobalConfig(ctx context.Context, cfg Config) (*Config, error) {
+	return m.setConfig(ctx, fmt.Sprintf("%s/config", m.basePath), cfg)
+}
+
+// SetSubjectConfig updates the configuration override for a subject and returns the
+// configuration echoed by the registry.
+func (m *Manager) SetSubjectConfig(ctx context.Context, subject string, cfg Config) (*Config, error) {
+	return m.setConfig(ctx, fmt.Sprintf("%s/config/%s", m.basePath, url.PathEscape(subject)), cfg)
+}
+
+// configResponse accepts both spellings of the compatibility level; the registry
+// reports it as compatibilityLevel on reads.
+type configResponse struct {
+	Config
+	CompatibilityLevel CompatibilityLevel `json:"compatibilityLevel"`
+}
+
+func (r configResponse) config() *Config {
+	cfg := r.Config
+	if r.CompatibilityLevel != "" {
+		cfg.Compatibility = r.CompatibilityLevel
+	}
+	return &cfg
+}
+
+func (m *Manager) getConfig(ctx context.Context, path string) (*Config, error) {
+	var out configResponse
+	req := client.Request{Method: "GET", Path: path}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&out); err != nil {
+		return nil, err
+	}
+	return out.config(), nil
+}
+
+func (m *Manager) setConfig(ctx context.Context, path string, cfg Config) (*Config, error) {
+	var out configResponse
+	req := client.Request{Method: "PUT", Path: path, Body: cfg}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&out); err != nil {
+		return nil, err
+	}
+	return out.config(), nil
+}
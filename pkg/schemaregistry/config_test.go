@@ -0,0 +1,62 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSubjectConfig(t *testing.T) {
+	var put map[string]interface{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			_ = json.NewDecoder(r.Body).Decode(&put)
+			_ = json.NewEncoder(w).Encode(put)
+		case "GET":
+			if r.URL.Query().Get("defaultToGlobal") != "true" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error_code":40408,"message":"Subject does not have subject-level compatibility configured"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"compatibilityLevel":"BACKWARD","compatibilityGroup":"major","normalize":true,"overrideMetadata":{"properties":{"owner":"payments"}}}`))
+		}
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+	ctx := context.Background()
+
+	normalize := true
+	cfg, err := m.SetSubjectConfig(ctx, "orders-value", Config{
+		Compatibility:      CompatBackward,
+		CompatibilityGroup: "major",
+		Normalize:          &normalize,
+		DefaultMetadata:    &Metadata{Properties: map[string]string{"major": "1"}},
+	})
+	if err != nil {
+		t.Fatalf("SetSubjectConfig error: %v", err)
+	}
+	if put["compatibility"] != string(CompatBackward) || put["compatibilityGroup"] != "major" || put["normalize"] != true {
+		t.Errorf("unexpected PUT body: %v", put)
+	}
+	if _, ok := put["overrideMetadata"]; ok {
+		t.Errorf("unset fields should be omitted: %v", put)
+	}
+	if cfg.DefaultMetadata == nil || cfg.DefaultMetadata.Properties["major"] != "1" {
+		t.Errorf("unexpected echoed config: %#v", cfg)
+	}
+
+	got, err := m.GetSubjectConfig(ctx, "orders-value", true)
+	if err != nil {
+		t.Fatalf("GetSubjectConfig error: %v", err)
+	}
+	if got.Compatibility != CompatBackward || got.CompatibilityGroup != "major" || got.Normalize == nil || !*got.Normalize ||
+		got.OverrideMetadata.Properties["owner"] != "payments" {
+		t.Errorf("unexpected config: %#v", got)
+	}
+
+	if _, err := m.GetSubjectConfig(ctx, "orders-value", false); !IsSubjectCompatibilityNotConfigured(err) {
+		t.Errorf("expected compatibility not configured, got %v", err)
+	}
+}
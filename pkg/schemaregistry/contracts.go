@@ -0,0 +1,99 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Metadata is the data contract metadata attached to a schema version.
+type Metadata struct {
+	// Tags maps a field path to the tags applied to it, e.g. "Order.ssn" -> ["PII"].
+	Tags map[string][]string `json:"tags,omitempty"`
+	// Properties are free-form key/value pairs such as owner or application version.
+	// They can be matched with GetLatestWithMetadata.
+	Properties map[string]string `json:"properties,omitempty"`
+	// Sensitive lists property names whose values should be treated as secrets.
+	Sensitive []string `json:"sensitive,omitempty"`
+}
+
+// RuleSet groups the data contract rules attached to a schema version.
+type RuleSet struct {
+	// MigrationRules transform data between schema versions on read or write.
+	MigrationRules []Rule `json:"migrationRules,omitempty"`
+	// DomainRules validate or transform data for a single schema version.
+	DomainRules []Rule `json:"domainRules,omitempty"`
+}
+
+// Rule kinds.
+const (
+	RuleKindTransform = "TRANSFORM"
+	RuleKindCondition = "CONDITION"
+)
+
+// Rule modes. Migration rules use UPGRADE, DOWNGRADE, or UPDOWN; domain rules
+// use WRITE, READ, or WRITEREAD.
+const (
+	RuleModeUpgrade   = "UPGRADE"
+	RuleModeDowngrade = "DOWNGRADE"
+	RuleModeUpDown    = "UPDOWN"
+	RuleModeWrite     = "WRITE"
+	RuleModeRead      = "READ"
+	RuleModeWriteRead = "WRITEREAD"
+)
+
+// Rule is a single data contract rule, such as a CEL condition or a JSONata migration.
+type Rule struct {
+	Name string `json:"name"`
+	Doc  string `json:"doc,omitempty"`
+	Kind string `json:"kind"`
+	Mode string `json:"mode"`
+	// Type is the rule executor, e.g. "CEL", "CEL_FIELD", "JSONATA", or "ENCRYPT".
+	Type string `json:"type"`
+	// Tags restricts field-level rules to fields carrying one of these tags.
+	Tags   []string          `json:"tags,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+	Expr   string            `json:"expr,omitempty"`
+	// OnSuccess and OnFailure name the action to take, e.g. "NONE", "ERROR", or "DLQ".
+	OnSuccess string `json:"onSuccess,omitempty"`
+	OnFailure string `json:"onFailure,omitempty"`
+	Disabled  bool   `json:"disabled,omitempty"`
+}
+
+// GetLatestWithMetadata returns the latest version of subject whose metadata properties
+// contain every given key/value pair. This lets applications pin to a schema by a
+// property such as an application major version instead of by version number.
+// Returns errors:
+//   - IsSubjectNotFound(err) if the subject does not exist
+//   - IsVersionNotFound(err) if no version matches the metadata
+func (m *Manager) GetLatestWithMetadata(ctx context.Context, subject string, metadata map[string]string) (*Schema, error) {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	q := url.Values{}
+	for _, k := range keys {
+		q.Add("key", k)
+		q.Add("value", metadata[k])
+	}
+
+	path := fmt.Sprintf("%s/subjects/%s/metadata", m.basePath, url.PathEscape(subject))
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var s Schema
+	req := client.Request{Method: "GET", Path: path}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,68 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestRegisterSchema_DataContract(t *testing.T) {
+	var got RegisterRequest
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(RegisterResponse{ID: 1})
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	_, err := m.RegisterSchema(context.Background(), "orders-value", RegisterRequest{
+		Schema:   `{"type":"record","name":"Order","fields":[{"name":"ssn","type":"string"}]}`,
+		Metadata: &Metadata{Properties: map[string]string{"owner": "payments"}, Tags: map[string][]string{"Order.ssn": {"PII"}}},
+		RuleSet: &RuleSet{DomainRules: []Rule{{
+			Name: "checkSsn", Kind: RuleKindCondition, Mode: RuleModeWrite, Type: "CEL",
+			Expr: "message.ssn.size() == 9", OnFailure: "DLQ",
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("RegisterSchema error: %v", err)
+	}
+	if got.Metadata == nil || got.Metadata.Properties["owner"] != "payments" || got.Metadata.Tags["Order.ssn"][0] != "PII" {
+		t.Errorf("metadata not sent: %#v", got.Metadata)
+	}
+	if got.RuleSet == nil || len(got.RuleSet.DomainRules) != 1 || got.RuleSet.DomainRules[0].OnFailure != "DLQ" {
+		t.Errorf("ruleSet not sent: %#v", got.RuleSet)
+	}
+}
+
+func TestGetLatestWithMetadata(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/schema-registry/v1/subjects/orders-value/metadata" ||
+			len(q["key"]) != 2 || q["key"][0] != "app" || q["value"][0] != "checkout" || q["key"][1] != "major" || q["value"][1] != "2" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40402,"message":"Version not found"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Schema{
+			ID: 7, Subject: "orders-value", Version: 4, Schema: `"string"`,
+			Metadata: &Metadata{Properties: map[string]string{"app": "checkout", "major": "2"}},
+		})
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	s, err := m.GetLatestWithMetadata(context.Background(), "orders-value", map[string]string{"major": "2", "app": "checkout"})
+	if err != nil {
+		t.Fatalf("GetLatestWithMetadata error: %v", err)
+	}
+	if s.Version != 4 || s.Metadata == nil || s.Metadata.Properties["major"] != "2" {
+		t.Fatalf("unexpected schema: %#v", s)
+	}
+
+	_, err = m.GetLatestWithMetadata(context.Background(), "orders-value", map[string]string{"major": "3"})
+	if !IsVersionNotFound(err) {
+		t.Fatalf("expected version not found, got %v", err)
+	}
+}
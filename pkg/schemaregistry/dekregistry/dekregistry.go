@@ -0,0 +1,299 @@
+// Package dekregistry manages the key encryption keys (KEKs) and data encryption keys
+// (DEKs) used by client-side field level encryption (CSFLE) in Schema Registry.
+//
+// A KEK references a key held in an external KMS (AWS KMS, Azure Key Vault, GCP KMS,
+// or HashiCorp Vault). DEKs are generated per subject, encrypted with the KEK, and
+// versioned so they can be rotated without losing the ability to decrypt old data.
+//
+// Example usage:
+//
+//	dr := dekregistry.NewManager(client, "/dek-registry/v1")
+//
+//	_, err := dr.CreateKek(ctx, dekregistry.Kek{
+//		Name:     "payments-kek",
+//		KmsType:  dekregistry.KmsTypeAWS,
+//		KmsKeyID: "arn:aws:kms:us-east-1:123456789012:key/abcd",
+//		Shared:   true,
+//	})
+//
+//	// Rotate the DEK used for a subject
+//	dek, err := dr.RotateDek(ctx, "payments-kek", "orders-value", dekregistry.AlgorithmAES256GCM)
+package dekregistry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/schemaregistry"
+)
+
+// KMS types supported for KEKs.
+const (
+	KmsTypeAWS     = "aws-kms"
+	KmsTypeAzure   = "azure-kms"
+	KmsTypeGCP     = "gcp-kms"
+	KmsTypeHCVault = "hcvault"
+)
+
+// DEK encryption algorithms.
+const (
+	AlgorithmAES128GCM = "AES128_GCM"
+	AlgorithmAES256GCM = "AES256_GCM"
+	AlgorithmAES256SIV = "AES256_SIV"
+)
+
+// DEK Registry error codes returned in the error_code field of API error responses.
+const (
+	ErrorCodeKeyNotFound    = 40470
+	ErrorCodeKeySoftDeleted = 40472
+	ErrorCodeKeyExists      = 40971
+	ErrorCodeKeyReferenced  = 42271
+)
+
+// IsKeyNotFound returns true if the KEK or DEK does not exist (40470)
+func IsKeyNotFound(err error) bool {
+	code, ok := schemaregistry.GetSRCode(err)
+	return ok && code == ErrorCodeKeyNotFound
+}
+
+// IsKeySoftDeleted returns true if the KEK or DEK has been soft-deleted (40472)
+func IsKeySoftDeleted(err error) bool {
+	code, ok := schemaregistry.GetSRCode(err)
+	return ok && code == ErrorCodeKeySoftDeleted
+}
+
+// IsKeyExists returns true if a KEK or DEK with the same name already exists (40971)
+func IsKeyExists(err error) bool {
+	code, ok := schemaregistry.GetSRCode(err)
+	return ok && code == ErrorCodeKeyExists
+}
+
+// IsKeyReferenced returns true if a KEK cannot be deleted because DEKs still use it (42271)
+func IsKeyReferenced(err error) bool {
+	code, ok := schemaregistry.GetSRCode(err)
+	return ok && code == ErrorCodeKeyReferenced
+}
+
+// Kek is a key encryption key backed by a KMS key.
+type Kek struct {
+	Name     string            `json:"name"`
+	KmsType  string            `json:"kmsType"`
+	KmsKeyID string            `json:"kmsKeyId"`
+	KmsProps map[string]string `json:"kmsProps,omitempty"`
+	Doc      string            `json:"doc,omitempty"`
+	// Shared allows the DEK Registry to access the KMS key and generate DEKs on behalf of clients.
+	Shared  bool `json:"shared"`
+	Deleted bool `json:"deleted,omitempty"`
+	// Timestamp is the last modification time in milliseconds since the epoch
+	Timestamp int64 `json:"ts,omitempty"`
+}
+
+// KekUpdate holds the mutable fields of a KEK.
+type KekUpdate struct {
+	KmsProps map[string]string `json:"kmsProps,omitempty"`
+	Doc      string            `json:"doc,omitempty"`
+	Shared   bool              `json:"shared"`
+}
+
+// Dek is a versioned data encryption key for a subject, encrypted with a KEK.
+type Dek struct {
+	KekName   string `json:"kekName,omitempty"`
+	Subject   string `json:"subject"`
+	Version   int    `json:"version,omitempty"`
+	Algorithm string `json:"algorithm,omitempty"`
+	// EncryptedKeyMaterial is the DEK encrypted with the KEK. It may be omitted on
+	// creation when the KEK is shared, in which case the registry generates it.
+	EncryptedKeyMaterial string `json:"encryptedKeyMaterial,omitempty"`
+	// KeyMaterial is the plaintext DEK, only returned for shared KEKs.
+	KeyMaterial string `json:"keyMaterial,omitempty"`
+	Deleted     bool   `json:"deleted,omitempty"`
+	Timestamp   int64  `json:"ts,omitempty"`
+}
+
+// Manager provides operations against the DEK Registry.
+type Manager struct {
+	c        client.Doer
+	basePath string
+}
+
+// NewManager creates a new DEK Registry manager using the shared REST client.
+// basePath is typically "/dek-registry/v1".
+func NewManager(c client.Doer, basePath string) *Manager {
+	if basePath == "" {
+		basePath = "/dek-registry/v1"
+	}
+	return &Manager{c: c, basePath: basePath}
+}
+
+// ListKeks returns the names of all KEKs. When deleted=true soft-deleted KEKs are included.
+func (m *Manager) ListKeks(ctx context.Context, deleted bool) ([]string, error) {
+	var names []string
+	if err := m.get(ctx, m.kekPath("")+deletedQuery(deleted), &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// CreateKek registers a KEK.
+func (m *Manager) CreateKek(ctx context.Context, kek Kek) (*Kek, error) {
+	var out Kek
+	req := client.Request{Method: "POST", Path: m.kekPath(""), Body: kek}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetKek returns a KEK by name.
+func (m *Manager) GetKek(ctx context.Context, name string) (*Kek, error) {
+	var out Kek
+	if err := m.get(ctx, m.kekPath(name), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateKek updates the KMS properties, doc, and sharing of a KEK.
+func (m *Manager) UpdateKek(ctx context.Context, name string, update KekUpdate) (*Kek, error) {
+	var out Kek
+	req := client.Request{Method: "PUT", Path: m.kekPath(name), Body: update}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteKek deletes a KEK. When permanent=true a hard delete is performed; the KEK
+// must be soft-deleted first and no longer referenced by DEKs.
+func (m *Manager) DeleteKek(ctx context.Context, name string, permanent bool) error {
+	return m.delete(ctx, m.kekPath(name), permanent)
+}
+
+// UndeleteKek restores a soft-deleted KEK.
+func (m *Manager) UndeleteKek(ctx context.Context, name string) error {
+	req := client.Request{Method: "POST", Path: m.kekPath(name) + "/undelete"}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+// ListDeks returns the subjects that have DEKs under a KEK.
+func (m *Manager) ListDeks(ctx context.Context, kekName string, deleted bool) ([]string, error) {
+	var subjects []string
+	if err := m.get(ctx, m.kekPath(kekName)+"/deks"+deletedQuery(deleted), &subjects); err != nil {
+		return nil, err
+	}
+	return subjects, nil
+}
+
+// CreateDek creates a DEK for a subject under a KEK. A zero Version creates version 1.
+func (m *Manager) CreateDek(ctx context.Context, kekName string, dek Dek) (*Dek, error) {
+	var out Dek
+	req := client.Request{Method: "POST", Path: m.kekPath(kekName) + "/deks", Body: dek}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDek returns the latest DEK version for a subject. An empty algorithm uses the
+// registry default (AES256_GCM).
+func (m *Manager) GetDek(ctx context.Context, kekName, subject, algorithm string) (*Dek, error) {
+	return m.getDek(ctx, m.dekPath(kekName, subject), algorithm)
+}
+
+// GetDekVersion returns a specific DEK version for a subject.
+func (m *Manager) GetDekVersion(ctx context.Context, kekName, subject string, version int, algorithm string) (*Dek, error) {
+	return m.getDek(ctx, fmt.Sprintf("%s/versions/%d", m.dekPath(kekName, subject), version), algorithm)
+}
+
+// ListDekVersions returns the DEK versions for a subject.
+func (m *Manager) ListDekVersions(ctx context.Context, kekName, subject string) ([]int, error) {
+	var versions []int
+	if err := m.get(ctx, m.dekPath(kekName, subject)+"/versions", &versions); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// RotateDek creates the next DEK version for a subject. New data is encrypted with the
+// new version while older versions remain available for decryption. If the subject has
+// no DEK yet, version 1 is created. The KEK must be shared so the registry can generate
+// the key material.
+func (m *Manager) RotateDek(ctx context.Context, kekName, subject, algorithm string) (*Dek, error) {
+	next := 1
+	latest, err := m.GetDek(ctx, kekName, subject, algorithm)
+	switch {
+	case err == nil:
+		next = latest.Version + 1
+	case !IsKeyNotFound(err):
+		return nil, fmt.Errorf("failed to read current DEK for %s: %w", subject, err)
+	}
+	return m.CreateDek(ctx, kekName, Dek{Subject: subject, Version: next, Algorithm: algorithm})
+}
+
+// DeleteDek deletes every DEK version for a subject. When permanent=true a hard delete
+// is performed; the DEKs must be soft-deleted first.
+func (m *Manager) DeleteDek(ctx context.Context, kekName, subject string, permanent bool) error {
+	return m.delete(ctx, m.dekPath(kekName, subject), permanent)
+}
+
+func (m *Manager) getDek(ctx context.Context, path, algorithm string) (*Dek, error) {
+	if algorithm != "" {
+		path += "?algorithm=" + url.QueryEscape(algorithm)
+	}
+	var out Dek
+	if err := m.get(ctx, path, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (m *Manager) get(ctx context.Context, path string, out interface{}) error {
+	req := client.Request{Method: "GET", Path: path}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	return resp.DecodeJSON(out)
+}
+
+func (m *Manager) delete(ctx context.Context, path string, permanent bool) error {
+	if permanent {
+		path += "?permanent=true"
+	}
+	req := client.Request{Method: "DELETE", Path: path}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+func (m *Manager) kekPath(name string) string {
+	if name == "" {
+		return m.basePath + "/keks"
+	}
+	return fmt.Sprintf("%s/keks/%s", m.basePath, url.PathEscape(name))
+}
+
+func (m *Manager) dekPath(kekName, subject string) string {
+	return fmt.Sprintf("%s/deks/%s", m.kekPath(kekName), url.PathEscape(subject))
+}
+
+func deletedQuery(deleted bool) string {
+	if deleted {
+		return "?deleted=true"
+	}
+	return ""
+}
@@ -0,0 +1,166 @@
+package dekregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+func newTestManager(t *testing.T, handler http.HandlerFunc) *Manager {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:   srv.URL,
+		APIKey:    "key",
+		APISecret: "secret",
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return NewManager(c, "")
+}
+
+func writeKeyNotFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte(`{"error_code":40470,"message":"Key not found"}`))
+}
+
+func TestKekCRUD(t *testing.T) {
+	var calls []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /dek-registry/v1/keks":
+			_ = json.NewEncoder(w).Encode([]string{"payments-kek"})
+		case "POST /dek-registry/v1/keks":
+			var k Kek
+			_ = json.NewDecoder(r.Body).Decode(&k)
+			k.Timestamp = 1
+			_ = json.NewEncoder(w).Encode(k)
+		case "GET /dek-registry/v1/keks/payments-kek", "PUT /dek-registry/v1/keks/payments-kek":
+			_ = json.NewEncoder(w).Encode(Kek{Name: "payments-kek", KmsType: KmsTypeAWS, Shared: true, Doc: "updated"})
+		case "DELETE /dek-registry/v1/keks/payments-kek", "POST /dek-registry/v1/keks/payments-kek/undelete":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeKeyNotFound(w)
+		}
+	}
+	m := newTestManager(t, handler)
+	ctx := context.Background()
+
+	kek, err := m.CreateKek(ctx, Kek{Name: "payments-kek", KmsType: KmsTypeAWS, KmsKeyID: "arn:aws:kms:key", Shared: true})
+	if err != nil || kek.Name != "payments-kek" || kek.Timestamp != 1 {
+		t.Fatalf("CreateKek: %#v err=%v", kek, err)
+	}
+	names, err := m.ListKeks(ctx, true)
+	if err != nil || len(names) != 1 {
+		t.Fatalf("ListKeks: %v err=%v", names, err)
+	}
+	if kek, err = m.GetKek(ctx, "payments-kek"); err != nil || kek.KmsType != KmsTypeAWS {
+		t.Fatalf("GetKek: %#v err=%v", kek, err)
+	}
+	if kek, err = m.UpdateKek(ctx, "payments-kek", KekUpdate{Doc: "updated", Shared: true}); err != nil || kek.Doc != "updated" {
+		t.Fatalf("UpdateKek: %#v err=%v", kek, err)
+	}
+	if err := m.DeleteKek(ctx, "payments-kek", false); err != nil {
+		t.Fatalf("DeleteKek: %v", err)
+	}
+	if err := m.UndeleteKek(ctx, "payments-kek"); err != nil {
+		t.Fatalf("UndeleteKek: %v", err)
+	}
+	if err := m.DeleteKek(ctx, "payments-kek", true); err != nil {
+		t.Fatalf("DeleteKek permanent: %v", err)
+	}
+	if _, err := m.GetKek(ctx, "missing"); !IsKeyNotFound(err) {
+		t.Fatalf("expected key not found, got %v", err)
+	}
+
+	if calls[1] != "GET /dek-registry/v1/keks?deleted=true" || calls[6] != "DELETE /dek-registry/v1/keks/payments-kek?permanent=true" {
+		t.Errorf("unexpected calls: %v", calls)
+	}
+}
+
+func TestRotateDek(t *testing.T) {
+	latest := 0
+	var created []Dek
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /dek-registry/v1/keks/payments-kek/deks/orders-value":
+			if r.URL.Query().Get("algorithm") != AlgorithmAES256GCM {
+				http.Error(w, "missing algorithm", http.StatusBadRequest)
+				return
+			}
+			if latest == 0 {
+				writeKeyNotFound(w)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(Dek{Subject: "orders-value", Version: latest, Algorithm: AlgorithmAES256GCM})
+		case "POST /dek-registry/v1/keks/payments-kek/deks":
+			var d Dek
+			_ = json.NewDecoder(r.Body).Decode(&d)
+			created = append(created, d)
+			latest = d.Version
+			d.KekName = "payments-kek"
+			_ = json.NewEncoder(w).Encode(d)
+		default:
+			writeKeyNotFound(w)
+		}
+	}
+	m := newTestManager(t, handler)
+	ctx := context.Background()
+
+	for want := 1; want <= 2; want++ {
+		dek, err := m.RotateDek(ctx, "payments-kek", "orders-value", AlgorithmAES256GCM)
+		if err != nil {
+			t.Fatalf("RotateDek error: %v", err)
+		}
+		if dek.Version != want || dek.KekName != "payments-kek" {
+			t.Fatalf("rotation %d: unexpected dek %#v", want, dek)
+		}
+	}
+	if len(created) != 2 || created[1].Algorithm != AlgorithmAES256GCM || created[1].EncryptedKeyMaterial != "" {
+		t.Fatalf("unexpected create bodies: %#v", created)
+	}
+}
+
+func TestDekReads(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.RequestURI() {
+		case "GET /dek-registry/v1/keks/k/deks":
+			_ = json.NewEncoder(w).Encode([]string{"orders-value"})
+		case "GET /dek-registry/v1/keks/k/deks/orders-value/versions":
+			_ = json.NewEncoder(w).Encode([]int{1, 2})
+		case "GET /dek-registry/v1/keks/k/deks/orders-value/versions/1":
+			_ = json.NewEncoder(w).Encode(Dek{Subject: "orders-value", Version: 1})
+		case "DELETE /dek-registry/v1/keks/k/deks/orders-value":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeKeyNotFound(w)
+		}
+	}
+	m := newTestManager(t, handler)
+	ctx := context.Background()
+
+	if subjects, err := m.ListDeks(ctx, "k", false); err != nil || len(subjects) != 1 {
+		t.Fatalf("ListDeks: %v err=%v", subjects, err)
+	}
+	if versions, err := m.ListDekVersions(ctx, "k", "orders-value"); err != nil || len(versions) != 2 {
+		t.Fatalf("ListDekVersions: %v err=%v", versions, err)
+	}
+	if dek, err := m.GetDekVersion(ctx, "k", "orders-value", 1, ""); err != nil || dek.Version != 1 {
+		t.Fatalf("GetDekVersion: %#v err=%v", dek, err)
+	}
+	if err := m.DeleteDek(ctx, "k", "orders-value", false); err != nil {
+		t.Fatalf("DeleteDek: %v", err)
+	}
+}
@@ -0,0 +1,552 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind classifies a single difference between two schemas.
+type ChangeKind string
+
+// Change kinds reported by DiffSchemas.
+const (
+	ChangeFieldAdded        ChangeKind = "field added"
+	ChangeFieldRemoved      ChangeKind = "field removed"
+	ChangeFieldRenamed      ChangeKind = "field renamed"
+	ChangeTypeChanged       ChangeKind = "type changed"
+	ChangeDefaultChanged    ChangeKind = "default changed"
+	ChangeRequiredAdded     ChangeKind = "required added"
+	ChangeRequiredRemoved   ChangeKind = "required removed"
+	ChangeEnumSymbolAdded   ChangeKind = "enum symbol added"
+	ChangeEnumSymbolRemoved ChangeKind = "enum symbol removed"
+	ChangeMessageAdded      ChangeKind = "message added"
+	ChangeMessageRemoved    ChangeKind = "message removed"
+)
+
+// SchemaChange is one difference between two schema versions.
+type SchemaChange struct {
+	Kind ChangeKind
+	// Path locates the change, e.g. "User.address.zip" (Avro/Protobuf) or
+	// "properties.address.zip" (JSON Schema).
+	Path string
+	Old  string
+	New  string
+	// Breaking is true when data written with the old schema can no longer be read
+	// with the new one, i.e. the change violates BACKWARD compatibility.
+	Breaking bool
+}
+
+// String formats the change as a single report line.
+func (c SchemaChange) String() string {
+	marker := "         "
+	if c.Breaking {
+		marker = "BREAKING "
+	}
+	switch {
+	case c.Old != "" && c.New != "":
+		return fmt.Sprintf("%s%s: %s (%s -> %s)", marker, c.Kind, c.Path, c.Old, c.New)
+	case c.New != "":
+		return fmt.Sprintf("%s%s: %s (%s)", marker, c.Kind, c.Path, c.New)
+	case c.Old != "":
+		return fmt.Sprintf("%s%s: %s (%s)", marker, c.Kind, c.Path, c.Old)
+	}
+	return fmt.Sprintf("%s%s: %s", marker, c.Kind, c.Path)
+}
+
+// SchemaDiff is the structured difference between two schema versions.
+type SchemaDiff struct {
+	Changes []SchemaChange
+}
+
+// Breaking reports whether any change breaks BACKWARD compatibility.
+func (d *SchemaDiff) Breaking() bool {
+	for _, c := range d.Changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// BreakingChanges returns only the changes that break BACKWARD compatibility.
+func (d *SchemaDiff) BreakingChanges() []SchemaChange {
+	var out []SchemaChange
+	for _, c := range d.Changes {
+		if c.Breaking {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// String renders a human-readable evolution report, one change per line.
+func (d *SchemaDiff) String() string {
+	if len(d.Changes) == 0 {
+		return "no changes"
+	}
+	lines := make([]string, len(d.Changes))
+	for i, c := range d.Changes {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DiffSchemas compares two versions of a schema and classifies every field-level change
+// as breaking or not under BACKWARD compatibility (consumers upgrade first), the
+// registry default. It is a client-side aid for evolution reports in CI; the registry's
+// own compatibility check (TestCompatibility) remains authoritative.
+//
+// Both schemas are validated first. If schemaType is empty, it defaults to AVRO.
+// Changes are sorted by path, then kind.
+func DiffSchemas(oldSchema, newSchema, schemaType string) (*SchemaDiff, error) {
+	if schemaType == "" {
+		schemaType = SchemaTypeAvro
+	}
+	if err := ValidateSchema(oldSchema, schemaType); err != nil {
+		return nil, fmt.Errorf("invalid old schema: %w", err)
+	}
+	if err := ValidateSchema(newSchema, schemaType); err != nil {
+		return nil, fmt.Errorf("invalid new schema: %w", err)
+	}
+
+	d := &SchemaDiff{}
+	switch schemaType {
+	case SchemaTypeAvro:
+		var o, n interface{}
+		if err := json.Unmarshal([]byte(oldSchema), &o); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(newSchema), &n); err != nil {
+			return nil, err
+		}
+		path := avroTypeName(o)
+		d.diffAvro(path, o, n)
+	case SchemaTypeJSON:
+		var o, n map[string]interface{}
+		if err := json.Unmarshal([]byte(oldSchema), &o); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(newSchema), &n); err != nil {
+			return nil, err
+		}
+		d.diffJSON("", o, n)
+	case SchemaTypeProtobuf:
+		d.diffProtobuf(parseProtoMessages(oldSchema), parseProtoMessages(newSchema))
+	}
+
+	sort.Slice(d.Changes, func(i, j int) bool {
+		a, b := d.Changes[i], d.Changes[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Old+a.New < b.Old+b.New
+	})
+	return d, nil
+}
+
+func (d *SchemaDiff) add(c SchemaChange) {
+	d.Changes = append(d.Changes, c)
+}
+
+func joinPath(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+	return parent + "." + child
+}
+
+// Avro
+
+func (d *SchemaDiff) diffAvro(path string, o, n interface{}) {
+	om, oIsMap := o.(map[string]interface{})
+	nm, nIsMap := n.(map[string]interface{})
+	if oIsMap && nIsMap && om["type"] == nm["type"] {
+		switch om["type"] {
+		case "record", "error":
+			d.diffAvroRecord(path, om, nm)
+			return
+		case "enum":
+			d.diffAvroEnum(path, om, nm)
+			return
+		case "array":
+			d.diffAvro(path+"[]", om["items"], nm["items"])
+			return
+		case "map":
+			d.diffAvro(path+"{}", om["values"], nm["values"])
+			return
+		}
+	}
+
+	ou, oIsUnion := o.([]interface{})
+	nu, nIsUnion := n.([]interface{})
+	if oIsUnion && nIsUnion {
+		d.diffAvroUnion(path, ou, nu)
+		return
+	}
+
+	oldName, newName := avroTypeName(o), avroTypeName(n)
+	if oldName == newName {
+		return
+	}
+	d.add(SchemaChange{
+		Kind:     ChangeTypeChanged,
+		Path:     path,
+		Old:      oldName,
+		New:      newName,
+		Breaking: !avroReadable(o, n),
+	})
+}
+
+func (d *SchemaDiff) diffAvroRecord(path string, o, n map[string]interface{}) {
+	oldFields := avroFields(o)
+	newFields := avroFields(n)
+
+	for name, of := range oldFields {
+		fieldPath := joinPath(path, name)
+		nf, ok := newFields[name]
+		if !ok {
+			d.add(SchemaChange{Kind: ChangeFieldRemoved, Path: fieldPath, Old: avroTypeName(of["type"])})
+			continue
+		}
+		d.diffAvro(fieldPath, of["type"], nf["type"])
+		oldDefault, hadDefault := of["default"]
+		newDefault, hasDefault := nf["default"]
+		if hadDefault != hasDefault || (hadDefault && jsonString(oldDefault) != jsonString(newDefault)) {
+			c := SchemaChange{Kind: ChangeDefaultChanged, Path: fieldPath}
+			if hadDefault {
+				c.Old = jsonString(oldDefault)
+			}
+			if hasDefault {
+				c.New = jsonString(newDefault)
+			}
+			d.add(c)
+		}
+	}
+	for name, nf := range newFields {
+		if _, ok := oldFields[name]; ok {
+			continue
+		}
+		_, hasDefault := nf["default"]
+		d.add(SchemaChange{
+			Kind:     ChangeFieldAdded,
+			Path:     joinPath(path, name),
+			New:      avroTypeName(nf["type"]),
+			Breaking: !hasDefault,
+		})
+	}
+}
+
+func (d *SchemaDiff) diffAvroEnum(path string, o, n map[string]interface{}) {
+	oldSymbols := stringSet(o["symbols"])
+	newSymbols := stringSet(n["symbols"])
+	_, hasDefault := n["default"]
+	for s := range oldSymbols {
+		if !newSymbols[s] {
+			d.add(SchemaChange{Kind: ChangeEnumSymbolRemoved, Path: path, Old: s, Breaking: !hasDefault})
+		}
+	}
+	for s := range newSymbols {
+		if !oldSymbols[s] {
+			d.add(SchemaChange{Kind: ChangeEnumSymbolAdded, Path: path, New: s})
+		}
+	}
+}
+
+func (d *SchemaDiff) diffAvroUnion(path string, o, n []interface{}) {
+	newBranches := make(map[string]interface{}, len(n))
+	for _, b := range n {
+		newBranches[avroTypeName(b)] = b
+	}
+	oldBranches := make(map[string]bool, len(o))
+	for _, b := range o {
+		name := avroTypeName(b)
+		oldBranches[name] = true
+		nb, ok := newBranches[name]
+		if !ok {
+			d.add(SchemaChange{Kind: ChangeTypeChanged, Path: path, Old: name, New: avroTypeName(n), Breaking: !avroReadable(b, n)})
+			continue
+		}
+		d.diffAvro(path, b, nb)
+	}
+	for name := range newBranches {
+		if !oldBranches[name] {
+			d.add(SchemaChange{Kind: ChangeTypeChanged, Path: path, Old: avroTypeName(o), New: avroTypeName(n)})
+			return
+		}
+	}
+}
+
+// avroReadable reports whether data written as writer can be read as reader,
+// following the Avro schema resolution rules for promotions and unions.
+func avroReadable(writer, reader interface{}) bool {
+	if wu, ok := writer.([]interface{}); ok {
+		for _, b := range wu {
+			if !avroReadable(b, reader) {
+				return false
+			}
+		}
+		return true
+	}
+	if ru, ok := reader.([]interface{}); ok {
+		for _, b := range ru {
+			if avroReadable(writer, b) {
+				return true
+			}
+		}
+		return false
+	}
+	w, r := avroTypeName(writer), avroTypeName(reader)
+	if w == r {
+		return true
+	}
+	promotions := map[string][]string{
+		"int":    {"long", "float", "double"},
+		"long":   {"float", "double"},
+		"float":  {"double"},
+		"string": {"bytes"},
+		"bytes":  {"string"},
+	}
+	for _, p := range promotions[w] {
+		if p == r {
+			return true
+		}
+	}
+	return false
+}
+
+// avroTypeName renders an Avro type as a short readable name.
+func avroTypeName(t interface{}) string {
+	switch v := t.(type) {
+	case string:
+		return v
+	case []interface{}:
+		names := make([]string, len(v))
+		for i, b := range v {
+			names[i] = avroTypeName(b)
+		}
+		return "union[" + strings.Join(names, ",") + "]"
+	case map[string]interface{}:
+		typ, _ := v["type"].(string)
+		switch typ {
+		case "record", "error", "enum", "fixed":
+			if name, ok := v["name"].(string); ok {
+				if ns, ok := v["namespace"].(string); ok && ns != "" && !strings.Contains(name, ".") {
+					return ns + "." + name
+				}
+				return name
+			}
+		case "array":
+			return "array<" + avroTypeName(v["items"]) + ">"
+		case "map":
+			return "map<" + avroTypeName(v["values"]) + ">"
+		}
+		if lt, ok := v["logicalType"].(string); ok {
+			return typ + "(" + lt + ")"
+		}
+		if typ != "" {
+			return typ
+		}
+		return avroTypeName(v["type"])
+	}
+	return fmt.Sprintf("%v", t)
+}
+
+func avroFields(record map[string]interface{}) map[string]map[string]interface{} {
+	out := make(map[string]map[string]interface{})
+	fields, _ := record["fields"].([]interface{})
+	for _, f := range fields {
+		if fm, ok := f.(map[string]interface{}); ok {
+			if name, ok := fm["name"].(string); ok {
+				out[name] = fm
+			}
+		}
+	}
+	return out
+}
+
+// JSON Schema
+
+func (d *SchemaDiff) diffJSON(path string, o, n map[string]interface{}) {
+	if ot, nt := jsonString(o["type"]), jsonString(n["type"]); o["type"] != nil && n["type"] != nil && ot != nt {
+		d.add(SchemaChange{Kind: ChangeTypeChanged, Path: pathOrRoot(path), Old: ot, New: nt, Breaking: true})
+	}
+
+	oldRequired := stringSet(o["required"])
+	newRequired := stringSet(n["required"])
+	for name := range newRequired {
+		if !oldRequired[name] {
+			d.add(SchemaChange{Kind: ChangeRequiredAdded, Path: joinPath(joinPath(path, "properties"), name), Breaking: true})
+		}
+	}
+	for name := range oldRequired {
+		if !newRequired[name] {
+			d.add(SchemaChange{Kind: ChangeRequiredRemoved, Path: joinPath(joinPath(path, "properties"), name)})
+		}
+	}
+
+	oldProps, _ := o["properties"].(map[string]interface{})
+	newProps, _ := n["properties"].(map[string]interface{})
+	for name, op := range oldProps {
+		propPath := joinPath(joinPath(path, "properties"), name)
+		np, ok := newProps[name]
+		if !ok {
+			d.add(SchemaChange{Kind: ChangeFieldRemoved, Path: propPath, Old: jsonString(jsonType(op))})
+			continue
+		}
+		om, _ := op.(map[string]interface{})
+		nm, _ := np.(map[string]interface{})
+		if om != nil && nm != nil {
+			d.diffJSON(propPath, om, nm)
+		}
+	}
+	for name, np := range newProps {
+		if _, ok := oldProps[name]; !ok {
+			d.add(SchemaChange{Kind: ChangeFieldAdded, Path: joinPath(joinPath(path, "properties"), name), New: jsonString(jsonType(np))})
+		}
+	}
+
+	oi, _ := o["items"].(map[string]interface{})
+	ni, _ := n["items"].(map[string]interface{})
+	if oi != nil && ni != nil {
+		d.diffJSON(joinPath(path, "items"), oi, ni)
+	}
+}
+
+func jsonType(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		if t, ok := m["type"]; ok {
+			return t
+		}
+		if ref, ok := m["$ref"]; ok {
+			return ref
+		}
+	}
+	return nil
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return path
+}
+
+// Protobuf
+
+type protoField struct {
+	name string
+	typ  string
+}
+
+var (
+	protoBlockRe = regexp.MustCompile(`^\s*(message|enum|oneof)\s+(\w+)\s*\{`)
+	protoFieldRe = regexp.MustCompile(`^\s*(?:optional\s+|repeated\s+|required\s+)?((?:repeated\s+)?[\w.]+(?:\s*<\s*[\w.]+\s*,\s*[\w.]+\s*>)?)\s+(\w+)\s*=\s*(\d+)`)
+)
+
+// parseProtoMessages extracts fields by number for every message, keyed by the
+// dotted nested message name. It is a line-based reader, not a full .proto parser.
+func parseProtoMessages(schema string) map[string]map[int]protoField {
+	type block struct {
+		kind string
+		name string
+	}
+	messages := make(map[string]map[int]protoField)
+	var stack []block
+	messagePath := func() string {
+		var parts []string
+		for _, b := range stack {
+			if b.kind == "message" {
+				parts = append(parts, b.name)
+			}
+		}
+		return strings.Join(parts, ".")
+	}
+
+	for _, line := range strings.Split(schema, "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		if m := protoBlockRe.FindStringSubmatch(line); m != nil {
+			stack = append(stack, block{kind: m[1], name: m[2]})
+			if m[1] == "message" {
+				messages[messagePath()] = make(map[int]protoField)
+			}
+		} else if len(stack) > 0 && stack[len(stack)-1].kind != "enum" {
+			if m := protoFieldRe.FindStringSubmatch(line); m != nil && m[1] != "option" && m[1] != "reserved" {
+				num, _ := strconv.Atoi(m[3])
+				messages[messagePath()][num] = protoField{name: m[2], typ: strings.Join(strings.Fields(m[1]), " ")}
+			}
+		}
+		opens := strings.Count(line, "{")
+		if protoBlockRe.MatchString(line) {
+			opens--
+		}
+		for i := 0; i < opens; i++ {
+			stack = append(stack, block{kind: "other"})
+		}
+		for i := strings.Count(line, "}"); i > 0 && len(stack) > 0; i-- {
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return messages
+}
+
+func (d *SchemaDiff) diffProtobuf(o, n map[string]map[int]protoField) {
+	for msg, oldFields := range o {
+		newFields, ok := n[msg]
+		if !ok {
+			d.add(SchemaChange{Kind: ChangeMessageRemoved, Path: msg, Breaking: true})
+			continue
+		}
+		for num, of := range oldFields {
+			nf, ok := newFields[num]
+			path := joinPath(msg, of.name)
+			switch {
+			case !ok:
+				d.add(SchemaChange{Kind: ChangeFieldRemoved, Path: path, Old: fmt.Sprintf("%s = %d", of.typ, num)})
+			case of.typ != nf.typ:
+				d.add(SchemaChange{Kind: ChangeTypeChanged, Path: path, Old: of.typ, New: nf.typ, Breaking: true})
+			case of.name != nf.name:
+				d.add(SchemaChange{Kind: ChangeFieldRenamed, Path: path, Old: of.name, New: nf.name})
+			}
+		}
+		for num, nf := range newFields {
+			if _, ok := oldFields[num]; !ok {
+				d.add(SchemaChange{Kind: ChangeFieldAdded, Path: joinPath(msg, nf.name), New: fmt.Sprintf("%s = %d", nf.typ, num)})
+			}
+		}
+	}
+	for msg := range n {
+		if _, ok := o[msg]; !ok {
+			d.add(SchemaChange{Kind: ChangeMessageAdded, Path: msg})
+		}
+	}
+}
+
+// helpers
+
+func stringSet(v interface{}) map[string]bool {
+	out := make(map[string]bool)
+	list, _ := v.([]interface{})
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			out[s] = true
+		}
+	}
+	return out
+}
+
+func jsonString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
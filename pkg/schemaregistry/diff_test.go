@@ -0,0 +1,179 @@
+package schemaregistry
+
+import (
+	"strings"
+	"testing"
+)
+
+func findChange(d *SchemaDiff, kind ChangeKind, path string) *SchemaChange {
+	for i := range d.Changes {
+		if d.Changes[i].Kind == kind && d.Changes[i].Path == path {
+			return &d.Changes[i]
+		}
+	}
+	return nil
+}
+
+func TestDiffSchemas_Avro(t *testing.T) {
+	oldSchema := `{"type":"record","name":"User","namespace":"com.acme","fields":[
+		{"name":"id","type":"int"},
+		{"name":"email","type":"string"},
+		{"name":"status","type":{"type":"enum","name":"Status","symbols":["ACTIVE","BANNED"]}},
+		{"name":"nickname","type":["null","string"],"default":null},
+		{"name":"age","type":"int"}
+	]}`
+	newSchema := `{"type":"record","name":"User","namespace":"com.acme","fields":[
+		{"name":"id","type":"long"},
+		{"name":"status","type":{"type":"enum","name":"Status","symbols":["ACTIVE","DELETED"]}},
+		{"name":"nickname","type":["null","string"],"default":null},
+		{"name":"age","type":"string"},
+		{"name":"country","type":"string","default":"US"},
+		{"name":"tenant","type":"string"}
+	]}`
+
+	d, err := DiffSchemas(oldSchema, newSchema, SchemaTypeAvro)
+	if err != nil {
+		t.Fatalf("DiffSchemas error: %v", err)
+	}
+
+	cases := []struct {
+		kind     ChangeKind
+		path     string
+		breaking bool
+	}{
+		{ChangeTypeChanged, "com.acme.User.id", false}, // int -> long is a promotion
+		{ChangeTypeChanged, "com.acme.User.age", true},
+		{ChangeFieldRemoved, "com.acme.User.email", false},
+		{ChangeFieldAdded, "com.acme.User.country", false},
+		{ChangeFieldAdded, "com.acme.User.tenant", true},
+		{ChangeEnumSymbolRemoved, "com.acme.User.status", true},
+		{ChangeEnumSymbolAdded, "com.acme.User.status", false},
+	}
+	for _, tc := range cases {
+		c := findChange(d, tc.kind, tc.path)
+		if c == nil {
+			t.Errorf("missing %s at %s in:\n%s", tc.kind, tc.path, d)
+			continue
+		}
+		if c.Breaking != tc.breaking {
+			t.Errorf("%s at %s: breaking=%v, want %v", tc.kind, tc.path, c.Breaking, tc.breaking)
+		}
+	}
+	if len(d.Changes) != len(cases) {
+		t.Errorf("expected %d changes, got:\n%s", len(cases), d)
+	}
+	if !d.Breaking() || len(d.BreakingChanges()) != 3 {
+		t.Errorf("expected 3 breaking changes, got %d", len(d.BreakingChanges()))
+	}
+	if !strings.Contains(d.String(), "BREAKING field added: com.acme.User.tenant (string)") {
+		t.Errorf("unexpected report:\n%s", d)
+	}
+}
+
+func TestDiffSchemas_AvroUnionWidening(t *testing.T) {
+	oldSchema := `{"type":"record","name":"R","fields":[{"name":"v","type":"string"}]}`
+	newSchema := `{"type":"record","name":"R","fields":[{"name":"v","type":["null","string"],"default":null}]}`
+
+	d, err := DiffSchemas(oldSchema, newSchema, "")
+	if err != nil {
+		t.Fatalf("DiffSchemas error: %v", err)
+	}
+	if d.Breaking() {
+		t.Errorf("making a field nullable should not be breaking:\n%s", d)
+	}
+	if findChange(d, ChangeTypeChanged, "R.v") == nil || findChange(d, ChangeDefaultChanged, "R.v") == nil {
+		t.Errorf("expected type and default changes:\n%s", d)
+	}
+}
+
+func TestDiffSchemas_JSON(t *testing.T) {
+	oldSchema := `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"}},"required":["id"]}`
+	newSchema := `{"type":"object","properties":{"id":{"type":"string"},"email":{"type":"string"}},"required":["id","email"]}`
+
+	d, err := DiffSchemas(oldSchema, newSchema, SchemaTypeJSON)
+	if err != nil {
+		t.Fatalf("DiffSchemas error: %v", err)
+	}
+	if c := findChange(d, ChangeTypeChanged, "properties.id"); c == nil || !c.Breaking || c.Old != "integer" || c.New != "string" {
+		t.Errorf("expected breaking type change on id, got %#v", c)
+	}
+	if c := findChange(d, ChangeRequiredAdded, "properties.email"); c == nil || !c.Breaking {
+		t.Errorf("expected breaking required addition, got %#v", c)
+	}
+	if c := findChange(d, ChangeFieldRemoved, "properties.name"); c == nil || c.Breaking {
+		t.Errorf("expected non-breaking removal, got %#v", c)
+	}
+	if c := findChange(d, ChangeFieldAdded, "properties.email"); c == nil {
+		t.Errorf("expected email addition:\n%s", d)
+	}
+}
+
+func TestDiffSchemas_Protobuf(t *testing.T) {
+	oldSchema := `syntax = "proto3";
+package acme;
+
+message Order {
+  string id = 1;
+  int32 quantity = 2;
+  string note = 3; // free text
+  message Line {
+    string sku = 1;
+  }
+  repeated Line lines = 4;
+}
+
+message Legacy {
+  string x = 1;
+}`
+	newSchema := `syntax = "proto3";
+package acme;
+
+message Order {
+  string id = 1;
+  int64 quantity = 2;
+  repeated Line items = 4;
+  map<string, string> labels = 5;
+  message Line {
+    string sku = 1;
+    oneof price {
+      int64 cents = 2;
+    }
+  }
+}`
+
+	d, err := DiffSchemas(oldSchema, newSchema, SchemaTypeProtobuf)
+	if err != nil {
+		t.Fatalf("DiffSchemas error: %v", err)
+	}
+	cases := []struct {
+		kind     ChangeKind
+		path     string
+		breaking bool
+	}{
+		{ChangeTypeChanged, "Order.quantity", true},
+		{ChangeFieldRemoved, "Order.note", false},
+		{ChangeFieldRenamed, "Order.lines", false},
+		{ChangeFieldAdded, "Order.labels", false},
+		{ChangeFieldAdded, "Order.Line.cents", false},
+		{ChangeMessageRemoved, "Legacy", true},
+	}
+	for _, tc := range cases {
+		c := findChange(d, tc.kind, tc.path)
+		if c == nil {
+			t.Errorf("missing %s at %s in:\n%s", tc.kind, tc.path, d)
+			continue
+		}
+		if c.Breaking != tc.breaking {
+			t.Errorf("%s at %s: breaking=%v, want %v", tc.kind, tc.path, c.Breaking, tc.breaking)
+		}
+	}
+	if len(d.Changes) != len(cases) {
+		t.Errorf("expected %d changes, got:\n%s", len(cases), d)
+	}
+}
+
+func TestDiffSchemas_InvalidSchema(t *testing.T) {
+	if _, err := DiffSchemas(`{"type":"record"}`, `{"type":"string"}`, SchemaTypeAvro); err == nil || !strings.Contains(err.Error(), "invalid old schema") {
+		t.Fatalf("expected invalid old schema error, got %v", err)
+	}
+}
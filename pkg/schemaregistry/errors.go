@@ -2,6 +2,7 @@ package schemaregistry
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/creiche/confluent-go/pkg/api"
 )
@@ -25,10 +26,12 @@ const (
 	ErrorCodeIncompatibleSchema = 409
 
 	// Compatibility errors
-	ErrorCodeInvalidCompatibility = 42203
+	ErrorCodeInvalidCompatibility              = 42203
+	ErrorCodeSubjectCompatibilityNotConfigured = 40408
 
 	// Mode errors
-	ErrorCodeInvalidMode = 42204
+	ErrorCodeInvalidMode           = 42204
+	ErrorCodeOperationNotPermitted = 42205
 )
 
 // GetSRCode extracts the Schema Registry error code from an error.
@@ -87,20 +90,62 @@ func IsIncompatibleSchema(err error) bool {
 	return ok && code == ErrorCodeIncompatibleSchema
 }
 
-// IsInvalidCompatibility returns true if the error is an invalid compatibility level error (42203)
+// IsInvalidCompatibility returns true if the error is an invalid compatibility level error (42203),
+// or a level rejected client-side by ValidateCompatibility
 func IsInvalidCompatibility(err error) bool {
+	if errors.Is(err, ErrInvalidCompatibility) {
+		return true
+	}
 	code, ok := GetSRCode(err)
 	return ok && code == ErrorCodeInvalidCompatibility
 }
 
+// IsSubjectCompatibilityNotConfigured returns true if the subject has no compatibility
+// override and inherits the global level (40408)
+func IsSubjectCompatibilityNotConfigured(err error) bool {
+	code, ok := GetSRCode(err)
+	return ok && code == ErrorCodeSubjectCompatibilityNotConfigured
+}
+
 // IsInvalidSubject returns true if the error is an invalid subject error (42202)
 func IsInvalidSubject(err error) bool {
 	code, ok := GetSRCode(err)
 	return ok && code == ErrorCodeInvalidSubject
 }
 
-// IsInvalidMode returns true if the error is an invalid mode error (42204)
+// IsInvalidMode returns true if the error is an invalid mode error (42204),
+// or a mode rejected client-side by ValidateMode
 func IsInvalidMode(err error) bool {
+	if errors.Is(err, ErrInvalidMode) {
+		return true
+	}
 	code, ok := GetSRCode(err)
 	return ok && code == ErrorCodeInvalidMode
 }
+
+// IsOperationNotPermitted returns true if the registry refused the operation in its
+// current state, e.g. entering IMPORT mode on a non-empty subject without force (42205)
+func IsOperationNotPermitted(err error) bool {
+	code, ok := GetSRCode(err)
+	return ok && code == ErrorCodeOperationNotPermitted
+}
+
+// notFoundError builds a 404 *api.Error carrying a Schema Registry error code,
+// matching what the live registry returns.
+func notFoundError(code int, message string) error {
+	return &api.Error{
+		Code:      http.StatusNotFound,
+		ErrorCode: api.ErrorCodeNotFound,
+		Message:   message,
+		Details: map[string]interface{}{
+			"error_code": float64(code),
+			"message":    message,
+		},
+	}
+}
+
+// isNotFound reports whether err is a 404 API error.
+func isNotFound(err error) bool {
+	var apiErr *api.Error
+	return errors.As(err, &apiErr) && apiErr.IsNotFound()
+}
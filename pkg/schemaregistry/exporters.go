@@ -0,0 +1,138 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// Exporter context types: how exported subjects are placed in the destination registry.
+const (
+	ExporterContextAuto   = "AUTO"   // Subjects go into a context named after the source cluster
+	ExporterContextCustom = "CUSTOM" // Subjects go into the context named by Exporter.Context
+	ExporterContextNone   = "NONE"   // Subjects keep their names in the default context
+)
+
+// Exporter states reported by GetExporterStatus.
+const (
+	ExporterStateStarting = "STARTING"
+	ExporterStateRunning  = "RUNNING"
+	ExporterStatePaused   = "PAUSED"
+	ExporterStateError    = "ERROR"
+)
+
+// Exporter describes a Schema Linking exporter that continuously copies schemas
+// from this registry to a destination registry.
+type Exporter struct {
+	Name                string   `json:"name"`
+	Subjects            []string `json:"subjects,omitempty"`
+	SubjectRenameFormat string   `json:"subjectRenameFormat,omitempty"`
+	ContextType         string   `json:"contextType,omitempty"`
+	Context             string   `json:"context,omitempty"`
+	// Config holds the destination connection settings, e.g. schema.registry.url and
+	// basic.auth.user.info.
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// ExporterStatus is the runtime state of an exporter.
+type ExporterStatus struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Offset int64  `json:"offset"`
+	// Timestamp is the time of the last state change in milliseconds since the epoch
+	Timestamp int64 `json:"ts,omitempty"`
+	// Trace holds the error details when State is ERROR
+	Trace string `json:"trace,omitempty"`
+}
+
+// ListExporters returns the names of all exporters.
+func (m *Manager) ListExporters(ctx context.Context) ([]string, error) {
+	var names []string
+	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/exporters", m.basePath)}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// CreateExporter creates an exporter. The exporter starts running immediately.
+func (m *Manager) CreateExporter(ctx context.Context, exporter Exporter) error {
+	req := client.Request{Method: "POST", Path: fmt.Sprintf("%s/exporters", m.basePath), Body: exporter}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+// GetExporter returns the definition of an exporter.
+func (m *Manager) GetExporter(ctx context.Context, name string) (*Exporter, error) {
+	var e Exporter
+	req := client.Request{Method: "GET", Path: m.exporterPath(name, "")}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// UpdateExporter updates the subjects, context, and config of an existing exporter.
+// The exporter must be paused first.
+func (m *Manager) UpdateExporter(ctx context.Context, exporter Exporter) error {
+	req := client.Request{Method: "PUT", Path: m.exporterPath(exporter.Name, ""), Body: exporter}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+// GetExporterStatus returns the runtime state of an exporter.
+func (m *Manager) GetExporterStatus(ctx context.Context, name string) (*ExporterStatus, error) {
+	var st ExporterStatus
+	req := client.Request{Method: "GET", Path: m.exporterPath(name, "/status")}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&st); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// PauseExporter pauses an exporter.
+func (m *Manager) PauseExporter(ctx context.Context, name string) error {
+	req := client.Request{Method: "PUT", Path: m.exporterPath(name, "/pause")}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+// ResumeExporter resumes a paused exporter.
+func (m *Manager) ResumeExporter(ctx context.Context, name string) error {
+	req := client.Request{Method: "PUT", Path: m.exporterPath(name, "/resume")}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+// ResetExporter resets an exporter's offset so it re-exports every schema.
+// The exporter must be paused first.
+func (m *Manager) ResetExporter(ctx context.Context, name string) error {
+	req := client.Request{Method: "PUT", Path: m.exporterPath(name, "/reset")}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+// DeleteExporter deletes an exporter. The exporter must be paused first.
+func (m *Manager) DeleteExporter(ctx context.Context, name string) error {
+	req := client.Request{Method: "DELETE", Path: m.exporterPath(name, "")}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+func (m *Manager) exporterPath(name, suffix string) string {
+	return fmt.Sprintf("%s/exporters/%s%s", m.basePath, url.PathEscape(name), suffix)
+}
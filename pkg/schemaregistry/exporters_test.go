@@ -0,0 +1,79 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestExporterLifecycle(t *testing.T) {
+	var calls []string
+	var created Exporter
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /schema-registry/v1/exporters":
+			_ = json.NewEncoder(w).Encode([]string{"dr-link"})
+		case "POST /schema-registry/v1/exporters":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_ = json.NewEncoder(w).Encode(map[string]string{"name": created.Name})
+		case "GET /schema-registry/v1/exporters/dr-link":
+			_ = json.NewEncoder(w).Encode(created)
+		case "GET /schema-registry/v1/exporters/dr-link/status":
+			_ = json.NewEncoder(w).Encode(ExporterStatus{Name: "dr-link", State: ExporterStateRunning, Offset: 42})
+		case "PUT /schema-registry/v1/exporters/dr-link",
+			"PUT /schema-registry/v1/exporters/dr-link/pause",
+			"PUT /schema-registry/v1/exporters/dr-link/resume",
+			"PUT /schema-registry/v1/exporters/dr-link/reset",
+			"DELETE /schema-registry/v1/exporters/dr-link":
+			_ = json.NewEncoder(w).Encode(map[string]string{"name": "dr-link"})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+	ctx := context.Background()
+
+	exp := Exporter{
+		Name:        "dr-link",
+		Subjects:    []string{"orders-value"},
+		ContextType: ExporterContextNone,
+		Config:      map[string]string{"schema.registry.url": "https://dest.example"},
+	}
+	if err := m.CreateExporter(ctx, exp); err != nil {
+		t.Fatalf("CreateExporter error: %v", err)
+	}
+	if created.ContextType != ExporterContextNone || created.Config["schema.registry.url"] != "https://dest.example" {
+		t.Fatalf("unexpected create body: %#v", created)
+	}
+
+	names, err := m.ListExporters(ctx)
+	if err != nil || len(names) != 1 || names[0] != "dr-link" {
+		t.Fatalf("unexpected exporters: %v err=%v", names, err)
+	}
+	got, err := m.GetExporter(ctx, "dr-link")
+	if err != nil || len(got.Subjects) != 1 {
+		t.Fatalf("unexpected exporter: %#v err=%v", got, err)
+	}
+	st, err := m.GetExporterStatus(ctx, "dr-link")
+	if err != nil || st.State != ExporterStateRunning || st.Offset != 42 {
+		t.Fatalf("unexpected status: %#v err=%v", st, err)
+	}
+
+	for name, fn := range map[string]func() error{
+		"PauseExporter":  func() error { return m.PauseExporter(ctx, "dr-link") },
+		"UpdateExporter": func() error { return m.UpdateExporter(ctx, exp) },
+		"ResetExporter":  func() error { return m.ResetExporter(ctx, "dr-link") },
+		"ResumeExporter": func() error { return m.ResumeExporter(ctx, "dr-link") },
+		"DeleteExporter": func() error { return m.DeleteExporter(ctx, "dr-link") },
+	} {
+		if err := fn(); err != nil {
+			t.Errorf("%s error: %v", name, err)
+		}
+	}
+	if len(calls) != 9 {
+		t.Errorf("expected 9 calls, got %d: %v", len(calls), calls)
+	}
+}
@@ -31,29 +31,57 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 
 	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/retry"
 )
 
 // Manager provides high-level operations against Schema Registry.
 type Manager struct {
-	c        *client.Client
+	c        client.Doer
 	basePath string
 }
 
 // NewManager creates a new Schema Registry manager using the shared REST client.
 // basePath is typically "/schema-registry/v1" for Confluent Cloud.
-func NewManager(c *client.Client, basePath string) *Manager {
+func NewManager(c client.Doer, basePath string) *Manager {
 	if basePath == "" {
 		basePath = "/schema-registry/v1"
 	}
 	return &Manager{c: c, basePath: basePath}
 }
 
+// NewManagerWithRetry is NewManager with every registry call retried by strategy,
+// e.g. retry.DefaultStrategy() to ride out 429s and 5xx during deploys. A nil strategy
+// uses retry.DefaultStrategy(). Each call is retried independently; helpers that make
+// several calls, such as ImportSchemas, do not restart from the beginning.
+func NewManagerWithRetry(c client.Doer, basePath string, strategy client.Retrier) *Manager {
+	if strategy == nil {
+		strategy = retry.DefaultStrategy()
+	}
+	retrying := client.DoerFunc(func(ctx context.Context, req client.Request) (*client.Response, error) {
+		var resp *client.Response
+		err := strategy.Do(ctx, func() error {
+			var err error
+			resp, err = c.Do(ctx, req)
+			return err
+		})
+		return resp, err
+	})
+	return NewManager(retrying, basePath)
+}
+
 // ListSubjects returns all subjects registered.
 func (m *Manager) ListSubjects(ctx context.Context) ([]string, error) {
+	return m.ListSubjectsWithOptions(ctx, ListOptions{})
+}
+
+// ListSubjectsWithOptions returns subjects filtered by opts. Use Deleted or DeletedOnly
+// to find soft-deleted subjects that can be purged with DeleteSubject(..., true).
+func (m *Manager) ListSubjectsWithOptions(ctx context.Context, opts ListOptions) ([]string, error) {
 	var subjects []string
-	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/subjects", m.basePath)}
+	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/subjects%s", m.basePath, opts.query(true))}
 	resp, err := m.c.Do(ctx, req)
 	if err != nil {
 		return nil, err
@@ -64,6 +92,21 @@ func (m *Manager) ListSubjects(ctx context.Context) ([]string, error) {
 	return subjects, nil
 }
 
+// ListSchemas returns schemas across all subjects in a single call, filtered and paged
+// by opts. Each Schema has its subject, version, ID, and type populated.
+func (m *Manager) ListSchemas(ctx context.Context, opts ListSchemasOptions) ([]Schema, error) {
+	var schemas []Schema
+	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/schemas%s", m.basePath, opts.query())}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&schemas); err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
+
 // GetLatestSchema returns the latest schema for a subject.
 func (m *Manager) GetLatestSchema(ctx context.Context, subject string) (*Schema, error) {
 	var s Schema
@@ -78,20 +121,70 @@ func (m *Manager) GetLatestSchema(ctx context.Context, subject string) (*Schema,
 	return &s, nil
 }
 
-// GetSchemaByID fetches a schema by its global ID.
+// GetSchemaByID fetches a schema by its global ID, including its type, references,
+// and data contract. Subject and Version are not set because an ID can be shared by
+// several subject versions; use GetSchemaByIDInSubject to resolve them.
 func (m *Manager) GetSchemaByID(ctx context.Context, id int) (*Schema, error) {
-	var body struct {
-		Schema string `json:"schema"`
+	return m.getSchemaByID(ctx, fmt.Sprintf("%s/schemas/ids/%d", m.basePath, id), id)
+}
+
+// GetSchemaByIDInSubject fetches a schema by its global ID, scoped to subject (?subject=),
+// which selects the right schema context when the registry uses contexts. The returned
+// Schema also lists every subject version that uses the ID in SubjectVersions, and has
+// Subject and Version set to the matching pair for subject when there is one.
+func (m *Manager) GetSchemaByIDInSubject(ctx context.Context, id int, subject string) (*Schema, error) {
+	path := fmt.Sprintf("%s/schemas/ids/%d", m.basePath, id)
+	if subject != "" {
+		path += "?subject=" + url.QueryEscape(subject)
+	}
+	s, err := m.getSchemaByID(ctx, path, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.SubjectVersions, err = m.GetSubjectVersionsByID(ctx, id); err != nil {
+		return nil, err
+	}
+	for _, sv := range s.SubjectVersions {
+		if sv.Subject == subject || (subject == "" && len(s.SubjectVersions) == 1) {
+			s.Subject, s.Version = sv.Subject, sv.Version
+			break
+		}
 	}
-	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/schemas/ids/%d", m.basePath, id)}
+	return s, nil
+}
+
+// GetSubjectVersionsByID returns every subject version that uses the schema ID.
+func (m *Manager) GetSubjectVersionsByID(ctx context.Context, id int) ([]SubjectVersion, error) {
+	var out []SubjectVersion
+	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/schemas/ids/%d/versions", m.basePath, id)}
 	resp, err := m.c.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	if err := resp.DecodeJSON(&body); err != nil {
+	if err := resp.DecodeJSON(&out); err != nil {
 		return nil, err
 	}
-	return &Schema{ID: id, Schema: body.Schema}, nil
+	return out, nil
+}
+
+func (m *Manager) getSchemaByID(ctx context.Context, path string, id int) (*Schema, error) {
+	var s Schema
+	req := client.Request{Method: "GET", Path: path}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&s); err != nil {
+		return nil, err
+	}
+	// The endpoint omits the ID it was queried by, and the subject/version fields are
+	// not meaningful for a global ID lookup.
+	s.ID, s.Subject, s.Version = id, "", 0
+	// The registry omits schemaType for Avro schemas
+	if s.Type == "" {
+		s.Type = SchemaTypeAvro
+	}
+	return &s, nil
 }
 
 // RegisterSchema registers a new schema under a subject and returns the assigned ID.
@@ -120,10 +213,63 @@ func (m *Manager) RegisterSchema(ctx context.Context, subject string, payload Re
 	return out.ID, nil
 }
 
+// LookupSchema checks whether the exact schema is already registered under subject
+// and returns the existing registration (ID, version, subject, schema).
+// This allows reconcilers to skip RegisterSchema when nothing has changed.
+// If SchemaType is empty, it defaults to AVRO (matching Schema Registry API behavior).
+// Returns errors:
+//   - IsSchemaNotFound(err) if the subject exists but the schema is not registered under it
+//   - IsSubjectNotFound(err) if the subject does not exist
+func (m *Manager) LookupSchema(ctx context.Context, subject string, payload RegisterRequest) (*Schema, error) {
+	schemaType := payload.SchemaType
+	if schemaType == "" {
+		schemaType = SchemaTypeAvro
+	}
+	if err := ValidateSchema(payload.Schema, schemaType); err != nil {
+		return nil, fmt.Errorf("schema validation failed: %w", err)
+	}
+
+	var s Schema
+	req := client.Request{Method: "POST", Path: fmt.Sprintf("%s/subjects/%s", m.basePath, url.PathEscape(subject)), Body: payload}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&s); err != nil {
+		return nil, err
+	}
+	if s.Subject == "" {
+		s.Subject = subject
+	}
+	return &s, nil
+}
+
 // TestCompatibility checks compatibility of the provided schema against the latest.
 // The schema is validated before the compatibility check.
 // If SchemaType is empty, it defaults to AVRO (matching Schema Registry API behavior).
 func (m *Manager) TestCompatibility(ctx context.Context, subject string, payload RegisterRequest) (bool, error) {
+	out, err := m.testCompatibility(ctx, fmt.Sprintf("%s/compatibility/subjects/%s/versions/latest", m.basePath, url.PathEscape(subject)), payload)
+	if err != nil {
+		return false, err
+	}
+	return out.IsCompatible, nil
+}
+
+// TestCompatibilityVerbose is TestCompatibility with ?verbose=true: when the schema is
+// incompatible, the response Messages explain which rules it breaks.
+func (m *Manager) TestCompatibilityVerbose(ctx context.Context, subject string, payload RegisterRequest) (*CompatibilityResponse, error) {
+	return m.testCompatibility(ctx, fmt.Sprintf("%s/compatibility/subjects/%s/versions/latest?verbose=true", m.basePath, url.PathEscape(subject)), payload)
+}
+
+// TestCompatibilityAllVersions checks the schema against every registered version of the
+// subject rather than only the latest, matching what the *_TRANSITIVE compatibility
+// levels enforce at registration time. The check is verbose.
+func (m *Manager) TestCompatibilityAllVersions(ctx context.Context, subject string, payload RegisterRequest) (*CompatibilityResponse, error) {
+	return m.testCompatibility(ctx, fmt.Sprintf("%s/compatibility/subjects/%s/versions?verbose=true", m.basePath, url.PathEscape(subject)), payload)
+}
+
+// testCompatibility validates payload and posts it to a compatibility endpoint.
+func (m *Manager) testCompatibility(ctx context.Context, path string, payload RegisterRequest) (*CompatibilityResponse, error) {
 	// Default schema type to AVRO if omitted, matching Schema Registry API
 	schemaType := payload.SchemaType
 	if schemaType == "" {
@@ -131,25 +277,31 @@ func (m *Manager) TestCompatibility(ctx context.Context, subject string, payload
 	}
 	// Validate schema syntax before testing compatibility
 	if err := ValidateSchema(payload.Schema, schemaType); err != nil {
-		return false, fmt.Errorf("schema validation failed: %w", err)
+		return nil, fmt.Errorf("schema validation failed: %w", err)
 	}
 
 	var out CompatibilityResponse
-	req := client.Request{Method: "POST", Path: fmt.Sprintf("%s/compatibility/subjects/%s/versions/latest", m.basePath, url.PathEscape(subject)), Body: payload}
+	req := client.Request{Method: "POST", Path: path, Body: payload}
 	resp, err := m.c.Do(ctx, req)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	if err := resp.DecodeJSON(&out); err != nil {
-		return false, err
+		return nil, err
 	}
-	return out.IsCompatible, nil
+	return &out, nil
 }
 
 // ListVersions lists all versions for a subject.
 func (m *Manager) ListVersions(ctx context.Context, subject string) ([]int, error) {
+	return m.ListVersionsWithOptions(ctx, subject, ListOptions{})
+}
+
+// ListVersionsWithOptions lists versions for a subject filtered by opts. Use Deleted or
+// DeletedOnly to find soft-deleted versions that can be purged with DeleteSchemaVersion(..., true).
+func (m *Manager) ListVersionsWithOptions(ctx context.Context, subject string, opts ListOptions) ([]int, error) {
 	var versions []int
-	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/subjects/%s/versions", m.basePath, url.PathEscape(subject))}
+	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/subjects/%s/versions%s", m.basePath, url.PathEscape(subject), opts.query(false))}
 	resp, err := m.c.Do(ctx, req)
 	if err != nil {
 		return nil, err
@@ -185,52 +337,113 @@ func (m *Manager) DeleteSubject(ctx context.Context, subject string, permanent b
 	return err
 }
 
-// Compatibility levels commonly used by SR: NONE, BACKWARD, BACKWARD_TRANSITIVE, FORWARD, FORWARD_TRANSITIVE, FULL, FULL_TRANSITIVE.
-
-// GetGlobalCompatibility returns the global compatibility level.
-func (m *Manager) GetGlobalCompatibility(ctx context.Context) (string, error) {
-	var out struct {
-		Compatibility string `json:"compatibility"`
+// DeleteSchemaVersion deletes a single version of a subject. When permanent=true a hard
+// delete is performed; Schema Registry requires the version to be soft-deleted first.
+// Returns errors:
+//   - IsSubjectNotFound(err) if the subject does not exist
+//   - IsVersionNotFound(err) if the version does not exist
+func (m *Manager) DeleteSchemaVersion(ctx context.Context, subject string, version int, permanent bool) error {
+	path := fmt.Sprintf("%s/subjects/%s/versions/%d", m.basePath, url.PathEscape(subject), version)
+	if permanent {
+		path += "?permanent=true"
 	}
-	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/config", m.basePath)}
-	resp, err := m.c.Do(ctx, req)
+	req := client.Request{Method: "DELETE", Path: path}
+	if _, err := m.c.Do(ctx, req); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UndeleteSubject restores the soft-deleted versions of a subject. Schema Registry has no
+// undelete endpoint, so each soft-deleted version is read back with ?deleted=true and
+// re-registered in version order. Restored schemas keep their global IDs but are assigned
+// new version numbers. It returns the IDs of the re-registered schemas.
+func (m *Manager) UndeleteSubject(ctx context.Context, subject string) ([]int, error) {
+	versions, err := m.ListVersionsWithOptions(ctx, subject, ListOptions{DeletedOnly: true})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	if err := resp.DecodeJSON(&out); err != nil {
+	sort.Ints(versions)
+
+	ids := make([]int, 0, len(versions))
+	for _, v := range versions {
+		var s Schema
+		req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/subjects/%s/versions/%d?deleted=true", m.basePath, url.PathEscape(subject), v)}
+		resp, err := m.c.Do(ctx, req)
+		if err != nil {
+			return ids, fmt.Errorf("failed to read deleted version %d of %s: %w", v, subject, err)
+		}
+		if err := resp.DecodeJSON(&s); err != nil {
+			return ids, err
+		}
+		id, err := m.RegisterSchema(ctx, subject, RegisterRequest{Schema: s.Schema, SchemaType: s.Type, References: s.References, Metadata: s.Metadata, RuleSet: s.RuleSet})
+		if err != nil {
+			return ids, fmt.Errorf("failed to restore version %d of %s: %w", v, subject, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// GetGlobalCompatibility returns the global compatibility level.
+func (m *Manager) GetGlobalCompatibility(ctx context.Context) (CompatibilityLevel, error) {
+	cfg, err := m.getConfig(ctx, fmt.Sprintf("%s/config", m.basePath))
+	if err != nil {
 		return "", err
 	}
-	return out.Compatibility, nil
+	return cfg.Compatibility, nil
 }
 
-// SetGlobalCompatibility sets the global compatibility level.
-func (m *Manager) SetGlobalCompatibility(ctx context.Context, level string) error {
-	body := map[string]string{"compatibility": level}
+// SetGlobalCompatibility sets the global compatibility level. Unknown levels are rejected
+// before reaching the registry with an error matching IsInvalidCompatibility.
+func (m *Manager) SetGlobalCompatibility(ctx context.Context, level CompatibilityLevel) error {
+	if err := ValidateCompatibility(level); err != nil {
+		return err
+	}
+	body := map[string]CompatibilityLevel{"compatibility": level}
 	req := client.Request{Method: "PUT", Path: fmt.Sprintf("%s/config", m.basePath), Body: body}
 	_, err := m.c.Do(ctx, req)
 	return err
 }
 
-// GetSubjectCompatibility returns compatibility level for a subject.
-func (m *Manager) GetSubjectCompatibility(ctx context.Context, subject string) (string, error) {
-	var out struct {
-		Compatibility string `json:"compatibility"`
-	}
-	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/config/%s", m.basePath, url.PathEscape(subject))}
-	resp, err := m.c.Do(ctx, req)
+// GetSubjectCompatibility returns the compatibility override for a subject.
+// When the subject inherits the global level, the registry returns an error matching
+// IsSubjectCompatibilityNotConfigured; use GetEffectiveSubjectCompatibility to resolve
+// the level actually enforced.
+func (m *Manager) GetSubjectCompatibility(ctx context.Context, subject string) (CompatibilityLevel, error) {
+	cfg, err := m.getConfig(ctx, fmt.Sprintf("%s/config/%s", m.basePath, url.PathEscape(subject)))
 	if err != nil {
 		return "", err
 	}
-	if err := resp.DecodeJSON(&out); err != nil {
+	return cfg.Compatibility, nil
+}
+
+// SetSubjectCompatibility sets compatibility level for a subject. Unknown levels are
+// rejected before reaching the registry with an error matching IsInvalidCompatibility.
+func (m *Manager) SetSubjectCompatibility(ctx context.Context, subject string, level CompatibilityLevel) error {
+	if err := ValidateCompatibility(level); err != nil {
+		return err
+	}
+	body := map[string]CompatibilityLevel{"compatibility": level}
+	req := client.Request{Method: "PUT", Path: fmt.Sprintf("%s/config/%s", m.basePath, url.PathEscape(subject)), Body: body}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+// GetEffectiveSubjectCompatibility returns the compatibility level enforced for a subject,
+// falling back to the global level when the subject has no override (?defaultToGlobal=true).
+func (m *Manager) GetEffectiveSubjectCompatibility(ctx context.Context, subject string) (CompatibilityLevel, error) {
+	cfg, err := m.getConfig(ctx, fmt.Sprintf("%s/config/%s?defaultToGlobal=true", m.basePath, url.PathEscape(subject)))
+	if err != nil {
 		return "", err
 	}
-	return out.Compatibility, nil
+	return cfg.Compatibility, nil
 }
 
-// SetSubjectCompatibility sets compatibility level for a subject.
-func (m *Manager) SetSubjectCompatibility(ctx context.Context, subject string, level string) error {
-	body := map[string]string{"compatibility": level}
-	req := client.Request{Method: "PUT", Path: fmt.Sprintf("%s/config/%s", m.basePath, url.PathEscape(subject)), Body: body}
+// DeleteSubjectCompatibility removes the compatibility override for a subject so it
+// inherits the global level again.
+func (m *Manager) DeleteSubjectCompatibility(ctx context.Context, subject string) error {
+	req := client.Request{Method: "DELETE", Path: fmt.Sprintf("%s/config/%s", m.basePath, url.PathEscape(subject))}
 	_, err := m.c.Do(ctx, req)
 	return err
 }
@@ -238,9 +451,9 @@ func (m *Manager) SetSubjectCompatibility(ctx context.Context, subject string, l
 // Mode operations: READWRITE (default), READONLY (prevents registration), IMPORT (for replication)
 
 // GetGlobalMode returns the global mode.
-func (m *Manager) GetGlobalMode(ctx context.Context) (string, error) {
+func (m *Manager) GetGlobalMode(ctx context.Context) (Mode, error) {
 	var out struct {
-		Mode string `json:"mode"`
+		Mode Mode `json:"mode"`
 	}
 	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/mode", m.basePath)}
 	resp, err := m.c.Do(ctx, req)
@@ -254,18 +467,22 @@ func (m *Manager) GetGlobalMode(ctx context.Context) (string, error) {
 }
 
 // SetGlobalMode sets the global mode.
-// Valid modes: ModeReadWrite, ModeReadOnly, ModeImport.
-func (m *Manager) SetGlobalMode(ctx context.Context, mode string) error {
-	body := map[string]string{"mode": mode}
-	req := client.Request{Method: "PUT", Path: fmt.Sprintf("%s/mode", m.basePath), Body: body}
-	_, err := m.c.Do(ctx, req)
-	return err
+// Valid modes: ModeReadWrite, ModeReadOnly, ModeReadOnlyOverride, ModeImport.
+// Unknown modes are rejected client-side with an error matching IsInvalidMode.
+func (m *Manager) SetGlobalMode(ctx context.Context, mode Mode) error {
+	return m.SetGlobalModeWithOptions(ctx, mode, ModeOptions{})
+}
+
+// SetGlobalModeWithOptions sets the global mode. With Force, IMPORT mode can be
+// entered even though the registry already contains schemas.
+func (m *Manager) SetGlobalModeWithOptions(ctx context.Context, mode Mode, opts ModeOptions) error {
+	return m.setMode(ctx, fmt.Sprintf("%s/mode", m.basePath), mode, opts)
 }
 
 // GetSubjectMode returns mode for a subject.
-func (m *Manager) GetSubjectMode(ctx context.Context, subject string) (string, error) {
+func (m *Manager) GetSubjectMode(ctx context.Context, subject string) (Mode, error) {
 	var out struct {
-		Mode string `json:"mode"`
+		Mode Mode `json:"mode"`
 	}
 	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/mode/%s", m.basePath, url.PathEscape(subject))}
 	resp, err := m.c.Do(ctx, req)
@@ -279,10 +496,35 @@ func (m *Manager) GetSubjectMode(ctx context.Context, subject string) (string, e
 }
 
 // SetSubjectMode sets mode for a subject.
-// Valid modes: ModeReadWrite, ModeReadOnly, ModeImport.
-func (m *Manager) SetSubjectMode(ctx context.Context, subject string, mode string) error {
-	body := map[string]string{"mode": mode}
-	req := client.Request{Method: "PUT", Path: fmt.Sprintf("%s/mode/%s", m.basePath, url.PathEscape(subject)), Body: body}
+// Valid modes: ModeReadWrite, ModeReadOnly, ModeReadOnlyOverride, ModeImport.
+// Unknown modes are rejected client-side with an error matching IsInvalidMode.
+func (m *Manager) SetSubjectMode(ctx context.Context, subject string, mode Mode) error {
+	return m.SetSubjectModeWithOptions(ctx, subject, mode, ModeOptions{})
+}
+
+// SetSubjectModeWithOptions sets mode for a subject. With Force, IMPORT mode can be
+// entered even though the subject already has versions; without it the registry
+// rejects the change with an error matching IsOperationNotPermitted.
+func (m *Manager) SetSubjectModeWithOptions(ctx context.Context, subject string, mode Mode, opts ModeOptions) error {
+	return m.setMode(ctx, fmt.Sprintf("%s/mode/%s", m.basePath, url.PathEscape(subject)), mode, opts)
+}
+
+// DeleteSubjectMode removes the mode override for a subject so it inherits the global mode again.
+func (m *Manager) DeleteSubjectMode(ctx context.Context, subject string) error {
+	req := client.Request{Method: "DELETE", Path: fmt.Sprintf("%s/mode/%s", m.basePath, url.PathEscape(subject))}
+	_, err := m.c.Do(ctx, req)
+	return err
+}
+
+func (m *Manager) setMode(ctx context.Context, path string, mode Mode, opts ModeOptions) error {
+	if err := ValidateMode(mode); err != nil {
+		return err
+	}
+	if opts.Force {
+		path += "?force=true"
+	}
+	body := map[string]Mode{"mode": mode}
+	req := client.Request{Method: "PUT", Path: path, Body: body}
 	_, err := m.c.Do(ctx, req)
 	return err
 }
@@ -3,12 +3,15 @@ package schemaregistry
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/creiche/confluent-go/pkg/client"
+	"github.com/creiche/confluent-go/pkg/retry"
 )
 
 func newTestClient(t *testing.T, handler http.HandlerFunc) *client.Client {
@@ -87,7 +90,7 @@ func TestGetSchemaByID(t *testing.T) {
 	if err != nil {
 		t.Fatalf("GetSchemaByID error: %v", err)
 	}
-	if s.ID != 42 || s.Schema == "" {
+	if s.ID != 42 || s.Schema == "" || s.Type != SchemaTypeAvro {
 		t.Fatalf("unexpected schema by id: %#v", s)
 	}
 }
@@ -113,6 +116,30 @@ func TestRegisterSchema(t *testing.T) {
 	}
 }
 
+func TestNewManagerWithRetry(t *testing.T) {
+	var attempts int
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error_code":50301,"message":"leader not known"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(RegisterResponse{ID: 99})
+	}
+	strategy := retry.DefaultStrategy().WithInitialBackoff(time.Millisecond).WithJitter(false)
+	m := NewManagerWithRetry(newTestClient(t, handler), "/schema-registry/v1", strategy)
+
+	id, err := m.RegisterSchema(context.Background(), "my-subject", RegisterRequest{Schema: "{\"type\":\"string\"}", SchemaType: SchemaTypeAvro})
+	if err != nil {
+		t.Fatalf("RegisterSchema error: %v", err)
+	}
+	if id != 99 || attempts != 3 {
+		t.Fatalf("unexpected id %d after %d attempts", id, attempts)
+	}
+}
+
 func TestTestCompatibility(t *testing.T) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/schema-registry/v1/compatibility/subjects/my-subject/versions/latest") {
@@ -134,6 +161,39 @@ func TestTestCompatibility(t *testing.T) {
 	}
 }
 
+func TestLookupSchema(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/schema-registry/v1/subjects/my-subject") {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		var req RegisterRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		if req.Schema != "{\"type\":\"string\"}" {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 40403, "message": "Schema not found"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(Schema{ID: 7, Subject: "my-subject", Version: 2, Schema: req.Schema})
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+
+	s, err := m.LookupSchema(context.Background(), "my-subject", RegisterRequest{Schema: "{\"type\":\"string\"}", SchemaType: SchemaTypeAvro})
+	if err != nil {
+		t.Fatalf("LookupSchema error: %v", err)
+	}
+	if s.ID != 7 || s.Version != 2 || s.Subject != "my-subject" {
+		t.Fatalf("unexpected schema: %#v", s)
+	}
+
+	_, err = m.LookupSchema(context.Background(), "my-subject", RegisterRequest{Schema: "{\"type\":\"int\"}"})
+	if !IsSchemaNotFound(err) {
+		t.Fatalf("expected schema not found, got %v", err)
+	}
+}
+
 func TestErrorsArePropagated(t *testing.T) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		// Return a 404 for any request to assert api.Error propagation
@@ -204,6 +264,35 @@ func TestDeleteSubject(t *testing.T) {
 	}
 }
 
+func TestDeleteSchemaVersion(t *testing.T) {
+	var got []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		got = append(got, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("3"))
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+
+	if err := m.DeleteSchemaVersion(context.Background(), "my-subject", 3, false); err != nil {
+		t.Fatalf("DeleteSchemaVersion error: %v", err)
+	}
+	if err := m.DeleteSchemaVersion(context.Background(), "my-subject", 3, true); err != nil {
+		t.Fatalf("DeleteSchemaVersion permanent error: %v", err)
+	}
+	want := []string{
+		"/schema-registry/v1/subjects/my-subject/versions/3",
+		"/schema-registry/v1/subjects/my-subject/versions/3?permanent=true",
+	}
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("unexpected requests: %#v", got)
+	}
+}
+
 func TestCompatibilityGetSet(t *testing.T) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -213,7 +302,8 @@ func TestCompatibilityGetSet(t *testing.T) {
 		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/schema-registry/v1/config"):
 			w.WriteHeader(http.StatusOK)
 		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/schema-registry/v1/config/my-subject"):
-			_ = json.NewEncoder(w).Encode(map[string]string{"compatibility": "BACKWARD"})
+			// Real registries report compatibilityLevel on reads
+			_ = json.NewEncoder(w).Encode(map[string]string{"compatibilityLevel": "BACKWARD"})
 		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/schema-registry/v1/config/my-subject"):
 			w.WriteHeader(http.StatusOK)
 		default:
@@ -414,22 +504,41 @@ func TestSRError_SubjectSoftDeleted(t *testing.T) {
 }
 
 func TestSRError_InvalidMode(t *testing.T) {
+	called := false
 	handler := func(w http.ResponseWriter, r *http.Request) {
+		called = true
 		w.WriteHeader(http.StatusUnprocessableEntity)
 		_, _ = w.Write([]byte(`{"error_code": 42204, "message": "Invalid mode"}`))
 	}
 	c := newTestClient(t, handler)
 	m := NewManager(c, "/schema-registry/v1")
 
-	err := m.SetGlobalCompatibility(context.Background(), "INVALID_MODE")
+	err := m.SetGlobalMode(context.Background(), ModeReadOnly)
 	if err == nil {
 		t.Fatal("expected error for invalid mode")
 	}
+	if !called {
+		t.Error("expected the request to reach the server")
+	}
 	if !IsInvalidMode(err) {
 		t.Errorf("IsInvalidMode should return true, got error: %v", err)
 	}
 }
 
+func TestInvalidMode_RejectedClientSide(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	if err := m.SetGlobalMode(context.Background(), "INVALID_MODE"); !errors.Is(err, ErrInvalidMode) {
+		t.Errorf("SetGlobalMode: expected ErrInvalidMode, got %v", err)
+	}
+	if err := m.SetGlobalCompatibility(context.Background(), "INVALID_MODE"); !errors.Is(err, ErrInvalidCompatibility) {
+		t.Errorf("SetGlobalCompatibility: expected ErrInvalidCompatibility, got %v", err)
+	}
+}
+
 // Client-side validation tests
 
 func TestRegisterSchema_ClientSideValidation(t *testing.T) {
@@ -559,3 +668,309 @@ func TestSetSubjectMode(t *testing.T) {
 		t.Fatalf("SetSubjectMode failed: %v", err)
 	}
 }
+
+func TestListWithDeletedOptions(t *testing.T) {
+	var got []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/versions") {
+			_ = json.NewEncoder(w).Encode([]int{1, 2})
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]string{"gone"})
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+	ctx := context.Background()
+
+	if _, err := m.ListSubjectsWithOptions(ctx, ListOptions{Deleted: true}); err != nil {
+		t.Fatalf("ListSubjectsWithOptions error: %v", err)
+	}
+	if _, err := m.ListSubjectsWithOptions(ctx, ListOptions{DeletedOnly: true, SubjectPrefix: "orders"}); err != nil {
+		t.Fatalf("ListSubjectsWithOptions error: %v", err)
+	}
+	if _, err := m.ListVersionsWithOptions(ctx, "gone", ListOptions{Deleted: true, SubjectPrefix: "ignored"}); err != nil {
+		t.Fatalf("ListVersionsWithOptions error: %v", err)
+	}
+	if _, err := m.ListSubjects(ctx); err != nil {
+		t.Fatalf("ListSubjects error: %v", err)
+	}
+
+	want := []string{
+		"/schema-registry/v1/subjects?deleted=true",
+		"/schema-registry/v1/subjects?deletedOnly=true&subjectPrefix=orders",
+		"/schema-registry/v1/subjects/gone/versions?deleted=true",
+		"/schema-registry/v1/subjects",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected requests: %#v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUndeleteSubject(t *testing.T) {
+	var registered []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/schema-registry/v1/subjects/orders/versions":
+			if r.URL.Query().Get("deletedOnly") != "true" {
+				http.Error(w, "expected deletedOnly", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode([]int{2, 1})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/schema-registry/v1/subjects/orders/versions/"):
+			if r.URL.Query().Get("deleted") != "true" {
+				http.Error(w, "expected deleted", http.StatusBadRequest)
+				return
+			}
+			v := strings.TrimPrefix(r.URL.Path, "/schema-registry/v1/subjects/orders/versions/")
+			_ = json.NewEncoder(w).Encode(Schema{ID: 10, Subject: "orders", Schema: "{\"type\":\"string\",\"doc\":\"v" + v + "\"}", Type: SchemaTypeAvro})
+		case r.Method == http.MethodPost && r.URL.Path == "/schema-registry/v1/subjects/orders/versions":
+			var req RegisterRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			registered = append(registered, req.Schema)
+			_ = json.NewEncoder(w).Encode(RegisterResponse{ID: 10 + len(registered)})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+
+	ids, err := m.UndeleteSubject(context.Background(), "orders")
+	if err != nil {
+		t.Fatalf("UndeleteSubject error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != 11 || ids[1] != 12 {
+		t.Fatalf("unexpected ids: %#v", ids)
+	}
+	if len(registered) != 2 || !strings.Contains(registered[0], "v1") || !strings.Contains(registered[1], "v2") {
+		t.Fatalf("versions not restored in order: %#v", registered)
+	}
+}
+
+func TestTestCompatibilityVerbose(t *testing.T) {
+	var got []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(CompatibilityResponse{
+			IsCompatible: false,
+			Messages:     []string{"READER_FIELD_MISSING_DEFAULT_VALUE: id"},
+		})
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+	payload := RegisterRequest{Schema: "{\"type\":\"string\"}"}
+
+	res, err := m.TestCompatibilityVerbose(context.Background(), "my-subject", payload)
+	if err != nil {
+		t.Fatalf("TestCompatibilityVerbose error: %v", err)
+	}
+	if res.IsCompatible || len(res.Messages) != 1 {
+		t.Fatalf("unexpected result: %#v", res)
+	}
+	if _, err := m.TestCompatibilityAllVersions(context.Background(), "my-subject", payload); err != nil {
+		t.Fatalf("TestCompatibilityAllVersions error: %v", err)
+	}
+
+	want := []string{
+		"/schema-registry/v1/compatibility/subjects/my-subject/versions/latest?verbose=true",
+		"/schema-registry/v1/compatibility/subjects/my-subject/versions?verbose=true",
+	}
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("unexpected requests: %#v", got)
+	}
+}
+
+func TestSubjectCompatibilityOverride(t *testing.T) {
+	override := "FULL"
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/schema-registry/v1/config/my-subject" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodDelete:
+			override = ""
+			_ = json.NewEncoder(w).Encode(map[string]string{"compatibilityLevel": "FULL"})
+		case http.MethodGet:
+			switch {
+			case override != "":
+				_ = json.NewEncoder(w).Encode(map[string]string{"compatibilityLevel": override})
+			case r.URL.Query().Get("defaultToGlobal") == "true":
+				_ = json.NewEncoder(w).Encode(map[string]string{"compatibilityLevel": "BACKWARD"})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error_code":40408,"message":"Subject 'my-subject' does not have subject-level compatibility configured"}`))
+			}
+		}
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+	ctx := context.Background()
+
+	level, err := m.GetEffectiveSubjectCompatibility(ctx, "my-subject")
+	if err != nil || level != "FULL" {
+		t.Fatalf("unexpected effective compat: %s err=%v", level, err)
+	}
+	if err := m.DeleteSubjectCompatibility(ctx, "my-subject"); err != nil {
+		t.Fatalf("DeleteSubjectCompatibility error: %v", err)
+	}
+	if _, err := m.GetSubjectCompatibility(ctx, "my-subject"); !IsSubjectCompatibilityNotConfigured(err) {
+		t.Fatalf("expected not configured error, got %v", err)
+	}
+	level, err = m.GetEffectiveSubjectCompatibility(ctx, "my-subject")
+	if err != nil || level != "BACKWARD" {
+		t.Fatalf("unexpected inherited compat: %s err=%v", level, err)
+	}
+}
+
+func TestGetSchemaByIDInSubject(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/schema-registry/v1/schemas/ids/42":
+			if r.URL.Query().Get("subject") != "orders-value" {
+				http.Error(w, "missing subject", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"schema":     "syntax = \"proto3\";",
+				"schemaType": SchemaTypeProtobuf,
+				"references": []SchemaReference{{Name: "common.proto", Subject: "common", Version: 1}},
+			})
+		case "/schema-registry/v1/schemas/ids/42/versions":
+			_ = json.NewEncoder(w).Encode([]SubjectVersion{{Subject: "orders-key", Version: 1}, {Subject: "orders-value", Version: 3}})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	s, err := m.GetSchemaByIDInSubject(context.Background(), 42, "orders-value")
+	if err != nil {
+		t.Fatalf("GetSchemaByIDInSubject error: %v", err)
+	}
+	if s.ID != 42 || s.Type != SchemaTypeProtobuf || len(s.References) != 1 {
+		t.Fatalf("unexpected schema: %#v", s)
+	}
+	if s.Subject != "orders-value" || s.Version != 3 || len(s.SubjectVersions) != 2 {
+		t.Fatalf("unexpected subject context: %#v", s)
+	}
+}
+
+func TestListSchemas(t *testing.T) {
+	var got []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]Schema{
+			{ID: 1, Subject: "orders-key", Version: 1, Schema: `"string"`},
+			{ID: 2, Subject: "orders-value", Version: 4, Schema: `"int"`, Type: SchemaTypeAvro},
+		})
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	schemas, err := m.ListSchemas(context.Background(), ListSchemasOptions{SubjectPrefix: "orders", LatestOnly: true, Offset: 10, Limit: 2})
+	if err != nil {
+		t.Fatalf("ListSchemas error: %v", err)
+	}
+	if len(schemas) != 2 || schemas[1].Subject != "orders-value" || schemas[1].Version != 4 {
+		t.Fatalf("unexpected schemas: %#v", schemas)
+	}
+	if _, err := m.ListSchemas(context.Background(), ListSchemasOptions{}); err != nil {
+		t.Fatalf("ListSchemas error: %v", err)
+	}
+
+	want := []string{
+		"/schema-registry/v1/schemas?latestOnly=true&limit=2&offset=10&subjectPrefix=orders",
+		"/schema-registry/v1/schemas",
+	}
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("unexpected requests: %#v", got)
+	}
+}
+
+func TestSubjectModeOverrides(t *testing.T) {
+	var got []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut && r.URL.Query().Get("force") != "true" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error_code":42205,"message":"Cannot import since found existing subjects"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]Mode{"mode": ModeImport})
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+	ctx := context.Background()
+
+	if err := m.SetSubjectMode(ctx, "orders", ModeImport); !IsOperationNotPermitted(err) {
+		t.Fatalf("expected operation not permitted, got %v", err)
+	}
+	if err := m.SetSubjectModeWithOptions(ctx, "orders", ModeImport, ModeOptions{Force: true}); err != nil {
+		t.Fatalf("SetSubjectModeWithOptions error: %v", err)
+	}
+	if err := m.SetGlobalModeWithOptions(ctx, ModeImport, ModeOptions{Force: true}); err != nil {
+		t.Fatalf("SetGlobalModeWithOptions error: %v", err)
+	}
+	if err := m.DeleteSubjectMode(ctx, "orders"); err != nil {
+		t.Fatalf("DeleteSubjectMode error: %v", err)
+	}
+
+	want := []string{
+		"PUT /schema-registry/v1/mode/orders",
+		"PUT /schema-registry/v1/mode/orders?force=true",
+		"PUT /schema-registry/v1/mode?force=true",
+		"DELETE /schema-registry/v1/mode/orders",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected requests: %#v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSetMode_ClientSideValidation(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for invalid mode: %s %s", r.Method, r.URL.Path)
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	for _, mode := range []Mode{"", "read-only", "WRITEONLY"} {
+		if err := m.SetSubjectMode(context.Background(), "orders", mode); !IsInvalidMode(err) {
+			t.Errorf("SetSubjectMode(%q): expected invalid mode error, got %v", mode, err)
+		}
+		if err := m.SetGlobalMode(context.Background(), mode); !errors.Is(err, ErrInvalidMode) {
+			t.Errorf("SetGlobalMode(%q): expected ErrInvalidMode, got %v", mode, err)
+		}
+	}
+}
+
+func TestSetCompatibility_ClientSideValidation(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for invalid level: %s %s", r.Method, r.URL.Path)
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	for _, level := range []CompatibilityLevel{"", "backward", "FULL_TRANSITIVE_ALL"} {
+		if err := m.SetSubjectCompatibility(context.Background(), "orders", level); !IsInvalidCompatibility(err) {
+			t.Errorf("SetSubjectCompatibility(%q): expected invalid compatibility error, got %v", level, err)
+		}
+		if err := m.SetGlobalCompatibility(context.Background(), level); !errors.Is(err, ErrInvalidCompatibility) {
+			t.Errorf("SetGlobalCompatibility(%q): expected ErrInvalidCompatibility, got %v", level, err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package schemaregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// modeRestoreTimeout bounds restoring the global mode after ImportSchemas.
+const modeRestoreTimeout = 30 * time.Second
+
+// ExportAllSubjects reads every subject with all of its versions, sorted by subject name.
+// Subject-level configs are not read; use ExportSnapshot when those are needed too.
+// The result can be passed to ImportSchemas on another registry.
+func (m *Manager) ExportAllSubjects(ctx context.Context) ([]SubjectSnapshot, error) {
+	subjects, err := m.ListSubjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export subjects: %w", err)
+	}
+	sort.Strings(subjects)
+
+	out := make([]SubjectSnapshot, 0, len(subjects))
+	for _, subject := range subjects {
+		ss := SubjectSnapshot{Name: subject}
+
+		versions, err := m.ListVersions(ctx, subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export versions of %s: %w", subject, err)
+		}
+		for _, v := range versions {
+			s, err := m.GetSchemaVersion(ctx, subject, v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to export %s version %d: %w", subject, v, err)
+			}
+			ss.Versions = append(ss.Versions, *s)
+		}
+		out = append(out, ss)
+	}
+	return out, nil
+}
+
+// ImportSchemas registers the given subjects into the registry while preserving their
+// schema IDs and version numbers, for migrations and disaster recovery. It returns the
+// number of schema versions imported.
+//
+// The registry is switched to IMPORT mode for the duration of the import and restored
+// to its previous global mode afterwards, even when the import fails or ctx is cancelled. Schema Registry
+// only accepts IMPORT mode on an empty registry unless the target has been prepared for it.
+//
+// Versions are registered in ascending schema ID order so that referenced schemas always
+// exist before the schemas that reference them. Schemas are sent verbatim without
+// client-side validation. Subject compatibility and mode overrides recorded on each
+// SubjectSnapshot are applied once all schemas are imported.
+func (m *Manager) ImportSchemas(ctx context.Context, subjects []SubjectSnapshot) (n int, err error) {
+	previous, err := m.GetGlobalMode(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read global mode: %w", err)
+	}
+	if previous != ModeImport {
+		if err := m.SetGlobalMode(ctx, ModeImport); err != nil {
+			return 0, fmt.Errorf("failed to enter import mode: %w", err)
+		}
+		defer func() {
+			// Restore even if ctx was cancelled, since IMPORT mode blocks normal registration
+			restoreCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), modeRestoreTimeout)
+			defer cancel()
+			if restoreErr := m.SetGlobalMode(restoreCtx, previous); restoreErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to restore global mode %s: %w", previous, restoreErr))
+			}
+		}()
+	}
+
+	var all []Schema
+	for _, ss := range subjects {
+		for _, s := range ss.Versions {
+			s.Subject = ss.Name
+			all = append(all, s)
+		}
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].ID != all[j].ID {
+			return all[i].ID < all[j].ID
+		}
+		return all[i].Version < all[j].Version
+	})
+
+	for _, s := range all {
+		payload := RegisterRequest{
+			Schema:     s.Schema,
+			SchemaType: s.Type,
+			References: s.References,
+			Metadata:   s.Metadata,
+			RuleSet:    s.RuleSet,
+			ID:         s.ID,
+			Version:    s.Version,
+		}
+		req := client.Request{Method: "POST", Path: fmt.Sprintf("%s/subjects/%s/versions", m.basePath, url.PathEscape(s.Subject)), Body: payload}
+		if _, err := m.c.Do(ctx, req); err != nil {
+			return n, fmt.Errorf("failed to import %s version %d (id %d): %w", s.Subject, s.Version, s.ID, err)
+		}
+		n++
+	}
+
+	for _, ss := range subjects {
+		if ss.Compatibility != "" {
+			if err := m.SetSubjectCompatibility(ctx, ss.Name, ss.Compatibility); err != nil {
+				return n, fmt.Errorf("failed to import compatibility of %s: %w", ss.Name, err)
+			}
+		}
+		if ss.Mode != "" && ss.Mode != ModeImport {
+			if err := m.SetSubjectMode(ctx, ss.Name, ss.Mode); err != nil {
+				return n, fmt.Errorf("failed to import mode of %s: %w", ss.Name, err)
+			}
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,143 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExportAllSubjects(t *testing.T) {
+	m := NewManager(newTestClient(t, newSnapshotServer(t)), "/schema-registry/v1")
+
+	subjects, err := m.ExportAllSubjects(context.Background())
+	if err != nil {
+		t.Fatalf("ExportAllSubjects error: %v", err)
+	}
+	if len(subjects) != 1 || subjects[0].Name != "orders-value" || len(subjects[0].Versions) != 2 {
+		t.Fatalf("unexpected export: %#v", subjects)
+	}
+	if subjects[0].Compatibility != "" {
+		t.Errorf("expected no subject config in export, got %q", subjects[0].Compatibility)
+	}
+}
+
+func TestImportSchemas(t *testing.T) {
+	var modes []Mode
+	var imported []RegisterRequest
+	var importedSubjects []string
+	var subjectCompat CompatibilityLevel
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/schema-registry/v1/mode" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]Mode{"mode": ModeReadWrite})
+		case r.URL.Path == "/schema-registry/v1/mode" && r.Method == http.MethodPut:
+			var body map[string]Mode
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			modes = append(modes, body["mode"])
+			_ = json.NewEncoder(w).Encode(body)
+		case r.URL.Path == "/schema-registry/v1/config/orders-value" && r.Method == http.MethodPut:
+			var body map[string]CompatibilityLevel
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			subjectCompat = body["compatibility"]
+			_ = json.NewEncoder(w).Encode(body)
+		case strings.HasSuffix(r.URL.Path, "/versions") && r.Method == http.MethodPost:
+			var req RegisterRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			imported = append(imported, req)
+			subject := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/schema-registry/v1/subjects/"), "/versions")
+			importedSubjects = append(importedSubjects, subject)
+			_ = json.NewEncoder(w).Encode(RegisterResponse{ID: req.ID})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	subjects := []SubjectSnapshot{
+		{Name: "orders-value", Compatibility: CompatFull, Versions: []Schema{
+			{ID: 12, Version: 1, Schema: `"string"`, References: []SchemaReference{{Name: "Customer", Subject: "customer", Version: 1}}},
+		}},
+		{Name: "customer", Versions: []Schema{{ID: 3, Version: 1, Schema: `"int"`}}},
+	}
+	n, err := m.ImportSchemas(context.Background(), subjects)
+	if err != nil {
+		t.Fatalf("ImportSchemas error: %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("expected 2 imported versions, got %d", n)
+	}
+	if len(modes) != 2 || modes[0] != ModeImport || modes[1] != ModeReadWrite {
+		t.Errorf("expected IMPORT then READWRITE, got %v", modes)
+	}
+	if importedSubjects[0] != "customer" || imported[0].ID != 3 || imported[1].ID != 12 || imported[1].Version != 1 {
+		t.Errorf("referenced schema should be imported first with pinned IDs: %v %#v", importedSubjects, imported)
+	}
+	if len(imported[1].References) != 1 {
+		t.Errorf("references not preserved: %#v", imported[1])
+	}
+	if subjectCompat != CompatFull {
+		t.Errorf("subject compatibility not applied: %q", subjectCompat)
+	}
+}
+
+func TestImportSchemas_RestoresModeOnFailure(t *testing.T) {
+	var modes []Mode
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/schema-registry/v1/mode" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]Mode{"mode": ModeReadOnly})
+		case r.URL.Path == "/schema-registry/v1/mode" && r.Method == http.MethodPut:
+			var body map[string]Mode
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			modes = append(modes, body["mode"])
+			_ = json.NewEncoder(w).Encode(body)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error_code":42201,"message":"Invalid schema"}`))
+		}
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	_, err := m.ImportSchemas(context.Background(), []SubjectSnapshot{{Name: "x", Versions: []Schema{{ID: 1, Version: 1, Schema: "bad"}}}})
+	if !IsInvalidSchema(err) {
+		t.Fatalf("expected invalid schema error, got %v", err)
+	}
+	if len(modes) != 2 || modes[1] != ModeReadOnly {
+		t.Errorf("expected previous mode to be restored, got %v", modes)
+	}
+}
+
+func TestImportSchemas_RestoresModeWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var modes []Mode
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/schema-registry/v1/mode" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]Mode{"mode": ModeReadWrite})
+		case r.URL.Path == "/schema-registry/v1/mode" && r.Method == http.MethodPut:
+			var body map[string]Mode
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			modes = append(modes, body["mode"])
+			_ = json.NewEncoder(w).Encode(body)
+		default:
+			// The caller gives up mid-import
+			cancel()
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	_, err := m.ImportSchemas(ctx, []SubjectSnapshot{{Name: "orders-value", Versions: []Schema{{ID: 1, Version: 1, Schema: `"string"`}}}})
+	if err == nil {
+		t.Fatal("expected import error")
+	}
+	if len(modes) != 2 || modes[1] != ModeReadWrite {
+		t.Errorf("expected global mode restored to READWRITE after cancellation, got %v", modes)
+	}
+}
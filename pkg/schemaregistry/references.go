@@ -0,0 +1,94 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creiche/confluent-go/pkg/client"
+)
+
+// SchemaBundle is a schema together with every schema it transitively references.
+type SchemaBundle struct {
+	// Root is the schema whose references were resolved.
+	Root *Schema
+	// References holds the resolved dependencies in dependency order: every schema
+	// appears after the schemas it references, so they can be parsed front to back.
+	References []ResolvedReference
+}
+
+// ResolvedReference is a SchemaReference together with the schema it points to.
+type ResolvedReference struct {
+	SchemaReference
+	Schema *Schema
+}
+
+// ByName returns the resolved schemas keyed by reference name (the Avro full name,
+// JSON Schema URL, or Protobuf import path used inside the referencing schema).
+func (b *SchemaBundle) ByName() map[string]*Schema {
+	out := make(map[string]*Schema, len(b.References))
+	for _, r := range b.References {
+		out[r.Name] = r.Schema
+	}
+	return out
+}
+
+// GetReferencedBy returns the IDs of schemas that reference the given subject version.
+// A version that is still referenced cannot be deleted.
+func (m *Manager) GetReferencedBy(ctx context.Context, subject string, version int) ([]int, error) {
+	var ids []int
+	req := client.Request{Method: "GET", Path: fmt.Sprintf("%s/subjects/%s/versions/%d/referencedby", m.basePath, url.PathEscape(subject), version)}
+	resp, err := m.c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.DecodeJSON(&ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ResolveReferences recursively fetches every schema referenced by root into a bundle.
+// A schema referenced more than once, directly or transitively, appears in the bundle once.
+func (m *Manager) ResolveReferences(ctx context.Context, root *Schema) (*SchemaBundle, error) {
+	return resolveReferences(root, func(subject string, version int) (*Schema, error) {
+		return m.GetSchemaVersion(ctx, subject, version)
+	})
+}
+
+// resolveReferences builds the bundle of root, fetching referenced versions with get.
+func resolveReferences(root *Schema, get func(subject string, version int) (*Schema, error)) (*SchemaBundle, error) {
+	bundle := &SchemaBundle{Root: root}
+	seen := make(map[versionKey]bool)
+	onPath := make(map[versionKey]bool)
+
+	var visit func(refs []SchemaReference) error
+	visit = func(refs []SchemaReference) error {
+		for _, ref := range refs {
+			key := versionKey{subject: ref.Subject, version: ref.Version}
+			if onPath[key] {
+				return fmt.Errorf("circular schema reference to %s version %d", ref.Subject, ref.Version)
+			}
+			if seen[key] {
+				continue
+			}
+			s, err := get(ref.Subject, ref.Version)
+			if err != nil {
+				return fmt.Errorf("failed to resolve reference %s (%s version %d): %w", ref.Name, ref.Subject, ref.Version, err)
+			}
+			onPath[key] = true
+			if err := visit(s.References); err != nil {
+				return err
+			}
+			onPath[key] = false
+			seen[key] = true
+			bundle.References = append(bundle.References, ResolvedReference{SchemaReference: ref, Schema: s})
+		}
+		return nil
+	}
+
+	if err := visit(root.References); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
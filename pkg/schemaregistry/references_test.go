@@ -0,0 +1,106 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetReferencedBy(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !strings.HasSuffix(r.URL.Path, "/schema-registry/v1/subjects/address/versions/1/referencedby") {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]int{5, 9})
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+
+	ids, err := m.GetReferencedBy(context.Background(), "address", 1)
+	if err != nil {
+		t.Fatalf("GetReferencedBy error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != 5 || ids[1] != 9 {
+		t.Fatalf("unexpected ids: %#v", ids)
+	}
+}
+
+func TestResolveReferences(t *testing.T) {
+	// order -> customer -> address, order -> address
+	schemas := map[string]Schema{
+		"customer/1": {ID: 2, Subject: "customer", Version: 1, Schema: "customer", References: []SchemaReference{
+			{Name: "com.acme.Address", Subject: "address", Version: 1},
+		}},
+		"address/1": {ID: 1, Subject: "address", Version: 1, Schema: "address"},
+	}
+	calls := make(map[string]int)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/schema-registry/v1/subjects/")
+		key = strings.Replace(key, "/versions/", "/", 1)
+		calls[key]++
+		s, ok := schemas[key]
+		if !ok {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(s)
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+
+	root := &Schema{ID: 3, Subject: "order", Version: 1, References: []SchemaReference{
+		{Name: "com.acme.Customer", Subject: "customer", Version: 1},
+		{Name: "com.acme.Address", Subject: "address", Version: 1},
+	}}
+	bundle, err := m.ResolveReferences(context.Background(), root)
+	if err != nil {
+		t.Fatalf("ResolveReferences error: %v", err)
+	}
+	if len(bundle.References) != 2 {
+		t.Fatalf("expected 2 references, got %#v", bundle.References)
+	}
+	if bundle.References[0].Subject != "address" || bundle.References[1].Subject != "customer" {
+		t.Errorf("references not in dependency order: %s, %s", bundle.References[0].Subject, bundle.References[1].Subject)
+	}
+	if byName := bundle.ByName(); byName["com.acme.Customer"].ID != 2 || byName["com.acme.Address"].ID != 1 {
+		t.Errorf("unexpected ByName: %#v", byName)
+	}
+	if calls["address/1"] != 1 {
+		t.Errorf("expected address to be fetched once, got %d", calls["address/1"])
+	}
+}
+
+func TestResolveReferences_Cycle(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Schema{Subject: "a", Version: 1, References: []SchemaReference{{Name: "a", Subject: "a", Version: 1}}})
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+
+	root := &Schema{References: []SchemaReference{{Name: "a", Subject: "a", Version: 1}}}
+	if _, err := m.ResolveReferences(context.Background(), root); err == nil || !strings.Contains(err.Error(), "circular") {
+		t.Fatalf("expected circular reference error, got %v", err)
+	}
+}
+
+func TestResolveReferences_MissingReference(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_code":40402,"message":"Version not found"}`))
+	}
+	c := newTestClient(t, handler)
+	m := NewManager(c, "/schema-registry/v1")
+
+	root := &Schema{References: []SchemaReference{{Name: "x", Subject: "x", Version: 4}}}
+	_, err := m.ResolveReferences(context.Background(), root)
+	if !IsVersionNotFound(err) {
+		t.Fatalf("expected version not found, got %v", err)
+	}
+}
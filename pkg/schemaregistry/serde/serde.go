@@ -0,0 +1,248 @@
+// Package serde frames Kafka record payloads in the Confluent wire format so they can
+// be produced and consumed by any Confluent-compatible client.
+//
+// A framed message is a zero magic byte, the 4-byte big-endian schema ID, and the
+// encoded payload. Serializer resolves the schema ID for a topic through Schema Registry
+// (optionally registering the schema) and Deserializer resolves the writer schema from
+// the ID; both cache lookups so the registry is only consulted once per schema.
+//
+// Encoding the payload itself is delegated to a Codec, so any Avro or JSON library can
+// be plugged in without this package depending on it. Protobuf message indexes are not
+// written or read; Protobuf users should frame payloads with Frame and Parse directly.
+//
+// Example usage:
+//
+//	sr := schemaregistry.NewManager(client, "/schema-registry/v1")
+//	ser := serde.NewSerializer(sr, serde.SerializerConfig{
+//		Schema:       schemaregistry.RegisterRequest{Schema: userSchema},
+//		AutoRegister: true,
+//	})
+//	msg, err := ser.SerializeBytes(ctx, "users", avroEncodedUser)
+//
+//	de := serde.NewDeserializer(sr, nil)
+//	schema, payload, err := de.DeserializeBytes(ctx, msg)
+package serde
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/creiche/confluent-go/pkg/schemaregistry"
+)
+
+// MagicByte is the first byte of every Confluent wire-format message.
+const MagicByte byte = 0
+
+// headerSize is the magic byte plus the 4-byte schema ID.
+const headerSize = 5
+
+var (
+	// ErrUnknownMagicByte is returned when a message does not start with MagicByte.
+	ErrUnknownMagicByte = errors.New("unknown magic byte")
+	// ErrMessageTooShort is returned when a message is shorter than the wire-format header.
+	ErrMessageTooShort = errors.New("message too short for wire format header")
+	// ErrNoCodec is returned by Serialize and Deserialize when no Codec is configured.
+	ErrNoCodec = errors.New("no codec configured")
+)
+
+// Frame prepends the wire-format header for schema id to payload.
+func Frame(id int, payload []byte) []byte {
+	out := make([]byte, headerSize+len(payload))
+	out[0] = MagicByte
+	binary.BigEndian.PutUint32(out[1:headerSize], uint32(id))
+	copy(out[headerSize:], payload)
+	return out
+}
+
+// Parse splits a wire-format message into its schema ID and payload.
+// The returned payload aliases msg.
+func Parse(msg []byte) (id int, payload []byte, err error) {
+	if len(msg) < headerSize {
+		return 0, nil, ErrMessageTooShort
+	}
+	if msg[0] != MagicByte {
+		return 0, nil, fmt.Errorf("%w: %d", ErrUnknownMagicByte, msg[0])
+	}
+	return int(binary.BigEndian.Uint32(msg[1:headerSize])), msg[headerSize:], nil
+}
+
+// Registry is the subset of *schemaregistry.Manager used for ID resolution.
+type Registry interface {
+	GetSchemaByID(ctx context.Context, id int) (*schemaregistry.Schema, error)
+	LookupSchema(ctx context.Context, subject string, payload schemaregistry.RegisterRequest) (*schemaregistry.Schema, error)
+	RegisterSchema(ctx context.Context, subject string, payload schemaregistry.RegisterRequest) (int, error)
+}
+
+var _ Registry = (*schemaregistry.Manager)(nil)
+
+// Codec encodes and decodes payloads for a schema, e.g. an adapter around an Avro library.
+type Codec interface {
+	Marshal(schema *schemaregistry.Schema, v interface{}) ([]byte, error)
+	Unmarshal(schema *schemaregistry.Schema, data []byte, v interface{}) error
+}
+
+// SubjectNameStrategy maps a topic to the subject its schemas are registered under.
+type SubjectNameStrategy func(topic string, isKey bool) string
+
+// TopicNameStrategy is the default strategy: "<topic>-key" or "<topic>-value".
+func TopicNameStrategy(topic string, isKey bool) string {
+	if isKey {
+		return topic + "-key"
+	}
+	return topic + "-value"
+}
+
+// SerializerConfig configures a Serializer.
+type SerializerConfig struct {
+	// Schema is the writer schema for every message produced by the serializer.
+	Schema schemaregistry.RegisterRequest
+	// IsKey selects the key subject instead of the value subject.
+	IsKey bool
+	// SubjectNameStrategy defaults to TopicNameStrategy.
+	SubjectNameStrategy SubjectNameStrategy
+	// AutoRegister registers Schema when it is not yet registered under the subject.
+	// When false the schema must already be registered.
+	AutoRegister bool
+	// Codec is required by Serialize; SerializeBytes does not use it.
+	Codec Codec
+}
+
+// Serializer frames payloads with the schema ID registered for their topic.
+// It is safe for concurrent use.
+type Serializer struct {
+	reg    Registry
+	config SerializerConfig
+
+	mu  sync.Mutex
+	ids map[string]int // by subject
+}
+
+// NewSerializer creates a Serializer backed by reg.
+func NewSerializer(reg Registry, config SerializerConfig) *Serializer {
+	if config.SubjectNameStrategy == nil {
+		config.SubjectNameStrategy = TopicNameStrategy
+	}
+	return &Serializer{reg: reg, config: config, ids: make(map[string]int)}
+}
+
+// SchemaID returns the ID of the writer schema under the subject for topic,
+// registering it first when AutoRegister is set. Results are cached per subject.
+func (s *Serializer) SchemaID(ctx context.Context, topic string) (int, error) {
+	subject := s.config.SubjectNameStrategy(topic, s.config.IsKey)
+
+	s.mu.Lock()
+	id, ok := s.ids[subject]
+	s.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	existing, err := s.reg.LookupSchema(ctx, subject, s.config.Schema)
+	switch {
+	case err == nil:
+		id = existing.ID
+	case s.config.AutoRegister && (schemaregistry.IsSchemaNotFound(err) || schemaregistry.IsSubjectNotFound(err)):
+		if id, err = s.reg.RegisterSchema(ctx, subject, s.config.Schema); err != nil {
+			return 0, fmt.Errorf("failed to register schema for %s: %w", subject, err)
+		}
+	default:
+		return 0, fmt.Errorf("failed to resolve schema ID for %s: %w", subject, err)
+	}
+
+	s.mu.Lock()
+	s.ids[subject] = id
+	s.mu.Unlock()
+	return id, nil
+}
+
+// SerializeBytes frames an already-encoded payload for topic.
+func (s *Serializer) SerializeBytes(ctx context.Context, topic string, payload []byte) ([]byte, error) {
+	id, err := s.SchemaID(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	return Frame(id, payload), nil
+}
+
+// Serialize encodes v with the configured Codec and frames it for topic.
+func (s *Serializer) Serialize(ctx context.Context, topic string, v interface{}) ([]byte, error) {
+	if s.config.Codec == nil {
+		return nil, ErrNoCodec
+	}
+	id, err := s.SchemaID(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	schema := &schemaregistry.Schema{
+		ID:         id,
+		Subject:    s.config.SubjectNameStrategy(topic, s.config.IsKey),
+		Schema:     s.config.Schema.Schema,
+		Type:       s.config.Schema.SchemaType,
+		References: s.config.Schema.References,
+	}
+	payload, err := s.config.Codec.Marshal(schema, v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode message for %s: %w", topic, err)
+	}
+	return Frame(id, payload), nil
+}
+
+// Deserializer resolves the writer schema of wire-format messages.
+// It is safe for concurrent use.
+type Deserializer struct {
+	reg   Registry
+	codec Codec
+
+	// schemas caches writer schemas by ID; IDs are immutable so entries never expire.
+	schemas sync.Map
+}
+
+// NewDeserializer creates a Deserializer backed by reg. codec may be nil when only
+// DeserializeBytes is used.
+func NewDeserializer(reg Registry, codec Codec) *Deserializer {
+	return &Deserializer{reg: reg, codec: codec}
+}
+
+// Schema returns the schema for id, consulting the registry only on the first lookup.
+func (d *Deserializer) Schema(ctx context.Context, id int) (*schemaregistry.Schema, error) {
+	if s, ok := d.schemas.Load(id); ok {
+		return s.(*schemaregistry.Schema), nil
+	}
+	s, err := d.reg.GetSchemaByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve schema ID %d: %w", id, err)
+	}
+	d.schemas.Store(id, s)
+	return s, nil
+}
+
+// DeserializeBytes parses msg and returns its writer schema and encoded payload.
+func (d *Deserializer) DeserializeBytes(ctx context.Context, msg []byte) (*schemaregistry.Schema, []byte, error) {
+	id, payload, err := Parse(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := d.Schema(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, payload, nil
+}
+
+// Deserialize parses msg and decodes its payload into v with the configured Codec.
+func (d *Deserializer) Deserialize(ctx context.Context, msg []byte, v interface{}) error {
+	if d.codec == nil {
+		return ErrNoCodec
+	}
+	s, payload, err := d.DeserializeBytes(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if err := d.codec.Unmarshal(s, payload, v); err != nil {
+		return fmt.Errorf("failed to decode message with schema ID %d: %w", s.ID, err)
+	}
+	return nil
+}
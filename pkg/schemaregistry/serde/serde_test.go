@@ -0,0 +1,159 @@
+package serde
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/creiche/confluent-go/pkg/api"
+	"github.com/creiche/confluent-go/pkg/schemaregistry"
+)
+
+type fakeRegistry struct {
+	subjects map[string]int
+	byID     map[int]*schemaregistry.Schema
+	calls    map[string]int
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{subjects: map[string]int{}, byID: map[int]*schemaregistry.Schema{}, calls: map[string]int{}}
+}
+
+func srNotFound(code int) error {
+	return &api.Error{Code: http.StatusNotFound, Details: map[string]interface{}{"error_code": float64(code)}}
+}
+
+func (f *fakeRegistry) GetSchemaByID(ctx context.Context, id int) (*schemaregistry.Schema, error) {
+	f.calls["GetSchemaByID"]++
+	if s, ok := f.byID[id]; ok {
+		return s, nil
+	}
+	return nil, srNotFound(schemaregistry.ErrorCodeSchemaNotFound)
+}
+
+func (f *fakeRegistry) LookupSchema(ctx context.Context, subject string, req schemaregistry.RegisterRequest) (*schemaregistry.Schema, error) {
+	f.calls["LookupSchema"]++
+	id, ok := f.subjects[subject]
+	if !ok {
+		return nil, srNotFound(schemaregistry.ErrorCodeSubjectNotFound)
+	}
+	return &schemaregistry.Schema{ID: id, Subject: subject}, nil
+}
+
+func (f *fakeRegistry) RegisterSchema(ctx context.Context, subject string, req schemaregistry.RegisterRequest) (int, error) {
+	f.calls["RegisterSchema"]++
+	id := len(f.byID) + 100
+	f.subjects[subject] = id
+	f.byID[id] = &schemaregistry.Schema{ID: id, Schema: req.Schema, Type: req.SchemaType}
+	return id, nil
+}
+
+// jsonCodec is a stand-in for an Avro library adapter.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(schema *schemaregistry.Schema, v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(schema *schemaregistry.Schema, data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func TestFrameAndParse(t *testing.T) {
+	msg := Frame(258, []byte("payload"))
+	if !bytes.Equal(msg[:5], []byte{0, 0, 0, 1, 2}) {
+		t.Fatalf("unexpected header: %v", msg[:5])
+	}
+	id, payload, err := Parse(msg)
+	if err != nil || id != 258 || string(payload) != "payload" {
+		t.Fatalf("Parse = %d %q %v", id, payload, err)
+	}
+
+	if _, _, err := Parse([]byte{0, 1}); !errors.Is(err, ErrMessageTooShort) {
+		t.Errorf("expected ErrMessageTooShort, got %v", err)
+	}
+	if _, _, err := Parse([]byte{1, 0, 0, 0, 1}); !errors.Is(err, ErrUnknownMagicByte) {
+		t.Errorf("expected ErrUnknownMagicByte, got %v", err)
+	}
+}
+
+func TestSerializer_AutoRegisterAndCache(t *testing.T) {
+	reg := newFakeRegistry()
+	ser := NewSerializer(reg, SerializerConfig{
+		Schema:       schemaregistry.RegisterRequest{Schema: `{"type":"record","name":"User","fields":[]}`},
+		AutoRegister: true,
+		Codec:        jsonCodec{},
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		msg, err := ser.SerializeBytes(ctx, "users", []byte("x"))
+		if err != nil {
+			t.Fatalf("SerializeBytes error: %v", err)
+		}
+		if id, _, _ := Parse(msg); id != 100 {
+			t.Fatalf("unexpected id %d", id)
+		}
+	}
+	if reg.calls["RegisterSchema"] != 1 || reg.calls["LookupSchema"] != 1 {
+		t.Errorf("expected one lookup and one registration, got %v", reg.calls)
+	}
+	if _, ok := reg.subjects["users-value"]; !ok {
+		t.Errorf("expected users-value subject, got %v", reg.subjects)
+	}
+
+	msg, err := ser.Serialize(ctx, "users", map[string]string{"name": "ada"})
+	if err != nil {
+		t.Fatalf("Serialize error: %v", err)
+	}
+	de := NewDeserializer(reg, jsonCodec{})
+	var out map[string]string
+	if err := de.Deserialize(ctx, msg, &out); err != nil {
+		t.Fatalf("Deserialize error: %v", err)
+	}
+	if out["name"] != "ada" {
+		t.Fatalf("unexpected round trip: %v", out)
+	}
+	if _, err := de.Schema(ctx, 100); err != nil {
+		t.Fatalf("Schema error: %v", err)
+	}
+	if reg.calls["GetSchemaByID"] != 1 {
+		t.Errorf("expected cached schema lookups, got %d", reg.calls["GetSchemaByID"])
+	}
+}
+
+func TestSerializer_NoAutoRegister(t *testing.T) {
+	reg := newFakeRegistry()
+	ser := NewSerializer(reg, SerializerConfig{IsKey: true})
+
+	_, err := ser.SerializeBytes(context.Background(), "users", nil)
+	if !schemaregistry.IsSubjectNotFound(err) {
+		t.Fatalf("expected subject not found, got %v", err)
+	}
+	if reg.calls["RegisterSchema"] != 0 {
+		t.Errorf("schema should not be registered")
+	}
+
+	reg.subjects["users-key"] = 7
+	msg, err := ser.SerializeBytes(context.Background(), "users", nil)
+	if err != nil {
+		t.Fatalf("SerializeBytes error: %v", err)
+	}
+	if id, _, _ := Parse(msg); id != 7 {
+		t.Fatalf("unexpected id %d", id)
+	}
+	if _, err := ser.Serialize(context.Background(), "users", 1); !errors.Is(err, ErrNoCodec) {
+		t.Errorf("expected ErrNoCodec, got %v", err)
+	}
+}
+
+func TestDeserializer_UnknownID(t *testing.T) {
+	de := NewDeserializer(newFakeRegistry(), nil)
+	_, _, err := de.DeserializeBytes(context.Background(), Frame(9, nil))
+	if !schemaregistry.IsSchemaNotFound(err) {
+		t.Fatalf("expected schema not found, got %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+// SchemaReader is the read-only subset of Schema Registry operations.
+// It is implemented by *Manager (backed by the live registry) and by
+// *SnapshotManager (backed by an offline snapshot).
+type SchemaReader interface {
+	ListSubjects(ctx context.Context) ([]string, error)
+	ListVersions(ctx context.Context, subject string) ([]int, error)
+	GetLatestSchema(ctx context.Context, subject string) (*Schema, error)
+	GetSchemaVersion(ctx context.Context, subject string, version int) (*Schema, error)
+	GetSchemaByID(ctx context.Context, id int) (*Schema, error)
+	GetGlobalCompatibility(ctx context.Context) (CompatibilityLevel, error)
+	GetSubjectCompatibility(ctx context.Context, subject string) (CompatibilityLevel, error)
+	GetGlobalMode(ctx context.Context) (Mode, error)
+	GetSubjectMode(ctx context.Context, subject string) (Mode, error)
+}
+
+// Snapshot is a point-in-time copy of a registry's subjects, versions, and configs.
+type Snapshot struct {
+	CreatedAt           time.Time          `json:"created_at"`
+	GlobalCompatibility CompatibilityLevel `json:"global_compatibility,omitempty"`
+	GlobalMode          Mode               `json:"global_mode,omitempty"`
+	Subjects            []SubjectSnapshot  `json:"subjects"`
+}
+
+// SubjectSnapshot holds every version of a subject along with its subject-level
+// config overrides. Compatibility and Mode are empty when the subject inherits the global setting.
+type SubjectSnapshot struct {
+	Name          string             `json:"name"`
+	Compatibility CompatibilityLevel `json:"compatibility,omitempty"`
+	Mode          Mode               `json:"mode,omitempty"`
+	Versions      []Schema           `json:"versions"`
+}
+
+// ExportSnapshot reads every subject, version, and config from the registry into a Snapshot.
+// Subjects without config overrides are recorded with empty Compatibility/Mode.
+func (m *Manager) ExportSnapshot(ctx context.Context) (*Snapshot, error) {
+	snap := &Snapshot{CreatedAt: time.Now().UTC()}
+
+	var err error
+	if snap.GlobalCompatibility, err = m.GetGlobalCompatibility(ctx); err != nil {
+		return nil, fmt.Errorf("failed to export global compatibility: %w", err)
+	}
+	if snap.GlobalMode, err = m.GetGlobalMode(ctx); err != nil {
+		return nil, fmt.Errorf("failed to export global mode: %w", err)
+	}
+
+	subjects, err := m.ExportAllSubjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ss := range subjects {
+		subject := ss.Name
+		if ss.Compatibility, err = m.GetSubjectCompatibility(ctx, subject); err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to export compatibility of %s: %w", subject, err)
+		}
+		if ss.Mode, err = m.GetSubjectMode(ctx, subject); err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to export mode of %s: %w", subject, err)
+		}
+
+		snap.Subjects = append(snap.Subjects, ss)
+	}
+
+	return snap, nil
+}
+
+// WriteSnapshot encodes a snapshot as an indented JSON bundle.
+func WriteSnapshot(w io.Writer, snap *Snapshot) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(snap)
+}
+
+// ReadSnapshot decodes a JSON bundle written by WriteSnapshot.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	var snap Snapshot
+	if err := json.NewDecoder(r).Decode(&snap); err != nil {
+		return nil, fmt.Errorf("failed to decode schema registry snapshot: %w", err)
+	}
+	return &snap, nil
+}
+
+// SaveSnapshotFile writes a snapshot bundle to path.
+func SaveSnapshotFile(path string, snap *Snapshot) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteSnapshot(f, snap); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadSnapshotFile reads a snapshot bundle from path.
+func LoadSnapshotFile(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return ReadSnapshot(f)
+}
+
+// SnapshotManager is a read-only SchemaReader backed by a Snapshot, for
+// air-gapped environments and CI where the registry is unreachable.
+// Lookups of missing subjects, versions, or IDs return the same typed errors as
+// the live registry, so IsSubjectNotFound and friends work unchanged.
+type SnapshotManager struct {
+	snap     *Snapshot
+	subjects map[string]*SubjectSnapshot
+	byID     map[int]Schema
+}
+
+// NewSnapshotManager creates a read-only manager over snap.
+func NewSnapshotManager(snap *Snapshot) *SnapshotManager {
+	sm := &SnapshotManager{
+		snap:     snap,
+		subjects: make(map[string]*SubjectSnapshot, len(snap.Subjects)),
+		byID:     make(map[int]Schema),
+	}
+	for i := range snap.Subjects {
+		ss := &snap.Subjects[i]
+		sort.Slice(ss.Versions, func(a, b int) bool { return ss.Versions[a].Version < ss.Versions[b].Version })
+		sm.subjects[ss.Name] = ss
+		for _, s := range ss.Versions {
+			if _, exists := sm.byID[s.ID]; !exists {
+				sm.byID[s.ID] = s
+			}
+		}
+	}
+	return sm
+}
+
+// ListSubjects implements SchemaReader.
+func (sm *SnapshotManager) ListSubjects(ctx context.Context) ([]string, error) {
+	subjects := make([]string, 0, len(sm.snap.Subjects))
+	for _, ss := range sm.snap.Subjects {
+		subjects = append(subjects, ss.Name)
+	}
+	return subjects, nil
+}
+
+// ListVersions implements SchemaReader.
+func (sm *SnapshotManager) ListVersions(ctx context.Context, subject string) ([]int, error) {
+	ss, err := sm.subject(subject)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]int, 0, len(ss.Versions))
+	for _, s := range ss.Versions {
+		versions = append(versions, s.Version)
+	}
+	return versions, nil
+}
+
+// GetLatestSchema implements SchemaReader.
+func (sm *SnapshotManager) GetLatestSchema(ctx context.Context, subject string) (*Schema, error) {
+	ss, err := sm.subject(subject)
+	if err != nil {
+		return nil, err
+	}
+	if len(ss.Versions) == 0 {
+		return nil, notFoundError(ErrorCodeVersionNotFound, fmt.Sprintf("Subject '%s' has no versions", subject))
+	}
+	s := ss.Versions[len(ss.Versions)-1]
+	return &s, nil
+}
+
+// GetSchemaVersion implements SchemaReader.
+func (sm *SnapshotManager) GetSchemaVersion(ctx context.Context, subject string, version int) (*Schema, error) {
+	ss, err := sm.subject(subject)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range ss.Versions {
+		if s.Version == version {
+			s := s
+			return &s, nil
+		}
+	}
+	return nil, notFoundError(ErrorCodeVersionNotFound, fmt.Sprintf("Version %d not found", version))
+}
+
+// GetSchemaByID implements SchemaReader.
+func (sm *SnapshotManager) GetSchemaByID(ctx context.Context, id int) (*Schema, error) {
+	s, ok := sm.byID[id]
+	if !ok {
+		return nil, notFoundError(ErrorCodeSchemaNotFound, fmt.Sprintf("Schema %d not found", id))
+	}
+	return &s, nil
+}
+
+// GetGlobalCompatibility implements SchemaReader.
+func (sm *SnapshotManager) GetGlobalCompatibility(ctx context.Context) (CompatibilityLevel, error) {
+	return sm.snap.GlobalCompatibility, nil
+}
+
+// GetSubjectCompatibility implements SchemaReader.
+func (sm *SnapshotManager) GetSubjectCompatibility(ctx context.Context, subject string) (CompatibilityLevel, error) {
+	ss, err := sm.subject(subject)
+	if err != nil {
+		return "", err
+	}
+	return ss.Compatibility, nil
+}
+
+// GetGlobalMode implements SchemaReader.
+func (sm *SnapshotManager) GetGlobalMode(ctx context.Context) (Mode, error) {
+	return sm.snap.GlobalMode, nil
+}
+
+// GetSubjectMode implements SchemaReader.
+func (sm *SnapshotManager) GetSubjectMode(ctx context.Context, subject string) (Mode, error) {
+	ss, err := sm.subject(subject)
+	if err != nil {
+		return "", err
+	}
+	return ss.Mode, nil
+}
+
+func (sm *SnapshotManager) subject(subject string) (*SubjectSnapshot, error) {
+	ss, ok := sm.subjects[subject]
+	if !ok {
+		return nil, notFoundError(ErrorCodeSubjectNotFound, fmt.Sprintf("Subject '%s' not found", subject))
+	}
+	return ss, nil
+}
+
+var (
+	_ SchemaReader = (*Manager)(nil)
+	_ SchemaReader = (*SnapshotManager)(nil)
+)
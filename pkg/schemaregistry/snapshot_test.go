@@ -0,0 +1,85 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func newSnapshotServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/schema-registry/v1/config":
+			_ = json.NewEncoder(w).Encode(map[string]CompatibilityLevel{"compatibilityLevel": CompatBackward})
+		case "/schema-registry/v1/mode":
+			_ = json.NewEncoder(w).Encode(map[string]Mode{"mode": ModeReadWrite})
+		case "/schema-registry/v1/subjects":
+			_ = json.NewEncoder(w).Encode([]string{"orders-value"})
+		case "/schema-registry/v1/subjects/orders-value/versions":
+			_ = json.NewEncoder(w).Encode([]int{1, 2})
+		case "/schema-registry/v1/subjects/orders-value/versions/1":
+			_ = json.NewEncoder(w).Encode(Schema{ID: 10, Subject: "orders-value", Version: 1, Schema: `"string"`})
+		case "/schema-registry/v1/subjects/orders-value/versions/2":
+			_ = json.NewEncoder(w).Encode(Schema{ID: 11, Subject: "orders-value", Version: 2, Schema: `"int"`})
+		case "/schema-registry/v1/config/orders-value":
+			_ = json.NewEncoder(w).Encode(map[string]CompatibilityLevel{"compatibilityLevel": CompatFull})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 40401, "message": "not found"})
+		}
+	}
+}
+
+func TestExportSnapshotAndSnapshotManager(t *testing.T) {
+	c := newTestClient(t, newSnapshotServer(t))
+	m := NewManager(c, "/schema-registry/v1")
+	ctx := context.Background()
+
+	snap, err := m.ExportSnapshot(ctx)
+	if err != nil {
+		t.Fatalf("ExportSnapshot error: %v", err)
+	}
+	if len(snap.Subjects) != 1 || len(snap.Subjects[0].Versions) != 2 {
+		t.Fatalf("unexpected snapshot: %#v", snap)
+	}
+	if snap.Subjects[0].Compatibility != CompatFull || snap.Subjects[0].Mode != "" {
+		t.Fatalf("unexpected subject config: %#v", snap.Subjects[0])
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, snap); err != nil {
+		t.Fatalf("WriteSnapshot error: %v", err)
+	}
+	loaded, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("ReadSnapshot error: %v", err)
+	}
+
+	var r SchemaReader = NewSnapshotManager(loaded)
+	latest, err := r.GetLatestSchema(ctx, "orders-value")
+	if err != nil || latest.Version != 2 || latest.ID != 11 {
+		t.Fatalf("unexpected latest schema: %#v (err %v)", latest, err)
+	}
+	byID, err := r.GetSchemaByID(ctx, 10)
+	if err != nil || byID.Schema != `"string"` {
+		t.Fatalf("unexpected schema by id: %#v (err %v)", byID, err)
+	}
+	compat, err := r.GetGlobalCompatibility(ctx)
+	if err != nil || compat != CompatBackward {
+		t.Fatalf("unexpected global compatibility: %q (err %v)", compat, err)
+	}
+
+	if _, err := r.GetLatestSchema(ctx, "missing"); !IsSubjectNotFound(err) {
+		t.Errorf("expected subject not found, got %v", err)
+	}
+	if _, err := r.GetSchemaVersion(ctx, "orders-value", 9); !IsVersionNotFound(err) {
+		t.Errorf("expected version not found, got %v", err)
+	}
+	if _, err := r.GetSchemaByID(ctx, 99); !IsSchemaNotFound(err) {
+		t.Errorf("expected schema not found, got %v", err)
+	}
+}
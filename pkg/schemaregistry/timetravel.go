@@ -0,0 +1,99 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// GetSchemaAt returns the version of subject that was latest at time at, which is
+// useful when debugging historical records. Versions are located by binary search
+// over their registration timestamps; if the registry omits timestamps for some
+// versions, every version is scanned instead. Wrap the Manager with a CachedManager
+// to make repeated lookups against the same subject cheap.
+//
+// It returns a version-not-found error if no version had been registered at that time.
+func (m *Manager) GetSchemaAt(ctx context.Context, subject string, at time.Time) (*Schema, error) {
+	versions, err := m.ListVersions(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return schemaAt(subject, versions, at, func(version int) (*Schema, error) {
+		return m.GetSchemaVersion(ctx, subject, version)
+	})
+}
+
+// GetSchemaAt returns the version of subject that was latest at time at, using the
+// registration timestamps recorded in the snapshot.
+func (sm *SnapshotManager) GetSchemaAt(ctx context.Context, subject string, at time.Time) (*Schema, error) {
+	versions, err := sm.ListVersions(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return schemaAt(subject, versions, at, func(version int) (*Schema, error) {
+		return sm.GetSchemaVersion(ctx, subject, version)
+	})
+}
+
+type versionKey struct {
+	subject string
+	version int
+}
+
+// schemaAt finds the newest version registered at or before at.
+func schemaAt(subject string, versions []int, at time.Time, get func(version int) (*Schema, error)) (*Schema, error) {
+	versions = append([]int(nil), versions...)
+	sort.Ints(versions)
+
+	// Version numbers increase with registration time, so binary search for the
+	// first version registered after at. Bail out to a full scan on a missing timestamp.
+	var searchErr error
+	complete := true
+	idx := sort.Search(len(versions), func(i int) bool {
+		if searchErr != nil || !complete {
+			return true
+		}
+		s, err := get(versions[i])
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		if s.Timestamp == 0 {
+			complete = false
+			return true
+		}
+		return s.RegisteredAt().After(at)
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if complete {
+		if idx == 0 {
+			return nil, notFoundError(ErrorCodeVersionNotFound,
+				fmt.Sprintf("Subject '%s' had no versions at %s", subject, at.UTC().Format(time.RFC3339)))
+		}
+		return get(versions[idx-1])
+	}
+
+	// Fallback: scan from newest to oldest, skipping versions without a timestamp.
+	sawTimestamp := false
+	for i := len(versions) - 1; i >= 0; i-- {
+		s, err := get(versions[i])
+		if err != nil {
+			return nil, err
+		}
+		if s.Timestamp == 0 {
+			continue
+		}
+		sawTimestamp = true
+		if !s.RegisteredAt().After(at) {
+			return s, nil
+		}
+	}
+	if !sawTimestamp {
+		return nil, fmt.Errorf("schema registry reported no registration timestamps for subject %s", subject)
+	}
+	return nil, notFoundError(ErrorCodeVersionNotFound,
+		fmt.Sprintf("Subject '%s' had no versions at %s", subject, at.UTC().Format(time.RFC3339)))
+}
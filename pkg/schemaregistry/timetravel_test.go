@@ -0,0 +1,74 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetSchemaAt(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fetches := map[string]int{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		const prefix = "/schema-registry/v1/subjects/orders-value/versions"
+		switch {
+		case r.URL.Path == prefix:
+			_ = json.NewEncoder(w).Encode([]int{1, 2, 3})
+		case strings.HasPrefix(r.URL.Path, prefix+"/"):
+			fetches[r.URL.Path]++
+			v, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, prefix+"/"))
+			ts := base.Add(time.Duration(v) * 24 * time.Hour).UnixMilli()
+			_ = json.NewEncoder(w).Encode(Schema{ID: 100 + v, Subject: "orders-value", Version: v, Timestamp: ts})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 40401, "message": "not found"})
+		}
+	}
+
+	m := NewCachedManager(NewManager(newTestClient(t, handler), "/schema-registry/v1"), CacheConfig{})
+	ctx := context.Background()
+
+	s, err := m.GetSchemaAt(ctx, "orders-value", base.Add(60*time.Hour))
+	if err != nil || s.Version != 2 {
+		t.Fatalf("expected version 2, got %#v (err %v)", s, err)
+	}
+	s, err = m.GetSchemaAt(ctx, "orders-value", base.Add(365*24*time.Hour))
+	if err != nil || s.Version != 3 {
+		t.Fatalf("expected version 3, got %#v (err %v)", s, err)
+	}
+	if _, err := m.GetSchemaAt(ctx, "orders-value", base); !IsVersionNotFound(err) {
+		t.Fatalf("expected version not found, got %v", err)
+	}
+	for path, n := range fetches {
+		if n > 1 {
+			t.Errorf("%s fetched %d times, expected cached", path, n)
+		}
+	}
+}
+
+func TestSnapshotManager_GetSchemaAtFallsBackToScan(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sm := NewSnapshotManager(&Snapshot{Subjects: []SubjectSnapshot{{
+		Name: "orders-value",
+		Versions: []Schema{
+			{ID: 1, Version: 1, Timestamp: base.UnixMilli()},
+			{ID: 2, Version: 2},
+			{ID: 3, Version: 3, Timestamp: base.Add(48 * time.Hour).UnixMilli()},
+		},
+	}}})
+	ctx := context.Background()
+
+	s, err := sm.GetSchemaAt(ctx, "orders-value", base.Add(time.Hour))
+	if err != nil || s.Version != 1 {
+		t.Fatalf("expected version 1, got %#v (err %v)", s, err)
+	}
+	s, err = sm.GetSchemaAt(ctx, "orders-value", base.Add(72*time.Hour))
+	if err != nil || s.Version != 3 {
+		t.Fatalf("expected version 3, got %#v (err %v)", s, err)
+	}
+}
@@ -1,5 +1,11 @@
 package schemaregistry
 
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // Subject represents a Schema Registry subject.
 // A subject is a named scope in which schemas evolve.
 type Subject struct {
@@ -14,6 +20,96 @@ type Schema struct {
 	Version int    `json:"version,omitempty"`
 	Schema  string `json:"schema"`
 	Type    string `json:"schemaType,omitempty"`
+	// References lists the other registered schemas this schema depends on
+	References []SchemaReference `json:"references,omitempty"`
+	// Metadata and RuleSet carry the data contract attached to this version, if any
+	Metadata *Metadata `json:"metadata,omitempty"`
+	RuleSet  *RuleSet  `json:"ruleSet,omitempty"`
+	// SubjectVersions lists the subject versions sharing this schema ID; only set by GetSchemaByIDInSubject
+	SubjectVersions []SubjectVersion `json:"subjectVersions,omitempty"`
+	// Timestamp is the registration time in milliseconds since the epoch, when reported by the registry
+	Timestamp int64 `json:"ts,omitempty"`
+}
+
+// SubjectVersion identifies one version of a subject.
+type SubjectVersion struct {
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+// RegisteredAt returns the registration time of the schema version,
+// or the zero time if the registry did not report it.
+func (s Schema) RegisteredAt() time.Time {
+	if s.Timestamp == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(s.Timestamp)
+}
+
+// ListOptions controls which subjects or versions ListSubjectsWithOptions and
+// ListVersionsWithOptions return. The zero value lists only live entries.
+type ListOptions struct {
+	// Deleted includes soft-deleted subjects or versions alongside live ones.
+	Deleted bool
+	// DeletedOnly returns only soft-deleted subjects or versions. It takes precedence over Deleted.
+	DeletedOnly bool
+	// SubjectPrefix restricts ListSubjectsWithOptions to subjects starting with the prefix.
+	// It is ignored by ListVersionsWithOptions.
+	SubjectPrefix string
+}
+
+// query encodes the options as a query string, including the leading "?" when non-empty.
+func (o ListOptions) query(subjects bool) string {
+	q := url.Values{}
+	switch {
+	case o.DeletedOnly:
+		q.Set("deletedOnly", "true")
+	case o.Deleted:
+		q.Set("deleted", "true")
+	}
+	if subjects && o.SubjectPrefix != "" {
+		q.Set("subjectPrefix", o.SubjectPrefix)
+	}
+	if len(q) == 0 {
+		return ""
+	}
+	return "?" + q.Encode()
+}
+
+// ListSchemasOptions filters and pages ListSchemas.
+type ListSchemasOptions struct {
+	// SubjectPrefix restricts the listing to subjects starting with the prefix.
+	SubjectPrefix string
+	// LatestOnly returns only the latest version of each subject.
+	LatestOnly bool
+	// Deleted includes soft-deleted schema versions.
+	Deleted bool
+	// Offset and Limit page through the results. A zero Limit returns everything.
+	Offset int
+	Limit  int
+}
+
+func (o ListSchemasOptions) query() string {
+	q := url.Values{}
+	if o.SubjectPrefix != "" {
+		q.Set("subjectPrefix", o.SubjectPrefix)
+	}
+	if o.LatestOnly {
+		q.Set("latestOnly", "true")
+	}
+	if o.Deleted {
+		q.Set("deleted", "true")
+	}
+	if o.Offset > 0 {
+		q.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if len(q) == 0 {
+		return ""
+	}
+	return "?" + q.Encode()
 }
 
 // RegisterRequest is the request payload for registering a schema.
@@ -22,6 +118,13 @@ type RegisterRequest struct {
 	Schema     string            `json:"schema"`
 	SchemaType string            `json:"schemaType,omitempty"`
 	References []SchemaReference `json:"references,omitempty"`
+	// Metadata and RuleSet attach a data contract to the registered version.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	RuleSet  *RuleSet  `json:"ruleSet,omitempty"`
+	// ID and Version pin the registration to an existing schema ID and version number.
+	// They are only accepted while the registry or subject is in IMPORT mode.
+	ID      int `json:"id,omitempty"`
+	Version int `json:"version,omitempty"`
 }
 
 // RegisterResponse is the response containing the assigned schema ID.
@@ -41,17 +144,22 @@ type SchemaReference struct {
 // according to the configured compatibility level.
 type CompatibilityResponse struct {
 	IsCompatible bool `json:"is_compatible"`
+	// Messages explains why the schema is incompatible; only populated by verbose checks.
+	Messages []string `json:"messages,omitempty"`
 }
 
+// CompatibilityLevel is a Schema Registry compatibility level.
+type CompatibilityLevel string
+
 // Compatibility levels for Schema Registry configuration.
 const (
-	CompatNone               = "NONE"
-	CompatBackward           = "BACKWARD"
-	CompatBackwardTransitive = "BACKWARD_TRANSITIVE"
-	CompatForward            = "FORWARD"
-	CompatForwardTransitive  = "FORWARD_TRANSITIVE"
-	CompatFull               = "FULL"
-	CompatFullTransitive     = "FULL_TRANSITIVE"
+	CompatNone               CompatibilityLevel = "NONE"
+	CompatBackward           CompatibilityLevel = "BACKWARD"
+	CompatBackwardTransitive CompatibilityLevel = "BACKWARD_TRANSITIVE"
+	CompatForward            CompatibilityLevel = "FORWARD"
+	CompatForwardTransitive  CompatibilityLevel = "FORWARD_TRANSITIVE"
+	CompatFull               CompatibilityLevel = "FULL"
+	CompatFullTransitive     CompatibilityLevel = "FULL_TRANSITIVE"
 )
 
 // Supported schema types.
@@ -61,9 +169,19 @@ const (
 	SchemaTypeProtobuf = "PROTOBUF"
 )
 
+// Mode is a Schema Registry mode.
+type Mode string
+
 // Mode values for Schema Registry configuration.
 const (
-	ModeReadWrite = "READWRITE" // Default: allows reading and writing schemas
-	ModeReadOnly  = "READONLY"  // Read-only: prevents schema registration
-	ModeImport    = "IMPORT"    // Import mode: for schema replication
+	ModeReadWrite        Mode = "READWRITE"         // Default: allows reading and writing schemas
+	ModeReadOnly         Mode = "READONLY"          // Read-only: prevents schema registration
+	ModeReadOnlyOverride Mode = "READONLY_OVERRIDE" // Read-only that also overrides subject-level modes
+	ModeImport           Mode = "IMPORT"            // Import mode: for schema replication
 )
+
+// ModeOptions controls SetGlobalModeWithOptions and SetSubjectModeWithOptions.
+type ModeOptions struct {
+	// Force switches to IMPORT mode even when schemas are already registered.
+	Force bool
+}
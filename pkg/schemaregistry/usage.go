@@ -0,0 +1,86 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaUsage reports where a schema ID is used, for safe-deletion analysis.
+type SchemaUsage struct {
+	ID int
+	// SubjectVersions lists every subject version registered with the schema.
+	SubjectVersions []SubjectVersion
+	// ReferencedBy lists the subject versions above that other schemas reference.
+	// Referenced versions cannot be deleted until the referencing schemas are.
+	ReferencedBy []SchemaReferrer
+	// Topics are the topics derived from the subjects, sorted and deduplicated.
+	// Subjects that do not map to a topic are omitted.
+	Topics []string
+}
+
+// SchemaReferrer is a subject version together with the IDs of the schemas referencing it.
+type SchemaReferrer struct {
+	SubjectVersion
+	IDs []int
+}
+
+// SafeToDelete reports whether no other schema references the schema, so every
+// subject version using it can be deleted. Topic consumers are not considered.
+func (u *SchemaUsage) SafeToDelete() bool {
+	return len(u.ReferencedBy) == 0
+}
+
+// TopicForSubject maps a subject back to its topic under TopicNameStrategy, the default
+// subject naming strategy: "orders-value" and "orders-key" both map to "orders".
+// It returns false for subjects without a -key or -value suffix.
+func TopicForSubject(subject string) (string, bool) {
+	for _, suffix := range []string{"-value", "-key"} {
+		if topic, ok := strings.CutSuffix(subject, suffix); ok && topic != "" {
+			return topic, true
+		}
+	}
+	return "", false
+}
+
+// GetSchemaUsage reports every subject version registered with the schema ID, which of
+// them are referenced by other schemas, and the topics they belong to. topicOf maps a
+// subject to its topic and should invert the subject naming strategy in use; nil uses
+// TopicForSubject. Soft-deleted subject versions are not reported.
+// Returns errors:
+//   - IsSchemaNotFound(err) if the schema ID does not exist
+func (m *Manager) GetSchemaUsage(ctx context.Context, id int, topicOf func(subject string) (string, bool)) (*SchemaUsage, error) {
+	if topicOf == nil {
+		topicOf = TopicForSubject
+	}
+
+	svs, err := m.GetSubjectVersionsByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(svs, func(i, j int) bool {
+		if svs[i].Subject != svs[j].Subject {
+			return svs[i].Subject < svs[j].Subject
+		}
+		return svs[i].Version < svs[j].Version
+	})
+
+	usage := &SchemaUsage{ID: id, SubjectVersions: svs}
+	topics := make(map[string]bool)
+	for _, sv := range svs {
+		ids, err := m.GetReferencedBy(ctx, sv.Subject, sv.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list references to %s version %d: %w", sv.Subject, sv.Version, err)
+		}
+		if len(ids) > 0 {
+			usage.ReferencedBy = append(usage.ReferencedBy, SchemaReferrer{SubjectVersion: sv, IDs: ids})
+		}
+		if topic, ok := topicOf(sv.Subject); ok && !topics[topic] {
+			topics[topic] = true
+			usage.Topics = append(usage.Topics, topic)
+		}
+	}
+	sort.Strings(usage.Topics)
+	return usage, nil
+}
@@ -0,0 +1,58 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetSchemaUsage(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/schema-registry/v1/schemas/ids/5/versions":
+			_ = json.NewEncoder(w).Encode([]SubjectVersion{
+				{Subject: "payments-value", Version: 2},
+				{Subject: "orders-value", Version: 1},
+				{Subject: "com.acme.Address", Version: 1},
+				{Subject: "orders-key", Version: 3},
+			})
+		case "/schema-registry/v1/subjects/com.acme.Address/versions/1/referencedby":
+			_ = json.NewEncoder(w).Encode([]int{8, 9})
+		default:
+			_ = json.NewEncoder(w).Encode([]int{})
+		}
+	}
+	m := NewManager(newTestClient(t, handler), "/schema-registry/v1")
+
+	u, err := m.GetSchemaUsage(context.Background(), 5, nil)
+	if err != nil {
+		t.Fatalf("GetSchemaUsage error: %v", err)
+	}
+	if len(u.SubjectVersions) != 4 || u.SubjectVersions[0].Subject != "com.acme.Address" {
+		t.Errorf("unexpected subject versions: %#v", u.SubjectVersions)
+	}
+	if len(u.Topics) != 2 || u.Topics[0] != "orders" || u.Topics[1] != "payments" {
+		t.Errorf("unexpected topics: %v", u.Topics)
+	}
+	if u.SafeToDelete() || len(u.ReferencedBy) != 1 || u.ReferencedBy[0].Subject != "com.acme.Address" || len(u.ReferencedBy[0].IDs) != 2 {
+		t.Errorf("unexpected referrers: %#v", u.ReferencedBy)
+	}
+}
+
+func TestTopicForSubject(t *testing.T) {
+	tests := map[string]string{
+		"orders-value":     "orders",
+		"orders-key":       "orders",
+		"my-topic-value":   "my-topic",
+		"com.acme.Address": "",
+		"-value":           "",
+	}
+	for subject, want := range tests {
+		got, ok := TopicForSubject(subject)
+		if got != want || ok != (want != "") {
+			t.Errorf("TopicForSubject(%q) = %q, %v; want %q", subject, got, ok, want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package schemaregistry
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -36,6 +37,38 @@ func ValidateSchema(schema string, schemaType string) error {
 	return validator.Validate(schema)
 }
 
+// ErrInvalidCompatibility is returned by ValidateCompatibility for unknown levels.
+// IsInvalidCompatibility matches it as well as the registry's own invalid level error.
+var ErrInvalidCompatibility = errors.New("invalid compatibility level")
+
+// ValidateCompatibility checks that level is one of the Compat* constants, so typos
+// fail before reaching the registry.
+func ValidateCompatibility(level CompatibilityLevel) error {
+	switch level {
+	case CompatNone, CompatBackward, CompatBackwardTransitive, CompatForward,
+		CompatForwardTransitive, CompatFull, CompatFullTransitive:
+		return nil
+	}
+	return fmt.Errorf("%w %q: must be one of %s, %s, %s, %s, %s, %s, %s", ErrInvalidCompatibility, level,
+		CompatNone, CompatBackward, CompatBackwardTransitive, CompatForward,
+		CompatForwardTransitive, CompatFull, CompatFullTransitive)
+}
+
+// ErrInvalidMode is returned by ValidateMode for unknown mode strings.
+// IsInvalidMode matches it as well as the registry's own invalid mode error.
+var ErrInvalidMode = errors.New("invalid mode")
+
+// ValidateMode checks that mode is one of ModeReadWrite, ModeReadOnly,
+// ModeReadOnlyOverride, or ModeImport, so typos fail before reaching the registry.
+func ValidateMode(mode Mode) error {
+	switch mode {
+	case ModeReadWrite, ModeReadOnly, ModeReadOnlyOverride, ModeImport:
+		return nil
+	}
+	return fmt.Errorf("%w %q: must be one of %s, %s, %s, %s", ErrInvalidMode, mode,
+		ModeReadWrite, ModeReadOnly, ModeReadOnlyOverride, ModeImport)
+}
+
 // AvroValidator validates AVRO schema syntax.
 // It checks JSON validity and required fields based on the AVRO type
 // (record, enum, array, map, primitive, or union).